@@ -0,0 +1,16 @@
+-- 1. 管理者フラグ（重複スポットの統合など、運営向け操作の権限判定に使用）
+ALTER TABLE users ADD COLUMN is_admin BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- 2. 統合済みスポットのリダイレクト
+-- 統合元（from）は削除されるため外部キーを張らず、旧IDと旧座標をここに残して引き続き解決できるようにする。
+CREATE TABLE spot_redirects (
+    from_spot_id INTEGER PRIMARY KEY,
+    to_spot_id INTEGER NOT NULL REFERENCES spots(id) ON DELETE CASCADE,
+    from_location GEOGRAPHY(POINT, 4326) NOT NULL,
+    merged_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    merged_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_spot_redirects_to_spot_id ON spot_redirects (to_spot_id);
+CREATE INDEX idx_spot_redirects_from_location
+ON spot_redirects ((ST_X(from_location::geometry)), (ST_Y(from_location::geometry)));
@@ -0,0 +1,17 @@
+-- 1. 店名のあいまい検索（トライグラム）用の拡張機能を有効化
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- 2. 検索用の正規化済み店名
+-- 全角英数→半角、半角カナ→全角、カタカナ→ひらがな、英字→小文字に揃えた店名を保持する。
+-- 新規登録・更新時はアプリケーション側（value_objects.NormalizeSearchText）で算出して書き込む。
+ALTER TABLE spots ADD COLUMN name_normalized TEXT NOT NULL DEFAULT '';
+
+-- 既存データは NormalizeSearchText と同じ対応表で一括変換する。
+UPDATE spots SET name_normalized = lower(translate(
+    name,
+    '！＂＃＄％＆＇（）＊＋，－．／０１２３４５６７８９：；＜＝＞？＠ＡＢＣＤＥＦＧＨＩＪＫＬＭＮＯＰＱＲＳＴＵＶＷＸＹＺ［＼］＾＿｀ａｂｃｄｅｆｇｈｉｊｋｌｍｎｏｐｑｒｓｔｕｖｗｘｙｚ｛｜｝～｡｢｣､･ｦｧｨｩｪｫｬｭｮｯｰｱｲｳｴｵｶｷｸｹｺｻｼｽｾｿﾀﾁﾂﾃﾄﾅﾆﾇﾈﾉﾊﾋﾌﾍﾎﾏﾐﾑﾒﾓﾔﾕﾖﾗﾘﾙﾚﾛﾜﾝﾞﾟァアィイゥウェエォオカガキギクグケゲコゴサザシジスズセゼソゾタダチヂッツヅテデトドナニヌネノハバパヒビピフブプヘベペホボポマミムメモャヤュユョヨラリルレロヮワヰヱヲンヴヵヶ',
+    '!"#$%&''()*+,-./0123456789:;<=>?@abcdefghijklmnopqrstuvwxyz[\]^_`abcdefghijklmnopqrstuvwxyz{|}~。「」、・をぁぃぅぇぉゃゅょっーあいうえおかきくけこさしすせそたちつてとなにぬねのはひふへほまみむめもやゆよらりるれろわん゙゚ぁあぃいぅうぇえぉおかがきぎくぐけげこごさざしじすずせぜそぞただちぢっつづてでとどなにぬねのはばぱひびぴふぶぷへべぺほぼぽまみむめもゃやゅゆょよらりるれろゎわゐゑをんゔゕゖ'
+));
+
+-- 3. インデックスの作成（similarity / LIKE '%...%' の両方に効く GIN トライグラム）
+CREATE INDEX idx_spots_name_normalized_trgm ON spots USING GIN (name_normalized gin_trgm_ops);
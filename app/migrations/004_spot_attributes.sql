@@ -0,0 +1,22 @@
+-- 1. スポットの付帯情報（カテゴリ・住所・価格帯・営業時間）
+-- いずれも任意項目。空文字 / 0 / 空オブジェクトは「未登録」を表す。
+ALTER TABLE spots
+    ADD COLUMN category VARCHAR(32) NOT NULL DEFAULT '',
+    ADD COLUMN address VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN price_range SMALLINT NOT NULL DEFAULT 0 CHECK (price_range BETWEEN 0 AND 4),
+    -- {"mon": ["11:00-15:00", "17:00-23:00"], ...} 形式（レスポンスへの復元用）
+    ADD COLUMN opening_hours JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+CREATE INDEX idx_spots_category ON spots (category);
+
+-- 2. 営業時間帯（「今開いている店」をSQLで絞り込むための展開テーブル）
+-- 分は当日0時からの経過分。深夜営業は 1440 を超える close_minute で表す（例: 17:00-26:00 → 1020〜1560）。
+CREATE TABLE spot_opening_periods (
+    spot_id INTEGER NOT NULL REFERENCES spots(id) ON DELETE CASCADE,
+    weekday SMALLINT NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    open_minute SMALLINT NOT NULL CHECK (open_minute >= 0 AND open_minute < 1440),
+    close_minute SMALLINT NOT NULL,
+    CHECK (close_minute > open_minute AND close_minute <= open_minute + 1440)
+);
+
+CREATE INDEX idx_spot_opening_periods_weekday ON spot_opening_periods (weekday, spot_id);
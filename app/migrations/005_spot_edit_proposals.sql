@@ -0,0 +1,41 @@
+-- 1. スポットの修正提案（店名・座標・カテゴリ）
+CREATE TABLE spot_edit_proposals (
+    id SERIAL PRIMARY KEY,
+    spot_id INTEGER NOT NULL REFERENCES spots(id) ON DELETE CASCADE,
+    proposer_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(16) NOT NULL CHECK (kind IN ('rename', 'relocate', 'recategorize')),
+    -- kind に応じていずれか1つだけを使う
+    proposed_name VARCHAR(255),
+    proposed_location GEOGRAPHY(POINT, 4326),
+    proposed_category VARCHAR(32),
+    status VARCHAR(16) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'applied', 'rejected')),
+    -- spot_edit_votes の集計値（投票のたびに再計算する）
+    upvotes INTEGER NOT NULL DEFAULT 0,
+    downvotes INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    resolved_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_spot_edit_proposals_spot_open ON spot_edit_proposals (spot_id) WHERE status = 'open';
+
+-- 2. 提案への投票（1ユーザー1票。再投票は上書き）
+CREATE TABLE spot_edit_votes (
+    proposal_id INTEGER NOT NULL REFERENCES spot_edit_proposals(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    value SMALLINT NOT NULL CHECK (value IN (-1, 1)),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (proposal_id, user_id)
+);
+
+-- 3. 適用された修正の履歴
+CREATE TABLE spot_edit_history (
+    id SERIAL PRIMARY KEY,
+    spot_id INTEGER NOT NULL REFERENCES spots(id) ON DELETE CASCADE,
+    proposal_id INTEGER REFERENCES spot_edit_proposals(id) ON DELETE SET NULL,
+    kind VARCHAR(16) NOT NULL,
+    old_value TEXT NOT NULL,
+    new_value TEXT NOT NULL,
+    applied_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_spot_edit_history_spot ON spot_edit_history (spot_id, applied_at DESC);
@@ -0,0 +1,14 @@
+-- 1. スポットの営業状態（closed は論理削除。投稿や履歴は残したまま推薦・検索から外す）
+ALTER TABLE spots
+    ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'reported', 'closed')),
+    ADD COLUMN closed_at TIMESTAMPTZ;
+
+CREATE INDEX idx_spots_mesh_open ON spots (mesh_id) WHERE status <> 'closed';
+
+-- 2. 閉店報告（1ユーザー1スポット1件。スポットを active へ戻すと削除される）
+CREATE TABLE spot_closure_reports (
+    spot_id INTEGER NOT NULL REFERENCES spots(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (spot_id, user_id)
+);
@@ -0,0 +1,6 @@
+-- 上書き投稿で過去の投稿を削除せず、上書きされた時刻を記録する（履歴は激戦区度の算定に使う）
+ALTER TABLE posts ADD COLUMN superseded_at TIMESTAMPTZ;
+
+-- 現行の投稿（superseded_at IS NULL）の検索用
+CREATE INDEX idx_posts_spot_current ON posts (spot_id, posted_at DESC) WHERE superseded_at IS NULL;
+CREATE INDEX idx_posts_user_current ON posts (user_id, spot_id) WHERE superseded_at IS NULL;
@@ -0,0 +1,15 @@
+-- アップロード画像のメタデータ（実体は BlobStore に保存する）
+CREATE TABLE images (
+    id SERIAL PRIMARY KEY,
+    -- 退会済みユーザーの画像も孤立画像の回収で削除できるよう、所有者の削除では行を消さない
+    owner_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    storage_key TEXT NOT NULL UNIQUE,
+    url TEXT NOT NULL UNIQUE,
+    content_type VARCHAR(64) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_images_created_at ON images (created_at);
+-- 孤立画像の判定（posts.image_url からの参照）用
+CREATE INDEX idx_posts_image_url ON posts (image_url);
@@ -0,0 +1,9 @@
+-- アップロード画像の派生サイズ（一覧用の正方形サムネイル・推薦カード用の中サイズ）
+-- 派生サイズを生成できない形式（WebP）では NULL のままとし、配信時は元画像のURLで代替する
+ALTER TABLE images
+    ADD COLUMN width INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN height INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN thumb_key TEXT UNIQUE,
+    ADD COLUMN thumb_url TEXT,
+    ADD COLUMN medium_key TEXT UNIQUE,
+    ADD COLUMN medium_url TEXT;
@@ -0,0 +1,6 @@
+-- アップロード画像の EXIF から読み取った撮影地点と撮影日時
+-- 画像ファイルからは取り除くため、投稿者本人の座標確認（image_id 付きの投稿）にのみ使う
+ALTER TABLE images
+    ADD COLUMN gps_latitude DOUBLE PRECISION,
+    ADD COLUMN gps_longitude DOUBLE PRECISION,
+    ADD COLUMN captured_at TIMESTAMPTZ;
@@ -0,0 +1,15 @@
+-- 投稿ごとの複数画像（外観・料理・メニューなど）。position 0 がカバー画像で posts.image_url と一致する
+CREATE TABLE post_images (
+    post_id INTEGER NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    position SMALLINT NOT NULL,
+    image_url TEXT NOT NULL,
+    alt_text VARCHAR(200) NOT NULL DEFAULT '',
+    PRIMARY KEY (post_id, position)
+);
+
+-- 孤立画像の判定（post_images.image_url からの参照）用
+CREATE INDEX idx_post_images_image_url ON post_images (image_url);
+
+-- 既存の投稿の画像をカバー画像として移す
+INSERT INTO post_images (post_id, position, image_url)
+SELECT id, 0, image_url FROM posts WHERE image_url IS NOT NULL AND image_url <> '';
@@ -0,0 +1,47 @@
+-- 1. キャプションから抽出したハッシュタグ（name は # を除いた正規化済みの表記）
+CREATE TABLE tags (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(50) NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE post_tags (
+    post_id INTEGER NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    tag_id INTEGER NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (post_id, tag_id)
+);
+
+CREATE INDEX idx_post_tags_tag_id ON post_tags (tag_id);
+
+-- 2. キャプションの @ユーザー名 を保存時に解決したもの（存在しないユーザー名・投稿者本人は記録しない）
+CREATE TABLE post_mentions (
+    post_id INTEGER NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    PRIMARY KEY (post_id, user_id)
+);
+
+CREATE INDEX idx_post_mentions_user_id ON post_mentions (user_id);
+
+-- トレンド集計（期間内の投稿）用
+CREATE INDEX idx_posts_posted_at ON posts (posted_at);
+
+-- 3. 既存の投稿のキャプションを索引する
+-- アプリケーションの正規化（全角→半角など）の簡易版。以後の投稿はアプリケーション側で抽出する。
+INSERT INTO tags (name)
+SELECT DISTINCT lower(m[1])
+FROM posts p, regexp_matches(p.caption, '(?:^|[^[:alnum:]_.-])[#＃]([[:alnum:]_]{1,50})(?![[:alnum:]_])', 'g') AS m
+WHERE m[1] ~ '[^0-9]'
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO post_tags (post_id, tag_id)
+SELECT DISTINCT p.id, t.id
+FROM posts p, regexp_matches(p.caption, '(?:^|[^[:alnum:]_.-])[#＃]([[:alnum:]_]{1,50})(?![[:alnum:]_])', 'g') AS m
+JOIN tags t ON t.name = lower(m[1])
+ON CONFLICT DO NOTHING;
+
+INSERT INTO post_mentions (post_id, user_id)
+SELECT DISTINCT p.id, u.id
+FROM posts p, regexp_matches(p.caption, '(?:^|[^[:alnum:]_.-])[@＠]([[:alnum:]_.-]{3,32})', 'g') AS m
+JOIN users u ON u.username = rtrim(m[1], '.-')
+WHERE u.id <> p.user_id
+ON CONFLICT DO NOTHING;
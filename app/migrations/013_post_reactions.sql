@@ -0,0 +1,11 @@
+-- 投稿へのリアクション（1ユーザー1投稿につき1種類。付け直すと種類を置き換える）
+CREATE TABLE post_reactions (
+    post_id INTEGER NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(16) NOT NULL CHECK (kind IN ('resonate', 'want_to_go', 'helpful')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (post_id, user_id)
+);
+
+-- リアクションした人の一覧（新しい順）用
+CREATE INDEX idx_post_reactions_post_created ON post_reactions (post_id, created_at DESC, user_id DESC);
@@ -0,0 +1,19 @@
+-- 投稿へのコメント（返信は1階層まで。parent_id は常にトップレベルのコメントを指す）
+CREATE TABLE comments (
+    id SERIAL PRIMARY KEY,
+    post_id INTEGER NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    parent_id INTEGER REFERENCES comments(id) ON DELETE CASCADE,
+    body VARCHAR(500) NOT NULL,
+    -- hidden は禁止語の自動判定・運営者の判断で非表示にしたコメント（投稿者本人にだけ表示する）
+    status VARCHAR(16) NOT NULL DEFAULT 'visible' CHECK (status IN ('visible', 'hidden')),
+    moderation_reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ,
+    -- 投稿者による削除。返信が付いている場合は本文を伏せて残す
+    deleted_at TIMESTAMPTZ
+);
+
+-- トップレベルのコメント一覧・返信一覧（古い順）用
+CREATE INDEX idx_comments_post_thread ON comments (post_id, created_at, id) WHERE parent_id IS NULL;
+CREATE INDEX idx_comments_parent ON comments (parent_id, created_at, id) WHERE parent_id IS NOT NULL;
@@ -0,0 +1,8 @@
+-- 投稿者による5段階の評価（任意）。0 は未評価。内訳（味・コスパ・雰囲気）は総合評価がある場合のみ付けられる
+ALTER TABLE posts
+    ADD COLUMN rating SMALLINT NOT NULL DEFAULT 0 CHECK (rating BETWEEN 0 AND 5),
+    ADD COLUMN rating_taste SMALLINT NOT NULL DEFAULT 0 CHECK (rating_taste BETWEEN 0 AND 5),
+    ADD COLUMN rating_value SMALLINT NOT NULL DEFAULT 0 CHECK (rating_value BETWEEN 0 AND 5),
+    ADD COLUMN rating_atmosphere SMALLINT NOT NULL DEFAULT 0 CHECK (rating_atmosphere BETWEEN 0 AND 5),
+    ADD CONSTRAINT posts_rating_subscores_require_overall
+        CHECK (rating > 0 OR (rating_taste = 0 AND rating_value = 0 AND rating_atmosphere = 0));
@@ -0,0 +1,7 @@
+-- プロフィール（表示名・自己紹介・拠点エリア・アイコン画像）。空文字は未設定
+ALTER TABLE users
+    ADD COLUMN display_name VARCHAR(50) NOT NULL DEFAULT '',
+    ADD COLUMN bio TEXT NOT NULL DEFAULT '',
+    ADD COLUMN home_area VARCHAR(50) NOT NULL DEFAULT '',
+    -- アイコンは POST /v1/images でアップロードした本人の画像。画像が削除されたら未設定に戻す
+    ADD COLUMN avatar_image_id INTEGER REFERENCES images(id) ON DELETE SET NULL;
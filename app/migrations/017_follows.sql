@@ -0,0 +1,13 @@
+-- 明示的なフォロー（共鳴とは別に、感性を信頼するユーザーを選べる）
+CREATE TABLE follows (
+    follower_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    followee_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (follower_id, followee_id),
+    CHECK (follower_id <> followee_id)
+);
+
+-- フォロワー一覧（新しい順）
+CREATE INDEX idx_follows_followee ON follows (followee_id, created_at DESC, follower_id DESC);
+-- フォロー中一覧（新しい順）
+CREATE INDEX idx_follows_follower_created ON follows (follower_id, created_at DESC, followee_id DESC);
@@ -0,0 +1,13 @@
+-- ブロック・ミュート。ブロックは双方向（互いの共鳴・フィード・プロフィールから消える）、
+-- ミュートはミュートした側の共鳴・推薦・フィードからのみ相手を外す
+CREATE TABLE user_restrictions (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    target_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(10) NOT NULL CHECK (kind IN ('block', 'mute')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, kind, target_id),
+    CHECK (user_id <> target_id)
+);
+
+-- 自分をブロックしているユーザーの判定
+CREATE INDEX idx_user_restrictions_target ON user_restrictions (target_id, kind);
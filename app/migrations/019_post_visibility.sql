@@ -0,0 +1,4 @@
+-- 投稿の公開範囲（public: 全員 / followers: 投稿者のフォロワーのみ / private: 本人のみ）
+ALTER TABLE posts
+    ADD COLUMN visibility VARCHAR(10) NOT NULL DEFAULT 'public'
+        CHECK (visibility IN ('public', 'followers', 'private'));
@@ -0,0 +1,11 @@
+-- 1. 退会の申請。猶予期間中は再ログインで取り消せ、猶予期間を過ぎるとパージ処理がユーザーと本人の記録を完全に削除する
+ALTER TABLE users ADD COLUMN deletion_requested_at TIMESTAMPTZ;
+
+CREATE INDEX idx_users_deletion_requested ON users (deletion_requested_at) WHERE deletion_requested_at IS NOT NULL;
+
+-- 2. 王座の保持者を削除しても、スポットごと他のユーザーの投稿まで連鎖削除されないようにする。
+--    パージ処理が王座を他の投稿者へ移し（投稿者が本人だけならスポットを削除し）てからユーザーを削除する
+ALTER TABLE spots
+    DROP CONSTRAINT spots_registered_user_id_fkey,
+    ADD CONSTRAINT spots_registered_user_id_fkey
+        FOREIGN KEY (registered_user_id) REFERENCES users(id) ON DELETE RESTRICT;
@@ -0,0 +1,10 @@
+-- 同じ曜日に重なる営業時間帯を持つスポットは読み込み時の検証で弾かれるため、営業時間を未登録に戻す
+CREATE TEMPORARY TABLE overlapping_opening_spots ON COMMIT DROP AS
+SELECT DISTINCT a.spot_id
+FROM spot_opening_periods a
+JOIN spot_opening_periods b
+  ON a.spot_id = b.spot_id AND a.weekday = b.weekday AND a.ctid <> b.ctid
+ AND a.open_minute < b.close_minute AND b.open_minute < a.close_minute;
+
+UPDATE spots SET opening_hours = '{}'::jsonb WHERE id IN (SELECT spot_id FROM overlapping_opening_spots);
+DELETE FROM spot_opening_periods WHERE spot_id IN (SELECT spot_id FROM overlapping_opening_spots);
@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// DeleteCommentControllerは、DELETE /v1/comments/:id のリクエストを受け取り、
+// コメントを削除（投稿者本人または管理者）する役割を担います。
+type DeleteCommentController struct {
+	usecase usecase.DeleteCommentUseCase
+}
+
+func NewDeleteCommentController(u usecase.DeleteCommentUseCase) *DeleteCommentController {
+	return &DeleteCommentController{usecase: u}
+}
+
+func (ctrl *DeleteCommentController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	commentID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid comment id"})
+	}
+
+	input := usecase.DeleteCommentInput{Token: token, CommentID: commentID}
+	if err := ctrl.usecase.Execute(c.Request().Context(), input); err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -49,17 +49,28 @@ func (ctrl *DistillRecommendationController) Execute(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid longitude format"})
 	}
 
+	// 任意の絞り込み条件（category=ramen, open_now=true）
+	openNow := false
+	if openNowStr := c.QueryParam("open_now"); openNowStr != "" {
+		openNow, err = strconv.ParseBool(openNowStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid open_now format"})
+		}
+	}
+
 	// 3. ユースケースの実行（UserIDではなくTokenを渡す）
 	input := usecase.DistillRecommendationInput{
 		Token:     token,
 		Latitude:  lat,
 		Longitude: lng,
+		Category:  c.QueryParam("category"),
+		OpenNow:   openNow,
 	}
 
 	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
 	if err != nil {
 		// 認証エラーなどのドメインエラーを適切にハンドリング
-		return c.JSON(http.StatusUnauthorized, map[string]string{"error": err.Error()})
+		return c.JSON(errorStatus(err, http.StatusUnauthorized), map[string]string{"error": err.Error()})
 	}
 
 	// 4. 結果が空の場合のハンドリング
@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"mime"
+	"net/http"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// ExportPersonalDataControllerは、GET /v1/users/me/data のリクエストを受け取り、
+// 本人の記録一式（区分ごとの JSON）を ZIP としてストリーミングで返す役割を担います。
+type ExportPersonalDataController struct {
+	usecase usecase.ExportPersonalDataUseCase
+}
+
+func NewExportPersonalDataController(u usecase.ExportPersonalDataUseCase) *ExportPersonalDataController {
+	return &ExportPersonalDataController{usecase: u}
+}
+
+func (ctrl *ExportPersonalDataController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	output, err := ctrl.usecase.Execute(c.Request().Context(), usecase.ExportPersonalDataInput{Token: token})
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, output.ContentType)
+	res.Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": output.FileName}))
+	res.WriteHeader(http.StatusOK)
+
+	// ヘッダー送信後のエラーはステータスを変更できないため、そのまま返してログに残す
+	return output.Write(res)
+}
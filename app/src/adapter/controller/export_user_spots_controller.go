@@ -0,0 +1,40 @@
+package controller
+
+import (
+	"mime"
+	"net/http"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// ExportUserSpotsControllerは、GET /v1/users/me/export?format=geojson|kml|gpx|csv のリクエストを受け取り、
+// 自分のスポット一覧をファイルとしてストリーミングで返す役割を担います。
+type ExportUserSpotsController struct {
+	usecase usecase.ExportUserSpotsUseCase
+}
+
+func NewExportUserSpotsController(u usecase.ExportUserSpotsUseCase) *ExportUserSpotsController {
+	return &ExportUserSpotsController{usecase: u}
+}
+
+func (ctrl *ExportUserSpotsController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	input := usecase.ExportUserSpotsInput{Token: token, Format: c.QueryParam("format")}
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, output.ContentType)
+	res.Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": output.FileName}))
+	res.WriteHeader(http.StatusOK)
+
+	// ヘッダー送信後のエラーはステータスを変更できないため、そのまま返してログに残す
+	return output.Write(res)
+}
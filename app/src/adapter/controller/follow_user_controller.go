@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"net/http"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// FollowUserControllerは、PUT / DELETE /v1/users/:username/follow のリクエストを受け取り、
+// ユーザーのフォロー・フォロー解除を行う役割を担います。
+type FollowUserController struct {
+	usecase usecase.FollowUserUseCase
+}
+
+func NewFollowUserController(u usecase.FollowUserUseCase) *FollowUserController {
+	return &FollowUserController{usecase: u}
+}
+
+// Execute は PUT でユーザーをフォローします。
+func (ctrl *FollowUserController) Execute(c echo.Context) error {
+	return ctrl.execute(c, true)
+}
+
+// Remove は DELETE でフォローを解除します。
+func (ctrl *FollowUserController) Remove(c echo.Context) error {
+	return ctrl.execute(c, false)
+}
+
+func (ctrl *FollowUserController) execute(c echo.Context, follow bool) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	input := usecase.FollowUserInput{Token: token, Username: c.Param("username"), Follow: follow}
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
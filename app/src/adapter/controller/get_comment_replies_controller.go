@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// GetCommentRepliesControllerは、GET /v1/comments/:id/replies のリクエストを受け取り、
+// コメントへの返信（古い順、cursor / limit によるページング）を返す役割を担います。
+type GetCommentRepliesController struct {
+	usecase usecase.GetCommentRepliesUseCase
+}
+
+func NewGetCommentRepliesController(u usecase.GetCommentRepliesUseCase) *GetCommentRepliesController {
+	return &GetCommentRepliesController{usecase: u}
+}
+
+func (ctrl *GetCommentRepliesController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	commentID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid comment id"})
+	}
+
+	input := usecase.GetCommentRepliesInput{
+		Token:     token,
+		CommentID: commentID,
+		Cursor:    c.QueryParam("cursor"),
+	}
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid limit format"})
+		}
+		input.Limit = limit
+	}
+
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
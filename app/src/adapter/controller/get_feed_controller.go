@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// GetFeedControllerは、GET /v1/feed のリクエストを受け取り、
+// 共鳴者の最新の投稿（cursor / limit によるページング、min_match_count による絞り込み、
+// latitude / longitude 指定時は現在地からの距離付き）を返す役割を担います。
+type GetFeedController struct {
+	usecase usecase.GetFeedUseCase
+}
+
+func NewGetFeedController(u usecase.GetFeedUseCase) *GetFeedController {
+	return &GetFeedController{usecase: u}
+}
+
+func (ctrl *GetFeedController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	input := usecase.GetFeedInput{Token: token, Cursor: c.QueryParam("cursor")}
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid limit format"})
+		}
+		input.Limit = limit
+	}
+	if minStr := c.QueryParam("min_match_count"); minStr != "" {
+		minMatch, err := strconv.Atoi(minStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid min_match_count format"})
+		}
+		input.MinMatchCount = minMatch
+	}
+
+	// 現在地は任意。指定する場合は緯度・経度の両方が必要。
+	latStr, lngStr := c.QueryParam("latitude"), c.QueryParam("longitude")
+	if (latStr == "") != (lngStr == "") {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Latitude and longitude must be given together"})
+	}
+	if latStr != "" {
+		lat, err := strconv.ParseFloat(latStr, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid latitude format"})
+		}
+		lng, err := strconv.ParseFloat(lngStr, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid longitude format"})
+		}
+		input.Latitude = &lat
+		input.Longitude = &lng
+	}
+
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// GetFollowsControllerは、GET /v1/users/:username/followers と /following のリクエストを受け取り、
+// フォロワー・フォロー中の一覧（cursor / limit によるページング）を返す役割を担います。
+type GetFollowsController struct {
+	usecase usecase.GetFollowsUseCase
+}
+
+func NewGetFollowsController(u usecase.GetFollowsUseCase) *GetFollowsController {
+	return &GetFollowsController{usecase: u}
+}
+
+// Followers はフォロワーの一覧を返します。
+func (ctrl *GetFollowsController) Followers(c echo.Context) error {
+	return ctrl.execute(c, usecase.FollowListFollowers)
+}
+
+// Following はフォロー中のユーザーの一覧を返します。
+func (ctrl *GetFollowsController) Following(c echo.Context) error {
+	return ctrl.execute(c, usecase.FollowListFollowing)
+}
+
+func (ctrl *GetFollowsController) execute(c echo.Context, list string) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	input := usecase.GetFollowsInput{Token: token, Username: c.Param("username"), List: list, Cursor: c.QueryParam("cursor")}
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid limit format"})
+		}
+		input.Limit = limit
+	}
+
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
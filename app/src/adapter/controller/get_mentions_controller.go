@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// GetMentionsControllerは、GET /v1/users/me/mentions のリクエストを受け取り、
+// 自分をメンションしている投稿（cursor / limit によるページング）を返す役割を担います。
+type GetMentionsController struct {
+	usecase usecase.GetMentionsUseCase
+}
+
+func NewGetMentionsController(u usecase.GetMentionsUseCase) *GetMentionsController {
+	return &GetMentionsController{usecase: u}
+}
+
+func (ctrl *GetMentionsController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	input := usecase.GetMentionsInput{Token: token, Cursor: c.QueryParam("cursor")}
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid limit format"})
+		}
+		input.Limit = limit
+	}
+
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
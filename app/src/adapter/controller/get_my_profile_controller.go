@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"net/http"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// GetMyProfileControllerは、GET /v1/users/me のリクエストを受け取り、
+// 自分のプロフィール（表示名・自己紹介・拠点エリア・アイコン）を返す役割を担います。
+type GetMyProfileController struct {
+	usecase usecase.GetMyProfileUseCase
+}
+
+func NewGetMyProfileController(u usecase.GetMyProfileUseCase) *GetMyProfileController {
+	return &GetMyProfileController{usecase: u}
+}
+
+func (ctrl *GetMyProfileController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	output, err := ctrl.usecase.Execute(c.Request().Context(), usecase.GetMyProfileInput{Token: token})
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// GetPostCommentsControllerは、GET /v1/posts/:id/comments のリクエストを受け取り、
+// 投稿のコメント（古い順、各コメントに先頭の返信を添えて、cursor / limit によるページング）を返す役割を担います。
+type GetPostCommentsController struct {
+	usecase usecase.GetPostCommentsUseCase
+}
+
+func NewGetPostCommentsController(u usecase.GetPostCommentsUseCase) *GetPostCommentsController {
+	return &GetPostCommentsController{usecase: u}
+}
+
+func (ctrl *GetPostCommentsController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	postID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid post id"})
+	}
+
+	input := usecase.GetPostCommentsInput{
+		Token:  token,
+		PostID: postID,
+		Cursor: c.QueryParam("cursor"),
+	}
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid limit format"})
+		}
+		input.Limit = limit
+	}
+
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// GetPostReactionsControllerは、GET /v1/posts/:id/reactions のリクエストを受け取り、
+// 投稿にリアクションしたユーザー（kind で絞り込み、cursor / limit によるページング）を返す役割を担います。
+type GetPostReactionsController struct {
+	usecase usecase.GetPostReactionsUseCase
+}
+
+func NewGetPostReactionsController(u usecase.GetPostReactionsUseCase) *GetPostReactionsController {
+	return &GetPostReactionsController{usecase: u}
+}
+
+func (ctrl *GetPostReactionsController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	postID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid post id"})
+	}
+
+	input := usecase.GetPostReactionsInput{
+		Token:  token,
+		PostID: postID,
+		Kind:   c.QueryParam("kind"),
+		Cursor: c.QueryParam("cursor"),
+	}
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid limit format"})
+		}
+		input.Limit = limit
+	}
+
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"net/http"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// GetPublicProfileControllerは、GET /v1/users/:username のリクエストを受け取り、
+// 公開プロフィールを返す役割を担います。認証ヘッダーは任意で、付いている場合は閲覧者との相性も返します。
+type GetPublicProfileController struct {
+	usecase usecase.GetPublicProfileUseCase
+}
+
+func NewGetPublicProfileController(u usecase.GetPublicProfileUseCase) *GetPublicProfileController {
+	return &GetPublicProfileController{usecase: u}
+}
+
+func (ctrl *GetPublicProfileController) Execute(c echo.Context) error {
+	input := usecase.GetPublicProfileInput{Username: c.Param("username")}
+	if token, ok := bearerToken(c); ok {
+		input.Token = token
+	}
+
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// GetResonanceCircleControllerは、GET /v1/users/me/resonance のリクエストを受け取り、
+// 自分の共鳴者（sort=match_count | recent、cursor / limit によるページング）を返す役割を担います。
+type GetResonanceCircleController struct {
+	usecase usecase.GetResonanceCircleUseCase
+}
+
+func NewGetResonanceCircleController(u usecase.GetResonanceCircleUseCase) *GetResonanceCircleController {
+	return &GetResonanceCircleController{usecase: u}
+}
+
+func (ctrl *GetResonanceCircleController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	input := usecase.GetResonanceCircleInput{
+		Token:  token,
+		Sort:   c.QueryParam("sort"),
+		Cursor: c.QueryParam("cursor"),
+	}
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid limit format"})
+		}
+		input.Limit = limit
+	}
+
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
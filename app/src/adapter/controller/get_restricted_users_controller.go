@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"net/http"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// GetRestrictedUsersControllerは、GET /v1/users/me/blocks と /mutes のリクエストを受け取り、
+// 自分がブロック・ミュートしているユーザーの一覧を返す役割を担います。
+type GetRestrictedUsersController struct {
+	usecase usecase.GetRestrictedUsersUseCase
+}
+
+func NewGetRestrictedUsersController(u usecase.GetRestrictedUsersUseCase) *GetRestrictedUsersController {
+	return &GetRestrictedUsersController{usecase: u}
+}
+
+// Blocks はブロック中のユーザーの一覧を返します。
+func (ctrl *GetRestrictedUsersController) Blocks(c echo.Context) error {
+	return ctrl.execute(c, usecase.RestrictionBlock)
+}
+
+// Mutes はミュート中のユーザーの一覧を返します。
+func (ctrl *GetRestrictedUsersController) Mutes(c echo.Context) error {
+	return ctrl.execute(c, usecase.RestrictionMute)
+}
+
+func (ctrl *GetRestrictedUsersController) execute(c echo.Context, kind string) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	input := usecase.GetRestrictedUsersInput{Token: token, Kind: kind}
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
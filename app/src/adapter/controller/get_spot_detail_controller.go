@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// GetSpotDetailControllerは、GET /v1/spots/:id のリクエストを受け取り、
+// スポット詳細と投稿一覧（cursor / limit によるページング）を返す役割を担います。
+type GetSpotDetailController struct {
+	usecase usecase.GetSpotDetailUseCase
+}
+
+func NewGetSpotDetailController(u usecase.GetSpotDetailUseCase) *GetSpotDetailController {
+	return &GetSpotDetailController{usecase: u}
+}
+
+func (ctrl *GetSpotDetailController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	spotID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid spot id"})
+	}
+
+	var limit int
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid limit format"})
+		}
+	}
+
+	input := usecase.GetSpotDetailInput{
+		Token:  token,
+		SpotID: spotID,
+		Cursor: c.QueryParam("cursor"),
+		Limit:  limit,
+	}
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
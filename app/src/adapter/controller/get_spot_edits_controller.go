@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// GetSpotEditsControllerは、GET /v1/spots/:id/edits のリクエストを受け取り、
+// スポットの未解決の修正提案と変更履歴を返す役割を担います。
+type GetSpotEditsController struct {
+	usecase usecase.GetSpotEditsUseCase
+}
+
+func NewGetSpotEditsController(u usecase.GetSpotEditsUseCase) *GetSpotEditsController {
+	return &GetSpotEditsController{usecase: u}
+}
+
+func (ctrl *GetSpotEditsController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	spotID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid spot id"})
+	}
+
+	input := usecase.GetSpotEditsInput{
+		Token:  token,
+		SpotID: spotID,
+	}
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// GetTagSpotsControllerは、GET /v1/tags/:tag/spots のリクエストを受け取り、
+// ハッシュタグ付きの投稿があるスポットを投稿数の多い順に返す役割を担います。
+type GetTagSpotsController struct {
+	usecase usecase.GetTagSpotsUseCase
+}
+
+func NewGetTagSpotsController(u usecase.GetTagSpotsUseCase) *GetTagSpotsController {
+	return &GetTagSpotsController{usecase: u}
+}
+
+func (ctrl *GetTagSpotsController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	input := usecase.GetTagSpotsInput{Token: token, Tag: c.Param("tag")}
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid limit format"})
+		}
+		input.Limit = limit
+	}
+
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
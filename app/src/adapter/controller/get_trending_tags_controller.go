@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// GetTrendingTagsControllerは、GET /v1/tags/trending のリクエストを受け取り、
+// 直近 days 日（既定 7 日）によく使われたハッシュタグを返す役割を担います。
+type GetTrendingTagsController struct {
+	usecase usecase.GetTrendingTagsUseCase
+}
+
+func NewGetTrendingTagsController(u usecase.GetTrendingTagsUseCase) *GetTrendingTagsController {
+	return &GetTrendingTagsController{usecase: u}
+}
+
+func (ctrl *GetTrendingTagsController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	input := usecase.GetTrendingTagsInput{Token: token, Now: time.Now()}
+	if daysStr := c.QueryParam("days"); daysStr != "" {
+		days, err := strconv.Atoi(daysStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid days format"})
+		}
+		input.Days = days
+	}
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid limit format"})
+		}
+		input.Limit = limit
+	}
+
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
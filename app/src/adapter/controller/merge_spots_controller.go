@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"net/http"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// MergeSpotsControllerは、POST /v1/admin/spots/merge のリクエストを受け取り、
+// 重複スポットの統合（source → target）を管理者として実行する役割を担います。
+type MergeSpotsController struct {
+	usecase usecase.MergeSpotsUseCase
+}
+
+func NewMergeSpotsController(u usecase.MergeSpotsUseCase) *MergeSpotsController {
+	return &MergeSpotsController{usecase: u}
+}
+
+func (ctrl *MergeSpotsController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	var req struct {
+		SourceSpotID int `json:"source_spot_id"`
+		TargetSpotID int `json:"target_spot_id"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	input := usecase.MergeSpotsInput{
+		Token:        token,
+		SourceSpotID: req.SourceSpotID,
+		TargetSpotID: req.TargetSpotID,
+	}
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
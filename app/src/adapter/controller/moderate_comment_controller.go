@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// ModerateCommentControllerは、PUT /v1/admin/comments/:id/status のリクエストを受け取り、
+// コメントの非表示（hidden）や表示への復元（visible）を管理者として実行する役割を担います。
+type ModerateCommentController struct {
+	usecase usecase.ModerateCommentUseCase
+}
+
+func NewModerateCommentController(u usecase.ModerateCommentUseCase) *ModerateCommentController {
+	return &ModerateCommentController{usecase: u}
+}
+
+func (ctrl *ModerateCommentController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	commentID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid comment id"})
+	}
+
+	var req struct {
+		Status string `json:"status"`
+		Reason string `json:"reason"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	input := usecase.ModerateCommentInput{
+		Token:     token,
+		CommentID: commentID,
+		Status:    req.Status,
+		Reason:    req.Reason,
+	}
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// PostCommentControllerは、POST /v1/posts/:id/comments のリクエストを受け取り、
+// 投稿へのコメント（parent_id を指定するとトップレベルのコメントへの返信）を作成する役割を担います。
+type PostCommentController struct {
+	usecase usecase.PostCommentUseCase
+}
+
+func NewPostCommentController(u usecase.PostCommentUseCase) *PostCommentController {
+	return &PostCommentController{usecase: u}
+}
+
+func (ctrl *PostCommentController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	postID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid post id"})
+	}
+
+	var req struct {
+		ParentID int    `json:"parent_id"`
+		Body     string `json:"body"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	input := usecase.PostCommentInput{
+		Token:    token,
+		PostID:   postID,
+		ParentID: req.ParentID,
+		Body:     req.Body,
+	}
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, output)
+}
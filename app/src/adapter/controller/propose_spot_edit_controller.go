@@ -0,0 +1,58 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// ProposeSpotEditControllerは、POST /v1/spots/:id/edits のリクエストを受け取り、
+// スポットの店名・座標・カテゴリの修正提案を登録する役割を担います。
+type ProposeSpotEditController struct {
+	usecase usecase.ProposeSpotEditUseCase
+}
+
+func NewProposeSpotEditController(u usecase.ProposeSpotEditUseCase) *ProposeSpotEditController {
+	return &ProposeSpotEditController{usecase: u}
+}
+
+func (ctrl *ProposeSpotEditController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	spotID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid spot id"})
+	}
+
+	var req struct {
+		Kind      string  `json:"kind"`
+		Name      string  `json:"name"`
+		Latitude  float64 `json:"latitude"`
+		Longitude float64 `json:"longitude"`
+		Category  string  `json:"category"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	input := usecase.ProposeSpotEditInput{
+		Token:     token,
+		SpotID:    spotID,
+		Kind:      req.Kind,
+		Name:      req.Name,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		Category:  req.Category,
+	}
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, output)
+}
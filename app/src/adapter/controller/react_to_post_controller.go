@@ -0,0 +1,58 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// ReactToPostControllerは、PUT / DELETE /v1/posts/:id/reactions のリクエストを受け取り、
+// 投稿への自分のリアクション（1投稿につき1種類）を付与・付け替え・取り消しする役割を担います。
+type ReactToPostController struct {
+	usecase usecase.ReactToPostUseCase
+}
+
+func NewReactToPostController(u usecase.ReactToPostUseCase) *ReactToPostController {
+	return &ReactToPostController{usecase: u}
+}
+
+// Execute は PUT でリアクションを付与（既にあれば種類を付け替え）します。
+func (ctrl *ReactToPostController) Execute(c echo.Context) error {
+	var req struct {
+		Kind string `json:"kind"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+	if req.Kind == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "kind is required"})
+	}
+	return ctrl.execute(c, req.Kind)
+}
+
+// Remove は DELETE で自分のリアクションを取り消します。
+func (ctrl *ReactToPostController) Remove(c echo.Context) error {
+	return ctrl.execute(c, "")
+}
+
+func (ctrl *ReactToPostController) execute(c echo.Context, kind string) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	postID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid post id"})
+	}
+
+	input := usecase.ReactToPostInput{Token: token, PostID: postID, Kind: kind}
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
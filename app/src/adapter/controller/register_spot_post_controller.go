@@ -31,29 +31,67 @@ func (ctrl *RegisterSpotPostController) Execute(c echo.Context) error {
 		Latitude  float64 `json:"latitude"`
 		Longitude float64 `json:"longitude"`
 		ImageURL  string  `json:"image_url"`
+		ImageID   int     `json:"image_id"`
 		Caption   string  `json:"caption"`
 		Overwrite bool    `json:"overwrite"`
+		// 店舗の付帯情報（任意。新規作成される Spot にのみ反映される）
+		Category     string              `json:"category"`
+		Address      string              `json:"address"`
+		PriceRange   int                 `json:"price_range"`
+		OpeningHours map[string][]string `json:"opening_hours"`
+		// 添付画像（表示順。先頭がカバー画像）
+		Images []struct {
+			ImageURL string `json:"image_url"`
+			AltText  string `json:"alt_text"`
+		} `json:"images"`
+		// 評価（任意。1〜5、内訳は総合評価を付けた場合のみ）
+		Rating struct {
+			Overall    int `json:"overall"`
+			Taste      int `json:"taste"`
+			Value      int `json:"value"`
+			Atmosphere int `json:"atmosphere"`
+		} `json:"rating"`
+		// 公開範囲（任意。public / followers / private、省略時は public）
+		Visibility string `json:"visibility"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
 	}
 
 	// 3. ユースケース入力を組み立て
+	images := make([]usecase.RegisterSpotPostImageInput, 0, len(req.Images))
+	for _, img := range req.Images {
+		images = append(images, usecase.RegisterSpotPostImageInput{ImageURL: img.ImageURL, AltText: img.AltText})
+	}
 	input := usecase.RegisterSpotPostInput{
 		Token:     tokenString,
 		SpotName:  req.SpotName,
 		Latitude:  req.Latitude,
 		Longitude: req.Longitude,
 		ImageURL:  req.ImageURL,
+		ImageID:   req.ImageID,
+		Images:    images,
 		Caption:   req.Caption,
 		Overwrite: req.Overwrite,
+		Rating: usecase.RegisterSpotPostRatingInput{
+			Overall:    req.Rating.Overall,
+			Taste:      req.Rating.Taste,
+			Value:      req.Rating.Value,
+			Atmosphere: req.Rating.Atmosphere,
+		},
+		Visibility: req.Visibility,
+
+		Category:     req.Category,
+		Address:      req.Address,
+		PriceRange:   req.PriceRange,
+		OpeningHours: req.OpeningHours,
 	}
 
 	// 4. ユースケース実行
 	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
 	if err != nil {
-		// すでにデータが存在する場合などは StatusConflict(409) を返す
-		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+		// 入力不正は 400、すでにデータが存在する場合などは StatusConflict(409) を返す
+		return c.JSON(errorStatus(err, http.StatusConflict), map[string]string{"error": err.Error()})
 	}
 
 	return c.JSON(http.StatusOK, output)
@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// ReportSpotClosureControllerは、POST /v1/spots/:id/closure-reports のリクエストを受け取り、
+// スポットの閉店報告を記録する役割を担います。
+type ReportSpotClosureController struct {
+	usecase usecase.ReportSpotClosureUseCase
+}
+
+func NewReportSpotClosureController(u usecase.ReportSpotClosureUseCase) *ReportSpotClosureController {
+	return &ReportSpotClosureController{usecase: u}
+}
+
+func (ctrl *ReportSpotClosureController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	spotID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid spot id"})
+	}
+
+	input := usecase.ReportSpotClosureInput{
+		Token:  token,
+		SpotID: spotID,
+	}
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,40 @@
+package controller
+
+import (
+	"net/http"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// RequestAccountDeletionControllerは、DELETE /v1/users/me のリクエストを受け取り、退会を申請する役割を担います。
+// 本人確認のためパスワードの再入力（{"password": "..."}）が必要です。完全な削除は猶予期間後のパージ処理で行われます。
+type RequestAccountDeletionController struct {
+	usecase usecase.RequestAccountDeletionUseCase
+}
+
+func NewRequestAccountDeletionController(u usecase.RequestAccountDeletionUseCase) *RequestAccountDeletionController {
+	return &RequestAccountDeletionController{usecase: u}
+}
+
+func (ctrl *RequestAccountDeletionController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	var req struct {
+		Password string `json:"password"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	input := usecase.RequestAccountDeletionInput{Token: token, Password: req.Password}
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusAccepted, output)
+}
@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// bearerTokenは Authorization: Bearer <token> ヘッダーからトークン文字列を取り出します。
+func bearerToken(c echo.Context) (string, bool) {
+	authHeader := c.Request().Header.Get("Authorization")
+	if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
+		return "", false
+	}
+	return strings.TrimPrefix(authHeader, "Bearer "), true
+}
+
+// errorStatusはユースケースが返した共通エラーをHTTPステータスへ変換します。
+// 共通エラーに該当しない場合は fallback を返します。
+func errorStatus(err error, fallback int) int {
+	switch {
+	case errors.Is(err, usecase.ErrUnauthorized):
+		return http.StatusUnauthorized
+	case errors.Is(err, usecase.ErrAdminRequired):
+		return http.StatusForbidden
+	case errors.Is(err, usecase.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, usecase.ErrSpotNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, usecase.ErrInvalidInput):
+		return http.StatusBadRequest
+	case errors.Is(err, usecase.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, usecase.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, usecase.ErrTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, usecase.ErrUnsupported):
+		return http.StatusUnsupportedMediaType
+	default:
+		return fallback
+	}
+}
@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"net/http"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// RestrictUserControllerは、PUT / DELETE /v1/users/:username/block と /mute のリクエストを受け取り、
+// ユーザーのブロック・ミュートとその解除を行う役割を担います。
+type RestrictUserController struct {
+	usecase usecase.RestrictUserUseCase
+}
+
+func NewRestrictUserController(u usecase.RestrictUserUseCase) *RestrictUserController {
+	return &RestrictUserController{usecase: u}
+}
+
+// Block は PUT でユーザーをブロックします。
+func (ctrl *RestrictUserController) Block(c echo.Context) error {
+	return ctrl.execute(c, usecase.RestrictionBlock, true)
+}
+
+// Unblock は DELETE でブロックを解除します。
+func (ctrl *RestrictUserController) Unblock(c echo.Context) error {
+	return ctrl.execute(c, usecase.RestrictionBlock, false)
+}
+
+// Mute は PUT でユーザーをミュートします。
+func (ctrl *RestrictUserController) Mute(c echo.Context) error {
+	return ctrl.execute(c, usecase.RestrictionMute, true)
+}
+
+// Unmute は DELETE でミュートを解除します。
+func (ctrl *RestrictUserController) Unmute(c echo.Context) error {
+	return ctrl.execute(c, usecase.RestrictionMute, false)
+}
+
+func (ctrl *RestrictUserController) execute(c echo.Context, kind string, restrict bool) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	input := usecase.RestrictUserInput{Token: token, Username: c.Param("username"), Kind: kind, Restrict: restrict}
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
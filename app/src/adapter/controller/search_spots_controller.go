@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// SearchSpotsControllerは、GET /v1/spots/search?q= のリクエストを受け取り、
+// 店名検索（latitude / longitude 指定時は現在地バイアス付き）を行う役割を担います。
+type SearchSpotsController struct {
+	usecase usecase.SearchSpotsUseCase
+}
+
+func NewSearchSpotsController(u usecase.SearchSpotsUseCase) *SearchSpotsController {
+	return &SearchSpotsController{usecase: u}
+}
+
+func (ctrl *SearchSpotsController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	q := c.QueryParam("q")
+	if q == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Query parameter q is required"})
+	}
+
+	input := usecase.SearchSpotsInput{Token: token, Query: q}
+
+	// 現在地は任意。指定する場合は緯度・経度の両方が必要。
+	latStr, lngStr := c.QueryParam("latitude"), c.QueryParam("longitude")
+	if (latStr == "") != (lngStr == "") {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Latitude and longitude must be given together"})
+	}
+	if latStr != "" {
+		lat, err := strconv.ParseFloat(latStr, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid latitude format"})
+		}
+		lng, err := strconv.ParseFloat(lngStr, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid longitude format"})
+		}
+		input.Latitude = &lat
+		input.Longitude = &lng
+	}
+
+	input.Category = c.QueryParam("category")
+	if openNowStr := c.QueryParam("open_now"); openNowStr != "" {
+		openNow, err := strconv.ParseBool(openNowStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid open_now format"})
+		}
+		input.OpenNow = openNow
+	}
+
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid limit format"})
+		}
+		input.Limit = limit
+	}
+
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// UpdateCommentControllerは、PATCH /v1/comments/:id のリクエストを受け取り、
+// 自分のコメントの本文を編集する役割を担います。
+type UpdateCommentController struct {
+	usecase usecase.UpdateCommentUseCase
+}
+
+func NewUpdateCommentController(u usecase.UpdateCommentUseCase) *UpdateCommentController {
+	return &UpdateCommentController{usecase: u}
+}
+
+func (ctrl *UpdateCommentController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	commentID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid comment id"})
+	}
+
+	var req struct {
+		Body string `json:"body"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	input := usecase.UpdateCommentInput{
+		Token:     token,
+		CommentID: commentID,
+		Body:      req.Body,
+	}
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"net/http"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// UpdateMyProfileControllerは、PATCH /v1/users/me のリクエストを受け取り、
+// 送られた項目だけプロフィールを更新する役割を担います（avatar_image_id に 0 を送るとアイコンを外す）。
+type UpdateMyProfileController struct {
+	usecase usecase.UpdateMyProfileUseCase
+}
+
+func NewUpdateMyProfileController(u usecase.UpdateMyProfileUseCase) *UpdateMyProfileController {
+	return &UpdateMyProfileController{usecase: u}
+}
+
+func (ctrl *UpdateMyProfileController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	var req struct {
+		Username      *string `json:"username"`
+		DisplayName   *string `json:"display_name"`
+		Bio           *string `json:"bio"`
+		HomeArea      *string `json:"home_area"`
+		AvatarImageID *int    `json:"avatar_image_id"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	input := usecase.UpdateMyProfileInput{
+		Token:         token,
+		Username:      req.Username,
+		DisplayName:   req.DisplayName,
+		Bio:           req.Bio,
+		HomeArea:      req.HomeArea,
+		AvatarImageID: req.AvatarImageID,
+	}
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// UpdateSpotStatusControllerは、PUT /v1/admin/spots/:id/status のリクエストを受け取り、
+// 閉店の確定（closed）や復元（active）を管理者として実行する役割を担います。
+type UpdateSpotStatusController struct {
+	usecase usecase.UpdateSpotStatusUseCase
+}
+
+func NewUpdateSpotStatusController(u usecase.UpdateSpotStatusUseCase) *UpdateSpotStatusController {
+	return &UpdateSpotStatusController{usecase: u}
+}
+
+func (ctrl *UpdateSpotStatusController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	spotID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid spot id"})
+	}
+
+	var req struct {
+		Status string `json:"status"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	input := usecase.UpdateSpotStatusInput{
+		Token:  token,
+		SpotID: spotID,
+		Status: req.Status,
+	}
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
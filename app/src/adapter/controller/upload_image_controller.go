@@ -0,0 +1,58 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// uploadFormOverhead はマルチパートの境界やヘッダーに見込む余裕分です。
+const uploadFormOverhead = 1 << 20
+
+// UploadImageControllerは、POST /v1/images のマルチパートリクエスト（フィールド名 "file"）を受け取り、
+// 画像を保存して投稿に使えるURLを返す役割を担います。
+type UploadImageController struct {
+	usecase usecase.UploadImageUseCase
+}
+
+func NewUploadImageController(u usecase.UploadImageUseCase) *UploadImageController {
+	return &UploadImageController{usecase: u}
+}
+
+func (ctrl *UploadImageController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	// 上限を大きく超えるリクエストは、本文を読み切る前に打ち切る。
+	req := c.Request()
+	req.Body = http.MaxBytesReader(c.Response(), req.Body, usecase.MaxImageUploadBytes+uploadFormOverhead)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return c.JSON(http.StatusRequestEntityTooLarge, map[string]string{"error": "Image is too large"})
+		}
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Missing file field"})
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid file"})
+	}
+	defer file.Close()
+
+	input := usecase.UploadImageInput{
+		Token: token,
+		Body:  file,
+	}
+	output, err := ctrl.usecase.Execute(req.Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, output)
+}
@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"app/src/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// VoteSpotEditControllerは、POST /v1/spot-edits/:id/votes のリクエストを受け取り、
+// 修正提案への賛成・反対票を記録する役割を担います。
+type VoteSpotEditController struct {
+	usecase usecase.VoteSpotEditUseCase
+}
+
+func NewVoteSpotEditController(u usecase.VoteSpotEditUseCase) *VoteSpotEditController {
+	return &VoteSpotEditController{usecase: u}
+}
+
+func (ctrl *VoteSpotEditController) Execute(c echo.Context) error {
+	token, ok := bearerToken(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Missing or invalid authorization header"})
+	}
+
+	proposalID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid proposal id"})
+	}
+
+	var req struct {
+		Vote string `json:"vote"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	input := usecase.VoteSpotEditInput{
+		Token:      token,
+		ProposalID: proposalID,
+		Vote:       req.Vote,
+	}
+	output, err := ctrl.usecase.Execute(c.Request().Context(), input)
+	if err != nil {
+		return c.JSON(errorStatus(err, http.StatusInternalServerError), map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
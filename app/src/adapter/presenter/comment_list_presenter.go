@@ -0,0 +1,29 @@
+package presenter
+
+import (
+	"app/src/domain/entities"
+	"app/src/usecase"
+)
+
+// commentListPresenterは、投稿のコメント一覧・コメントへの返信一覧をJSONレスポンス形式に整形します。
+type commentListPresenter struct{}
+
+func NewCommentListPresenter() usecase.CommentListPresenter {
+	return &commentListPresenter{}
+}
+
+func (p *commentListPresenter) Output(comments []*entities.Comment, nextCursor string) *usecase.CommentListResponse {
+	items := make([]usecase.CommentPayload, 0, len(comments))
+	for _, c := range comments {
+		items = append(items, commentPayload(c))
+	}
+
+	var cursor *string
+	if nextCursor != "" {
+		cursor = &nextCursor
+	}
+	return &usecase.CommentListResponse{
+		Comments:   items,
+		NextCursor: cursor,
+	}
+}
@@ -0,0 +1,18 @@
+package presenter
+
+import (
+	"app/src/domain/entities"
+	"app/src/usecase"
+)
+
+// commentPresenterは、作成・編集・モデレーションしたコメントをJSONレスポンス形式に整形します。
+type commentPresenter struct{}
+
+func NewCommentPresenter() usecase.CommentPresenter {
+	return &commentPresenter{}
+}
+
+func (p *commentPresenter) Output(comment *entities.Comment) *usecase.CommentPayload {
+	payload := commentPayload(comment)
+	return &payload
+}
@@ -0,0 +1,39 @@
+package presenter
+
+import (
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/usecase"
+)
+
+// commentPayload はコメント1件（先頭の返信を含む）をレスポンス形式に整形します。
+// 削除済みのコメントは本文を伏せ、deleted=true で返します。
+func commentPayload(c *entities.Comment) usecase.CommentPayload {
+	payload := usecase.CommentPayload{
+		ID:         c.ID.Value(),
+		PostID:     c.PostID.Value(),
+		UserID:     c.UserID.Value(),
+		UserName:   c.Username.String(),
+		Body:       c.Body.String(),
+		Status:     string(c.Status),
+		CreatedAt:  c.CreatedAt.UTC().Format(time.RFC3339),
+		ReplyCount: c.ReplyCount,
+	}
+	if c.ParentID != nil {
+		parentID := c.ParentID.Value()
+		payload.ParentID = &parentID
+	}
+	if c.UpdatedAt != nil {
+		updatedAt := c.UpdatedAt.UTC().Format(time.RFC3339)
+		payload.UpdatedAt = &updatedAt
+	}
+	if c.IsDeleted() {
+		payload.Body = ""
+		payload.Deleted = true
+	}
+	for _, reply := range c.Replies {
+		payload.Replies = append(payload.Replies, commentPayload(reply))
+	}
+	return payload
+}
@@ -37,6 +37,8 @@ func (p *distillRecommendationPresenter) Output(
 			Latitude:  spot.Latitude.Value(),
 			Longitude: spot.Longitude.Value(),
 		},
+		Attributes: spotAttributesPayload(spot),
+		Rating:     spotRatingPayload(spot.Rating),
 	}
 
 	// 2. 蒸留分析データの整形 (仕様書の distillation_analysis ブロックに対応)
@@ -51,12 +53,17 @@ func (p *distillRecommendationPresenter) Output(
 	postsOut := make([]usecase.PostOutput, 0, len(posts))
 	for _, post := range posts {
 		postsOut = append(postsOut, usecase.PostOutput{
-			ID:       post.ID.Value(),
+			ID:           post.ID.Value(),
 			// UserName VO から string を取り出すように修正
-			UserName: post.UserName.String(), 
-			Caption:  post.Caption.String(),
-			ImageURL: post.ImageURL.String(),
-			PostedAt: post.PostedAt.Format(time.RFC3339),
+			UserName:     post.UserName.String(), 
+			Caption:      post.Caption.String(),
+			ImageURL:     post.ImageURL.String(),
+			Images:       postImagesPayload(post),
+			Photos:       postPhotosPayload(post),
+			Reactions:    reactionCountsPayload(post.Reactions),
+			CommentCount: post.CommentCount,
+			Rating:       postRatingPayload(post.Rating),
+			PostedAt:     post.PostedAt.Format(time.RFC3339),
 		})
 	}
 
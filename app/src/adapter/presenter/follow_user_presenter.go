@@ -0,0 +1,22 @@
+package presenter
+
+import (
+	"app/src/domain/entities"
+	"app/src/usecase"
+)
+
+// followUserPresenterは、フォロー・フォロー解除の結果（相手のフォロワー数など）をJSONレスポンス形式に整形します。
+type followUserPresenter struct{}
+
+func NewFollowUserPresenter() usecase.FollowUserPresenter {
+	return &followUserPresenter{}
+}
+
+func (p *followUserPresenter) Output(user *entities.User, following bool, counts entities.FollowCounts) *usecase.FollowUserResponse {
+	return &usecase.FollowUserResponse{
+		User:           publicUserSummaryPayload(user),
+		Following:      following,
+		FollowerCount:  counts.Followers,
+		FollowingCount: counts.Following,
+	}
+}
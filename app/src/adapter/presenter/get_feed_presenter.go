@@ -0,0 +1,67 @@
+package presenter
+
+import (
+	"time"
+
+	"app/src/usecase"
+)
+
+// getFeedPresenterは、共鳴者のアクティビティフィードをJSONレスポンス形式に整形します。
+type getFeedPresenter struct{}
+
+func NewGetFeedPresenter() usecase.GetFeedPresenter {
+	return &getFeedPresenter{}
+}
+
+func (p *getFeedPresenter) Output(items []usecase.FeedDomainItem, nextCursor string) *usecase.GetFeedResponse {
+	payload := make([]usecase.FeedItemPayload, 0, len(items))
+	for _, it := range items {
+		post, spot := it.Item.Post, it.Item.Spot
+
+		var imageURL *string
+		if image := post.ImageURL.String(); image != "" {
+			imageURL = &image
+		}
+		kind := usecase.FeedKindNew
+		if it.Item.IsOverwrite {
+			kind = usecase.FeedKindOverwrite
+		}
+
+		payload = append(payload, usecase.FeedItemPayload{
+			Kind:       kind,
+			MatchCount: it.MatchCount,
+			DistanceKm: it.Item.DistanceKm,
+			Spot: usecase.FeedSpotPayload{
+				ID:     spot.ID.Value(),
+				Name:   spot.Name.String(),
+				MeshID: spot.MeshID.String(),
+				Location: usecase.SpotSearchLocation{
+					Latitude:  spot.Latitude.Value(),
+					Longitude: spot.Longitude.Value(),
+				},
+				Attributes: spotAttributesPayload(spot),
+			},
+			Post: usecase.FeedPostPayload{
+				ID:           post.ID.Value(),
+				UserID:       post.UserID.Value(),
+				UserName:     post.UserName.String(),
+				ImageURL:     imageURL,
+				Photos:       postPhotosPayload(post),
+				Reactions:    reactionCountsPayload(post.Reactions),
+				CommentCount: post.CommentCount,
+				Rating:       postRatingPayload(post.Rating),
+				Caption:      post.Caption.String(),
+				PostedAt:     post.PostedAt.UTC().Format(time.RFC3339),
+			},
+		})
+	}
+
+	var cursor *string
+	if nextCursor != "" {
+		cursor = &nextCursor
+	}
+	return &usecase.GetFeedResponse{
+		Items:      payload,
+		NextCursor: cursor,
+	}
+}
@@ -0,0 +1,34 @@
+package presenter
+
+import (
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/usecase"
+)
+
+// getFollowsPresenterは、フォロワー・フォロー中の一覧をJSONレスポンス形式に整形します。
+type getFollowsPresenter struct{}
+
+func NewGetFollowsPresenter() usecase.GetFollowsPresenter {
+	return &getFollowsPresenter{}
+}
+
+func (p *getFollowsPresenter) Output(edges []entities.FollowEdge, nextCursor string) *usecase.GetFollowsResponse {
+	users := make([]usecase.FollowUserPayload, 0, len(edges))
+	for _, edge := range edges {
+		users = append(users, usecase.FollowUserPayload{
+			User:       publicUserSummaryPayload(edge.User),
+			FollowedAt: edge.FollowedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	var cursor *string
+	if nextCursor != "" {
+		cursor = &nextCursor
+	}
+	return &usecase.GetFollowsResponse{
+		Users:      users,
+		NextCursor: cursor,
+	}
+}
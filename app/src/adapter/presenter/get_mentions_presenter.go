@@ -0,0 +1,47 @@
+package presenter
+
+import (
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/usecase"
+)
+
+// getMentionsPresenterは、自分をメンションしている投稿の一覧をJSONレスポンス形式に整形します。
+type getMentionsPresenter struct{}
+
+func NewGetMentionsPresenter() usecase.GetMentionsPresenter {
+	return &getMentionsPresenter{}
+}
+
+func (p *getMentionsPresenter) Output(mentions []entities.Mention, nextCursor string) *usecase.GetMentionsResponse {
+	payload := make([]usecase.MentionPayload, 0, len(mentions))
+	for _, m := range mentions {
+		var imageURL *string
+		if image := m.Post.ImageURL.String(); image != "" {
+			imageURL = &image
+		}
+		payload = append(payload, usecase.MentionPayload{
+			PostID:       m.Post.ID.Value(),
+			SpotID:       m.Post.SpotID.Value(),
+			SpotName:     m.SpotName.String(),
+			UserID:       m.Post.UserID.Value(),
+			UserName:     m.Post.UserName.String(),
+			ImageURL:     imageURL,
+			Photos:       postPhotosPayload(m.Post),
+			Reactions:    reactionCountsPayload(m.Post.Reactions),
+			CommentCount: m.Post.CommentCount,
+			Caption:      m.Post.Caption.String(),
+			PostedAt:     m.Post.PostedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	var cursor *string
+	if nextCursor != "" {
+		cursor = &nextCursor
+	}
+	return &usecase.GetMentionsResponse{
+		Mentions:   payload,
+		NextCursor: cursor,
+	}
+}
@@ -0,0 +1,38 @@
+package presenter
+
+import (
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/usecase"
+)
+
+// getPostReactionsPresenterは、投稿にリアクションしたユーザーの一覧をJSONレスポンス形式に整形します。
+type getPostReactionsPresenter struct{}
+
+func NewGetPostReactionsPresenter() usecase.GetPostReactionsPresenter {
+	return &getPostReactionsPresenter{}
+}
+
+func (p *getPostReactionsPresenter) Output(post *entities.Post, counts entities.ReactionCounts, reactions []*entities.Reaction, nextCursor string) *usecase.GetPostReactionsResponse {
+	users := make([]usecase.ReactionUserPayload, 0, len(reactions))
+	for _, r := range reactions {
+		users = append(users, usecase.ReactionUserPayload{
+			UserID:    r.UserID.Value(),
+			UserName:  r.Username.String(),
+			Kind:      r.Kind.String(),
+			ReactedAt: r.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	var cursor *string
+	if nextCursor != "" {
+		cursor = &nextCursor
+	}
+	return &usecase.GetPostReactionsResponse{
+		PostID:     post.ID.Value(),
+		Reactions:  reactionCountsPayload(counts),
+		Users:      users,
+		NextCursor: cursor,
+	}
+}
@@ -0,0 +1,56 @@
+package presenter
+
+import (
+	"math"
+	"time"
+
+	"app/src/usecase"
+)
+
+// getPublicProfilePresenterは、公開プロフィール（スポット一覧・王座数・閲覧者との相性）をJSONレスポンス形式に整形します。
+type getPublicProfilePresenter struct{}
+
+func NewGetPublicProfilePresenter() usecase.GetPublicProfilePresenter {
+	return &getPublicProfilePresenter{}
+}
+
+func (p *getPublicProfilePresenter) Output(item usecase.PublicProfileDomainItem) *usecase.GetPublicProfileResponse {
+	spots := make([]usecase.PublicSpotPayload, 0, len(item.Spots))
+	for _, s := range item.Spots {
+		spots = append(spots, usecase.PublicSpotPayload{
+			Spot:     userSpotPayload(s.Spot),
+			Post:     userPostPayload(s.Post),
+			IsThrone: s.IsThrone,
+		})
+	}
+
+	var compat *usecase.CompatibilityPayload
+	if item.Compatibility != nil {
+		shared := make([]usecase.UserSpotPayload, 0, len(item.Compatibility.SharedSpots))
+		for _, spot := range item.Compatibility.SharedSpots {
+			shared = append(shared, userSpotPayload(spot))
+		}
+		compat = &usecase.CompatibilityPayload{
+			MatchCount:  len(shared),
+			Score:       math.Round(item.Compatibility.Score*1000) / 1000,
+			SharedSpots: shared,
+		}
+	}
+
+	user := item.User
+	return &usecase.GetPublicProfileResponse{
+		User: usecase.PublicUserPayload{
+			ID:          user.ID.Value(),
+			Username:    user.Username.String(),
+			DisplayName: user.DisplayName.String(),
+			Bio:         user.Bio.String(),
+			HomeArea:    user.HomeArea.String(),
+			Avatar:      userAvatarPayload(user.Avatar),
+			JoinedAt:    user.CreatedAt.UTC().Format(time.RFC3339),
+		},
+		SpotCount:     len(spots),
+		ThroneCount:   item.ThroneCount,
+		Spots:         spots,
+		Compatibility: compat,
+	}
+}
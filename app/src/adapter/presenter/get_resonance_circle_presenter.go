@@ -0,0 +1,51 @@
+package presenter
+
+import (
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/usecase"
+)
+
+// getResonanceCirclePresenterは、共鳴者の一覧（共通スポット付き）をJSONレスポンス形式に整形します。
+type getResonanceCirclePresenter struct{}
+
+func NewGetResonanceCirclePresenter() usecase.GetResonanceCirclePresenter {
+	return &getResonanceCirclePresenter{}
+}
+
+func (p *getResonanceCirclePresenter) Output(sort entities.ResonanceSort, peers []entities.ResonantPeer, nextCursor string) *usecase.GetResonanceCircleResponse {
+	users := make([]usecase.ResonantPeerPayload, 0, len(peers))
+	for _, peer := range peers {
+		shared := make([]usecase.UserSpotPayload, 0, len(peer.SharedSpots))
+		for _, spot := range peer.SharedSpots {
+			shared = append(shared, userSpotPayload(spot))
+		}
+		users = append(users, usecase.ResonantPeerPayload{
+			User:         publicUserSummaryPayload(peer.User),
+			MatchCount:   peer.MatchCount,
+			LastSharedAt: peer.LastSharedAt.UTC().Format(time.RFC3339),
+			SharedSpots:  shared,
+		})
+	}
+
+	var cursor *string
+	if nextCursor != "" {
+		cursor = &nextCursor
+	}
+	return &usecase.GetResonanceCircleResponse{
+		Sort:       string(sort),
+		Users:      users,
+		NextCursor: cursor,
+	}
+}
+
+// publicUserSummaryPayload は一覧に載せるユーザーの要約を整形します。
+func publicUserSummaryPayload(user *entities.User) usecase.PublicUserSummaryPayload {
+	return usecase.PublicUserSummaryPayload{
+		ID:          user.ID.Value(),
+		Username:    user.Username.String(),
+		DisplayName: user.DisplayName.String(),
+		Avatar:      userAvatarPayload(user.Avatar),
+	}
+}
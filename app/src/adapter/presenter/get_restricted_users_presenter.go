@@ -0,0 +1,29 @@
+package presenter
+
+import (
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/usecase"
+)
+
+// getRestrictedUsersPresenterは、ブロック中・ミュート中のユーザー一覧をJSONレスポンス形式に整形します。
+type getRestrictedUsersPresenter struct{}
+
+func NewGetRestrictedUsersPresenter() usecase.GetRestrictedUsersPresenter {
+	return &getRestrictedUsersPresenter{}
+}
+
+func (p *getRestrictedUsersPresenter) Output(kind entities.UserRestrictionKind, users []entities.RestrictedUser) *usecase.GetRestrictedUsersResponse {
+	payloads := make([]usecase.RestrictedUserPayload, 0, len(users))
+	for _, u := range users {
+		payloads = append(payloads, usecase.RestrictedUserPayload{
+			User:         publicUserSummaryPayload(u.User),
+			RestrictedAt: u.RestrictedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return &usecase.GetRestrictedUsersResponse{
+		Kind:  string(kind),
+		Users: payloads,
+	}
+}
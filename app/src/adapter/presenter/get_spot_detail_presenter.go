@@ -0,0 +1,77 @@
+package presenter
+
+import (
+	"time"
+
+	"app/src/usecase"
+)
+
+// getSpotDetailPresenterは、スポット詳細（スポット・メッシュ・王座・投稿一覧）をJSONレスポンス形式に整形します。
+type getSpotDetailPresenter struct{}
+
+func NewGetSpotDetailPresenter() usecase.GetSpotDetailPresenter {
+	return &getSpotDetailPresenter{}
+}
+
+func (p *getSpotDetailPresenter) Output(item usecase.SpotDetailDomainItem) *usecase.GetSpotDetailResponse {
+	var throne *usecase.SpotDetailThronePayload
+	if item.ThroneHolder != nil {
+		throne = &usecase.SpotDetailThronePayload{
+			UserID:   item.ThroneHolder.ID.Value(),
+			UserName: item.ThroneHolder.Username.String(),
+		}
+	}
+
+	posts := make([]usecase.SpotDetailPostPayload, 0, len(item.Posts))
+	for _, p := range item.Posts {
+		var imageURL *string
+		image := p.Post.ImageURL.String()
+		if image != "" {
+			imageURL = &image
+		}
+
+		posts = append(posts, usecase.SpotDetailPostPayload{
+			ID:           p.Post.ID.Value(),
+			UserName:     p.Post.UserName.String(),
+			ImageURL:     imageURL,
+			Images:       postImagesPayload(p.Post),
+			Photos:       postPhotosPayload(p.Post),
+			Reactions:    reactionCountsPayload(p.Post.Reactions),
+			CommentCount: p.Post.CommentCount,
+			Rating:       postRatingPayload(p.Post.Rating),
+			Visibility:   p.Post.Visibility.String(),
+			Caption:      p.Post.Caption.String(),
+			PostedAt:     p.Post.PostedAt.UTC().Format(time.RFC3339),
+			IsOwn:        p.IsOwn,
+			IsResonant:   p.IsResonant,
+			MatchCount:   p.MatchCount,
+		})
+	}
+
+	var nextCursor *string
+	if item.NextCursor != "" {
+		nextCursor = &item.NextCursor
+	}
+
+	return &usecase.GetSpotDetailResponse{
+		Spot: usecase.SpotDetailPayload{
+			ID:     item.Spot.ID.Value(),
+			Name:   item.Spot.Name.String(),
+			MeshID: item.Spot.MeshID.String(),
+			Location: usecase.SpotDetailLocation{
+				Latitude:  item.Spot.Latitude.Value(),
+				Longitude: item.Spot.Longitude.Value(),
+			},
+			Attributes: spotAttributesPayload(item.Spot),
+			Rating:     spotRatingPayload(item.Spot.Rating),
+			Status:     string(item.Spot.Status),
+		},
+		Mesh: usecase.SpotDetailMeshPayload{
+			MeshID:       item.Spot.MeshID.String(),
+			DensityScore: item.Density.Int(),
+		},
+		Throne:     throne,
+		Posts:      posts,
+		NextCursor: nextCursor,
+	}
+}
@@ -0,0 +1,46 @@
+package presenter
+
+import (
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/usecase"
+)
+
+// getSpotEditsPresenterは、スポットの未解決の修正提案と変更履歴をJSONレスポンス形式に整形します。
+type getSpotEditsPresenter struct{}
+
+func NewGetSpotEditsPresenter() usecase.GetSpotEditsPresenter {
+	return &getSpotEditsPresenter{}
+}
+
+func (p *getSpotEditsPresenter) Output(spot *entities.Spot, threshold int, proposals []*entities.SpotEditProposal, history []*entities.SpotEditHistory) *usecase.GetSpotEditsResponse {
+	proposalPayloads := make([]usecase.SpotEditProposalPayload, 0, len(proposals))
+	for _, proposal := range proposals {
+		proposalPayloads = append(proposalPayloads, spotEditProposalPayload(proposal))
+	}
+
+	historyPayloads := make([]usecase.SpotEditHistoryPayload, 0, len(history))
+	for _, h := range history {
+		// 提案が統合などで削除された履歴は proposal_id が null になる
+		var proposalID *int
+		if id := h.ProposalID.Value(); id != 0 {
+			proposalID = &id
+		}
+		historyPayloads = append(historyPayloads, usecase.SpotEditHistoryPayload{
+			ID:         h.ID.Value(),
+			ProposalID: proposalID,
+			Kind:       h.Kind.String(),
+			OldValue:   h.OldValue,
+			NewValue:   h.NewValue,
+			AppliedAt:  h.AppliedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return &usecase.GetSpotEditsResponse{
+		SpotID:    spot.ID.Value(),
+		Threshold: threshold,
+		Proposals: proposalPayloads,
+		History:   historyPayloads,
+	}
+}
@@ -0,0 +1,41 @@
+package presenter
+
+import (
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+)
+
+// getTagSpotsPresenterは、ハッシュタグ付きの投稿があるスポットを投稿数順のJSONレスポンス形式に整形します。
+type getTagSpotsPresenter struct{}
+
+func NewGetTagSpotsPresenter() usecase.GetTagSpotsPresenter {
+	return &getTagSpotsPresenter{}
+}
+
+func (p *getTagSpotsPresenter) Output(tag value_objects.Hashtag, spots []entities.TaggedSpot) *usecase.GetTagSpotsResponse {
+	payload := make([]usecase.TagSpotPayload, 0, len(spots))
+	for _, item := range spots {
+		payload = append(payload, usecase.TagSpotPayload{
+			Spot: usecase.SpotSearchSpotPayload{
+				ID:     item.Spot.ID.Value(),
+				Name:   item.Spot.Name.String(),
+				MeshID: item.Spot.MeshID.String(),
+				Location: usecase.SpotSearchLocation{
+					Latitude:  item.Spot.Latitude.Value(),
+					Longitude: item.Spot.Longitude.Value(),
+				},
+				Attributes: spotAttributesPayload(item.Spot),
+			},
+			PostCount:      item.PostCount,
+			LatestPostedAt: item.LatestPostedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return &usecase.GetTagSpotsResponse{
+		Tag:   tag.String(),
+		Spots: payload,
+	}
+}
@@ -0,0 +1,32 @@
+package presenter
+
+import (
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/usecase"
+)
+
+// getTrendingTagsPresenterは、期間内によく使われたハッシュタグをJSONレスポンス形式に整形します。
+type getTrendingTagsPresenter struct{}
+
+func NewGetTrendingTagsPresenter() usecase.GetTrendingTagsPresenter {
+	return &getTrendingTagsPresenter{}
+}
+
+func (p *getTrendingTagsPresenter) Output(days int, since time.Time, tags []entities.TrendingTag) *usecase.GetTrendingTagsResponse {
+	payload := make([]usecase.TrendingTagPayload, 0, len(tags))
+	for _, t := range tags {
+		payload = append(payload, usecase.TrendingTagPayload{
+			Tag:       t.Tag.String(),
+			PostCount: t.PostCount,
+			SpotCount: t.SpotCount,
+		})
+	}
+
+	return &usecase.GetTrendingTagsResponse{
+		Days:  days,
+		Since: since.UTC().Format(time.RFC3339),
+		Tags:  payload,
+	}
+}
@@ -3,6 +3,7 @@ package presenter
 import (
 	"time"
 
+	"app/src/domain/entities"
 	"app/src/usecase"
 )
 
@@ -16,35 +17,14 @@ func (p *getUserSpotsPresenter) Output(items []usecase.UserSpotDomainItem) *usec
 	out := make([]usecase.UserSpotResult, 0, len(items))
 
 	for _, item := range items {
-		spotPayload := usecase.UserSpotPayload{
-			ID:     item.Spot.ID.Value(),
-			Name:   item.Spot.Name.String(),
-			MeshID: item.Spot.MeshID.String(),
-			Location: usecase.UserSpotLocation{
-				Latitude:  item.Spot.Latitude.Value(),
-				Longitude: item.Spot.Longitude.Value(),
-			},
-		}
-
 		var postPayload *usecase.UserPostPayload
 		if item.Post != nil {
-			var imageURL *string
-			image := item.Post.ImageURL.String()
-			if image != "" {
-				imageURL = &image
-			}
-
-			postPayload = &usecase.UserPostPayload{
-				ID:       item.Post.ID.Value(),
-				UserName: item.Post.UserName.String(),
-				ImageURL: imageURL,
-				Caption:  item.Post.Caption.String(),
-				PostedAt: item.Post.PostedAt.UTC().Format(time.RFC3339),
-			}
+			payload := userPostPayload(item.Post)
+			postPayload = &payload
 		}
 
 		out = append(out, usecase.UserSpotResult{
-			Spot: spotPayload,
+			Spot: userSpotPayload(item.Spot),
 			Post: postPayload,
 		})
 	}
@@ -53,3 +33,38 @@ func (p *getUserSpotsPresenter) Output(items []usecase.UserSpotDomainItem) *usec
 		UserSpots: out,
 	}
 }
+
+// userSpotPayload / userPostPayload は、ユーザーごとのスポット一覧（自分・公開プロフィール共通）の1件を整形します。
+func userSpotPayload(spot *entities.Spot) usecase.UserSpotPayload {
+	return usecase.UserSpotPayload{
+		ID:     spot.ID.Value(),
+		Name:   spot.Name.String(),
+		MeshID: spot.MeshID.String(),
+		Location: usecase.UserSpotLocation{
+			Latitude:  spot.Latitude.Value(),
+			Longitude: spot.Longitude.Value(),
+		},
+		Attributes: spotAttributesPayload(spot),
+	}
+}
+
+func userPostPayload(post *entities.Post) usecase.UserPostPayload {
+	var imageURL *string
+	image := post.ImageURL.String()
+	if image != "" {
+		imageURL = &image
+	}
+
+	return usecase.UserPostPayload{
+		ID:           post.ID.Value(),
+		UserName:     post.UserName.String(),
+		ImageURL:     imageURL,
+		Images:       postImagesPayload(post),
+		Photos:       postPhotosPayload(post),
+		Reactions:    reactionCountsPayload(post.Reactions),
+		CommentCount: post.CommentCount,
+		Visibility:   post.Visibility.String(),
+		Caption:      post.Caption.String(),
+		PostedAt:     post.PostedAt.UTC().Format(time.RFC3339),
+	}
+}
@@ -27,3 +27,24 @@ func TestGetUserSpotsPresenter_Output(t *testing.T) {
 	assert.Equal(t, "https://example.com/a.jpg", *resp.UserSpots[0].Post.ImageURL)
 	assert.Nil(t, resp.UserSpots[1].Post.ImageURL)
 }
+
+func TestGetUserSpotsPresenter_OutputPhotos(t *testing.T) {
+	spot, _ := entities.NewSpot(10, "駅前のコワーキング", 35.69, 139.70, 2)
+	post, _ := entities.NewPost(20, 2, 10, "koichi_123", "", "外観とランチ", time.Date(2026, 2, 1, 10, 0, 0, 0, time.UTC))
+	exterior, _ := entities.NewPostImage(0, "https://cdn.example.com/images/a.jpg", "店の外観")
+	exterior.Variants, _ = entities.NewImageVariants("https://cdn.example.com/images/a_thumb.jpg", "https://cdn.example.com/images/a_medium.jpg")
+	lunch, _ := entities.NewPostImage(1, "https://example.com/lunch.jpg", "日替わりランチ")
+	post.SetImages([]entities.PostImage{exterior, lunch})
+
+	resp := NewGetUserSpotsPresenter().Output([]usecase.UserSpotDomainItem{{Spot: spot, Post: post}})
+
+	payload := resp.UserSpots[0].Post
+	// image_url は後方互換のためカバー画像（先頭）を返す
+	assert.Equal(t, "https://cdn.example.com/images/a.jpg", *payload.ImageURL)
+	assert.Len(t, payload.Photos, 2)
+	assert.Equal(t, "店の外観", payload.Photos[0].AltText)
+	assert.Equal(t, "https://cdn.example.com/images/a_thumb.jpg", payload.Photos[0].Images.Thumb)
+	// 派生サイズのない外部URLは元画像で代替する
+	assert.Equal(t, 1, payload.Photos[1].Position)
+	assert.Equal(t, "https://example.com/lunch.jpg", payload.Photos[1].Images.Thumb)
+}
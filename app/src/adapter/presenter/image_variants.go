@@ -0,0 +1,52 @@
+package presenter
+
+import (
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+)
+
+// imageVariantsPayload は元画像と派生サイズのURLをレスポンス形式に整形します。
+// 派生サイズのない画像（外部URL・WebP）では、すべてのサイズに元画像のURLを入れます。
+func imageVariantsPayload(original value_objects.ImageURL, variants entities.ImageVariants) usecase.ImageVariantsPayload {
+	payload := usecase.ImageVariantsPayload{
+		Original: original.String(),
+		Medium:   variants.Medium.String(),
+		Thumb:    variants.Thumb.String(),
+	}
+	if payload.Medium == "" {
+		payload.Medium = payload.Original
+	}
+	if payload.Thumb == "" {
+		payload.Thumb = payload.Original
+	}
+	return payload
+}
+
+// postPhotosPayload は投稿の添付画像を表示順に返します。画像のない投稿では空配列です。
+func postPhotosPayload(post *entities.Post) []usecase.PostPhotoPayload {
+	images := post.Images
+	if len(images) == 0 && post.ImageURL != "" {
+		images = []entities.PostImage{{URL: post.ImageURL, Variants: post.ImageVariants}}
+	}
+
+	out := make([]usecase.PostPhotoPayload, 0, len(images))
+	for _, img := range images {
+		out = append(out, usecase.PostPhotoPayload{
+			Position: img.Position,
+			URL:      img.URL.String(),
+			AltText:  img.AltText.String(),
+			Images:   imageVariantsPayload(img.URL, img.Variants),
+		})
+	}
+	return out
+}
+
+// postImagesPayload は投稿画像のサイズ別URLを返します。画像のない投稿では nil です。
+func postImagesPayload(post *entities.Post) *usecase.ImageVariantsPayload {
+	if post.ImageURL == "" {
+		return nil
+	}
+	payload := imageVariantsPayload(post.ImageURL, post.ImageVariants)
+	return &payload
+}
@@ -0,0 +1,49 @@
+package presenter
+
+import (
+	"app/src/usecase"
+)
+
+// importSpotsPresenterは、一括取り込みの行ごとの結果を集計し、レポート形式に整形します。
+type importSpotsPresenter struct{}
+
+func NewImportSpotsPresenter() usecase.ImportSpotsPresenter {
+	return &importSpotsPresenter{}
+}
+
+func (p *importSpotsPresenter) Output(dryRun bool, items []usecase.ImportSpotsRowDomainItem) *usecase.ImportSpotsOutput {
+	out := &usecase.ImportSpotsOutput{
+		DryRun: dryRun,
+		Rows:   make([]usecase.ImportSpotsRowResult, 0, len(items)),
+	}
+
+	for _, item := range items {
+		row := usecase.ImportSpotsRowResult{
+			Line:        item.Row.Line,
+			Name:        item.Row.Name,
+			Status:      string(item.Status),
+			PostCreated: item.PostCreated,
+			Reason:      item.Reason,
+		}
+		// dry-run で作成予定のスポットはまだ ID を持たないため null とする
+		if item.Spot != nil && item.Spot.ID.Value() != 0 {
+			id := item.Spot.ID.Value()
+			row.SpotID = &id
+		}
+
+		switch item.Status {
+		case usecase.ImportRowCreated:
+			out.Created++
+		case usecase.ImportRowMerged:
+			out.Merged++
+		case usecase.ImportRowRejected:
+			out.Rejected++
+		}
+		if item.PostCreated {
+			out.PostsCreated++
+		}
+		out.Rows = append(out.Rows, row)
+	}
+
+	return out
+}
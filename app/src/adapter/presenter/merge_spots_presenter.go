@@ -0,0 +1,46 @@
+package presenter
+
+import (
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+)
+
+// mergeSpotsPresenterは、スポット統合の結果（統合先スポットと再計算された激戦区度）をJSONレスポンス形式に整形します。
+type mergeSpotsPresenter struct{}
+
+func NewMergeSpotsPresenter() usecase.MergeSpotsPresenter {
+	return &mergeSpotsPresenter{}
+}
+
+func (p *mergeSpotsPresenter) Output(
+	sourceID value_objects.ID,
+	target *entities.Spot,
+	movedPosts int,
+	densities []usecase.MergeSpotsMeshDomainItem,
+) *usecase.MergeSpotsOutput {
+	densityOut := make([]usecase.MergeSpotsMeshDensity, 0, len(densities))
+	for _, d := range densities {
+		densityOut = append(densityOut, usecase.MergeSpotsMeshDensity{
+			MeshID:       d.MeshID.String(),
+			DensityScore: d.Density.Int(),
+		})
+	}
+
+	return &usecase.MergeSpotsOutput{
+		Message:      "spots merged",
+		SourceSpotID: sourceID.Value(),
+		Spot: usecase.MergeSpotsSpotPayload{
+			ID:     target.ID.Value(),
+			Name:   target.Name.String(),
+			MeshID: target.MeshID.String(),
+			Location: usecase.MergeSpotsLocationPayload{
+				Latitude:  target.Latitude.Value(),
+				Longitude: target.Longitude.Value(),
+			},
+			RegisteredUserID: target.RegisteredUserID.Value(),
+		},
+		MovedPosts:    movedPosts,
+		DensityScores: densityOut,
+	}
+}
@@ -0,0 +1,70 @@
+package presenter
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"time"
+
+	"app/src/usecase"
+)
+
+// zipPersonalDataArchiverは、個人データの区分ごとに <区分>.json を作り、目録（manifest.json）と併せて ZIP にまとめます。
+type zipPersonalDataArchiver struct{}
+
+func NewPersonalDataArchiver() usecase.PersonalDataArchiver {
+	return &zipPersonalDataArchiver{}
+}
+
+type personalDataManifest struct {
+	UserID     int      `json:"user_id"`
+	Username   string   `json:"username"`
+	ExportedAt string   `json:"exported_at"`
+	Files      []string `json:"files"`
+}
+
+func (a *zipPersonalDataArchiver) ContentType() string   { return "application/zip" }
+func (a *zipPersonalDataArchiver) FileExtension() string { return "zip" }
+
+func (a *zipPersonalDataArchiver) Archive(w io.Writer, data usecase.PersonalDataDomainItem) error {
+	zw := zip.NewWriter(w)
+
+	manifest := personalDataManifest{
+		UserID:     data.User.ID.Value(),
+		Username:   data.User.Username.String(),
+		ExportedAt: data.ExportedAt.UTC().Format(time.RFC3339),
+		Files:      make([]string, 0, len(data.Sections)),
+	}
+	for _, section := range data.Sections {
+		manifest.Files = append(manifest.Files, section.Name+".json")
+	}
+	b, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeZipEntry(zw, "manifest.json", data.ExportedAt, b); err != nil {
+		return err
+	}
+
+	for _, section := range data.Sections {
+		// DB から受け取った JSON は1行にまとまっているため、読みやすいよう字下げして格納する
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, section.JSON, "", "  "); err != nil {
+			return err
+		}
+		if err := writeZipEntry(zw, section.Name+".json", data.ExportedAt, indented.Bytes()); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func writeZipEntry(zw *zip.Writer, name string, modified time.Time, content []byte) error {
+	f, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modified})
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(content)
+	return err
+}
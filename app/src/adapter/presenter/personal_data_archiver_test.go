@@ -0,0 +1,61 @@
+package presenter
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersonalDataArchiver_Archive(t *testing.T) {
+	user, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	data := usecase.PersonalDataDomainItem{
+		User:       user,
+		ExportedAt: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+		Sections: []entities.PersonalDataSection{
+			{Name: "profile", JSON: []byte(`{"id":2,"username":"local_malloy"}`)},
+			{Name: "posts", JSON: []byte(`[{"id":10,"visibility":"private"}]`)},
+		},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, NewPersonalDataArchiver().Archive(&buf, data))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if !assert.NoError(t, err) {
+		return
+	}
+	files := map[string][]byte{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if !assert.NoError(t, err) {
+			return
+		}
+		files[f.Name], _ = io.ReadAll(rc)
+		rc.Close()
+	}
+	assert.Len(t, files, 3)
+
+	var manifest struct {
+		UserID     int      `json:"user_id"`
+		ExportedAt string   `json:"exported_at"`
+		Files      []string `json:"files"`
+	}
+	if assert.NoError(t, json.Unmarshal(files["manifest.json"], &manifest)) {
+		assert.Equal(t, 2, manifest.UserID)
+		assert.Equal(t, "2026-03-02T09:00:00Z", manifest.ExportedAt)
+		assert.Equal(t, []string{"profile.json", "posts.json"}, manifest.Files)
+	}
+
+	var posts []map[string]any
+	if assert.NoError(t, json.Unmarshal(files["posts.json"], &posts)) && assert.Len(t, posts, 1) {
+		assert.Equal(t, "private", posts[0]["visibility"])
+	}
+}
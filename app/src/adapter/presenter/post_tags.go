@@ -0,0 +1,28 @@
+package presenter
+
+import (
+	"app/src/domain/entities"
+	"app/src/usecase"
+)
+
+// postTagsPayload はキャプションのハッシュタグを正規化済みの表記（# なし）で返します。タグがなければ空配列です。
+func postTagsPayload(post *entities.Post) []string {
+	tags := post.Caption.Hashtags()
+	payload := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		payload = append(payload, tag.String())
+	}
+	return payload
+}
+
+// postMentionsPayload は保存時に解決できたメンションを返します。
+func postMentionsPayload(post *entities.Post) []usecase.PostMentionPayload {
+	payload := make([]usecase.PostMentionPayload, 0, len(post.Mentions))
+	for _, m := range post.Mentions {
+		payload = append(payload, usecase.PostMentionPayload{
+			UserID:   m.UserID.Value(),
+			UserName: m.Username.String(),
+		})
+	}
+	return payload
+}
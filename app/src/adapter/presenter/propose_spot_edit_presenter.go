@@ -0,0 +1,55 @@
+package presenter
+
+import (
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+)
+
+// proposeSpotEditPresenterは、修正提案（作成・投票後の状態）をJSONレスポンス形式に整形します。
+type proposeSpotEditPresenter struct{}
+
+func NewProposeSpotEditPresenter() usecase.ProposeSpotEditPresenter {
+	return &proposeSpotEditPresenter{}
+}
+
+func (p *proposeSpotEditPresenter) Output(proposal *entities.SpotEditProposal) *usecase.SpotEditProposalResponse {
+	return &usecase.SpotEditProposalResponse{Proposal: spotEditProposalPayload(proposal)}
+}
+
+// spotEditProposalPayloadは提案の種類に応じて name / location / category のいずれかだけを埋めます。
+func spotEditProposalPayload(proposal *entities.SpotEditProposal) usecase.SpotEditProposalPayload {
+	payload := usecase.SpotEditProposalPayload{
+		ID:         proposal.ID.Value(),
+		SpotID:     proposal.SpotID.Value(),
+		ProposerID: proposal.ProposerID.Value(),
+		Kind:       proposal.Kind.String(),
+		Status:     string(proposal.Status),
+		Upvotes:    proposal.Upvotes,
+		Downvotes:  proposal.Downvotes,
+		Score:      proposal.Score(),
+		CreatedAt:  proposal.CreatedAt.UTC().Format(time.RFC3339),
+	}
+
+	switch proposal.Kind {
+	case value_objects.SpotEditRename:
+		name := proposal.Name.String()
+		payload.Name = &name
+	case value_objects.SpotEditRelocate:
+		payload.Location = &usecase.SpotDetailLocation{
+			Latitude:  proposal.Latitude.Value(),
+			Longitude: proposal.Longitude.Value(),
+		}
+	case value_objects.SpotEditRecategory:
+		category := proposal.Category.String()
+		payload.Category = &category
+	}
+
+	if proposal.ResolvedAt != nil {
+		resolvedAt := proposal.ResolvedAt.UTC().Format(time.RFC3339)
+		payload.ResolvedAt = &resolvedAt
+	}
+	return payload
+}
@@ -0,0 +1,57 @@
+package presenter
+
+import (
+	"math"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+)
+
+// postRatingPayload は投稿者による評価をレスポンス形式に整形します。評価のない投稿は nil です。
+func postRatingPayload(rating entities.PostRating) *usecase.PostRatingPayload {
+	if !rating.IsRated() {
+		return nil
+	}
+	return &usecase.PostRatingPayload{
+		Overall:    rating.Overall.Int(),
+		Taste:      ratingPointer(rating.Taste),
+		Value:      ratingPointer(rating.Value),
+		Atmosphere: ratingPointer(rating.Atmosphere),
+	}
+}
+
+// spotRatingPayload はスポットの評価の集計をレスポンス形式に整形します。評価が1件もなければ nil です。
+func spotRatingPayload(rating entities.SpotRating) *usecase.SpotRatingPayload {
+	if rating.Count == 0 {
+		return nil
+	}
+	return &usecase.SpotRatingPayload{
+		Count:      rating.Count,
+		Overall:    roundRating(rating.Overall),
+		Taste:      averagePointer(rating.Taste),
+		Value:      averagePointer(rating.Value),
+		Atmosphere: averagePointer(rating.Atmosphere),
+	}
+}
+
+func ratingPointer(r value_objects.Rating) *int {
+	if !r.IsRated() {
+		return nil
+	}
+	v := r.Int()
+	return &v
+}
+
+func averagePointer(avg float64) *float64 {
+	if avg == 0 {
+		return nil
+	}
+	v := roundRating(avg)
+	return &v
+}
+
+// roundRating は平均評価を小数第1位に丸めます。
+func roundRating(avg float64) float64 {
+	return math.Round(avg*10) / 10
+}
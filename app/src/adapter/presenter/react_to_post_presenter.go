@@ -0,0 +1,26 @@
+package presenter
+
+import (
+	"app/src/domain/entities"
+	"app/src/usecase"
+)
+
+// reactToPostPresenterは、リアクションの付与・取り消し後の件数と自分のリアクションをJSONレスポンス形式に整形します。
+type reactToPostPresenter struct{}
+
+func NewReactToPostPresenter() usecase.ReactToPostPresenter {
+	return &reactToPostPresenter{}
+}
+
+func (p *reactToPostPresenter) Output(post *entities.Post, counts entities.ReactionCounts, mine *entities.Reaction) *usecase.ReactToPostResponse {
+	var myReaction *string
+	if mine != nil {
+		kind := mine.Kind.String()
+		myReaction = &kind
+	}
+	return &usecase.ReactToPostResponse{
+		PostID:     post.ID.Value(),
+		Reactions:  reactionCountsPayload(counts),
+		MyReaction: myReaction,
+	}
+}
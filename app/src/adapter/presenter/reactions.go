@@ -0,0 +1,16 @@
+package presenter
+
+import (
+	"app/src/domain/entities"
+	"app/src/usecase"
+)
+
+// reactionCountsPayload はリアクションの種類別の件数と合計をレスポンス形式に整形します。
+func reactionCountsPayload(counts entities.ReactionCounts) usecase.ReactionCountsPayload {
+	return usecase.ReactionCountsPayload{
+		Resonate: counts.Resonate,
+		WantToGo: counts.WantToGo,
+		Helpful:  counts.Helpful,
+		Total:    counts.Total(),
+	}
+}
@@ -35,13 +35,21 @@ func (p *registerSpotPostPresenter) buildOutput(message string, hasExistingInfo
 				Latitude:  spot.Latitude.Value(),
 				Longitude: spot.Longitude.Value(),
 			},
+			Attributes: spotAttributesPayload(spot),
 		},
 		Post: &usecase.RegisterSpotPostPostPayload{
-			ID:       post.ID.Value(),
-			UserName: post.UserName.String(),
-			ImageURL: post.ImageURL.String(),
-			Caption:  post.Caption.String(),
-			PostedAt: post.PostedAt.UTC().Format(time.RFC3339),
+			ID:           post.ID.Value(),
+			UserName:     post.UserName.String(),
+			ImageURL:     post.ImageURL.String(),
+			Photos:       postPhotosPayload(post),
+			Reactions:    reactionCountsPayload(post.Reactions),
+			CommentCount: post.CommentCount,
+			Rating:       postRatingPayload(post.Rating),
+			Visibility:   post.Visibility.String(),
+			Caption:      post.Caption.String(),
+			PostedAt:     post.PostedAt.UTC().Format(time.RFC3339),
+			Tags:         postTagsPayload(post),
+			Mentions:     postMentionsPayload(post),
 		},
 	}
 }
@@ -0,0 +1,22 @@
+package presenter
+
+import (
+	"app/src/domain/entities"
+	"app/src/usecase"
+)
+
+// reportSpotClosurePresenterは、閉店報告後のスポットの状態と報告者数をJSONレスポンス形式に整形します。
+type reportSpotClosurePresenter struct{}
+
+func NewReportSpotClosurePresenter() usecase.ReportSpotClosurePresenter {
+	return &reportSpotClosurePresenter{}
+}
+
+func (p *reportSpotClosurePresenter) Output(spot *entities.Spot, reports, threshold int) *usecase.ReportSpotClosureResponse {
+	return &usecase.ReportSpotClosureResponse{
+		SpotID:    spot.ID.Value(),
+		Status:    string(spot.Status),
+		Reports:   reports,
+		Threshold: threshold,
+	}
+}
@@ -0,0 +1,21 @@
+package presenter
+
+import (
+	"time"
+
+	"app/src/usecase"
+)
+
+// requestAccountDeletionPresenterは、退会の申請時刻と完全に削除される予定時刻をJSONレスポンス形式に整形します。
+type requestAccountDeletionPresenter struct{}
+
+func NewRequestAccountDeletionPresenter() usecase.RequestAccountDeletionPresenter {
+	return &requestAccountDeletionPresenter{}
+}
+
+func (p *requestAccountDeletionPresenter) Output(requestedAt, purgeAfter time.Time) *usecase.RequestAccountDeletionResponse {
+	return &usecase.RequestAccountDeletionResponse{
+		DeletionRequestedAt: requestedAt.UTC().Format(time.RFC3339),
+		PurgeAfter:          purgeAfter.UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,21 @@
+package presenter
+
+import (
+	"app/src/domain/entities"
+	"app/src/usecase"
+)
+
+// restrictUserPresenterは、ブロック・ミュートとその解除の結果をJSONレスポンス形式に整形します。
+type restrictUserPresenter struct{}
+
+func NewRestrictUserPresenter() usecase.RestrictUserPresenter {
+	return &restrictUserPresenter{}
+}
+
+func (p *restrictUserPresenter) Output(user *entities.User, kind entities.UserRestrictionKind, restricted bool) *usecase.RestrictUserResponse {
+	return &usecase.RestrictUserResponse{
+		User:       publicUserSummaryPayload(user),
+		Kind:       string(kind),
+		Restricted: restricted,
+	}
+}
@@ -0,0 +1,78 @@
+package presenter
+
+import (
+	"html"
+	"strings"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+)
+
+const (
+	highlightOpen  = "<mark>"
+	highlightClose = "</mark>"
+)
+
+// searchSpotsPresenterは、店名検索の結果をスコア順のJSONレスポンス形式に整形し、一致箇所をハイライトします。
+type searchSpotsPresenter struct{}
+
+func NewSearchSpotsPresenter() usecase.SearchSpotsPresenter {
+	return &searchSpotsPresenter{}
+}
+
+func (p *searchSpotsPresenter) Output(query value_objects.SpotSearchQuery, hits []entities.SpotSearchHit) *usecase.SearchSpotsResponse {
+	results := make([]usecase.SpotSearchPayload, 0, len(hits))
+	for _, hit := range hits {
+		results = append(results, usecase.SpotSearchPayload{
+			Spot: usecase.SpotSearchSpotPayload{
+				ID:     hit.Spot.ID.Value(),
+				Name:   hit.Spot.Name.String(),
+				MeshID: hit.Spot.MeshID.String(),
+				Location: usecase.SpotSearchLocation{
+					Latitude:  hit.Spot.Latitude.Value(),
+					Longitude: hit.Spot.Longitude.Value(),
+				},
+				Attributes: spotAttributesPayload(hit.Spot),
+			},
+			HighlightedName: highlightMatch(hit.Spot.Name.String(), query.String()),
+			Score:           hit.Score,
+			DistanceKm:      hit.DistanceKm,
+		})
+	}
+
+	return &usecase.SearchSpotsResponse{
+		Query:   query.String(),
+		Results: results,
+	}
+}
+
+// highlightMatch は、正規化後の店名でキーワードが一致した範囲を、元の店名の上で <mark> で囲みます。
+// 正規化は1文字→1文字の変換なので、正規化後の rune 位置をそのまま元の店名に適用できます。
+// 結果は HTML として描画される前提のため、店名の各区間はエスケープしてから <mark> を挿入します。
+// トライグラム類似度のみでヒットし部分一致がない場合は、エスケープした店名をそのまま返します。
+func highlightMatch(name, normalizedQuery string) string {
+	original := []rune(name)
+	normalized := []rune(value_objects.NormalizeSearchText(name))
+	q := []rune(normalizedQuery)
+	if len(q) == 0 || len(normalized) != len(original) {
+		return html.EscapeString(name)
+	}
+
+	var b strings.Builder
+	last := 0
+	for i := 0; i+len(q) <= len(normalized); {
+		if string(normalized[i:i+len(q)]) != normalizedQuery {
+			i++
+			continue
+		}
+		b.WriteString(html.EscapeString(string(original[last:i])))
+		b.WriteString(highlightOpen)
+		b.WriteString(html.EscapeString(string(original[i : i+len(q)])))
+		b.WriteString(highlightClose)
+		i += len(q)
+		last = i
+	}
+	b.WriteString(html.EscapeString(string(original[last:])))
+	return b.String()
+}
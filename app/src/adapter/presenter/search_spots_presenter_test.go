@@ -0,0 +1,81 @@
+package presenter
+
+import (
+	"testing"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchSpotsPresenter_Output(t *testing.T) {
+	ramen, _ := entities.NewSpot(1, "ラーメン二郎 ﾗｰﾒﾝ館", 35.6467, 139.7101, 1)
+	cafe, _ := entities.NewSpot(2, "Cafe ＲＡＭＥＮ", 35.6467, 139.7102, 1)
+	fuzzy, _ := entities.NewSpot(3, "らあめん屋", 35.6467, 139.7103, 1)
+	query, _ := value_objects.NewSpotSearchQuery("らーめん")
+	latinQuery, _ := value_objects.NewSpotSearchQuery("Ramen")
+	km := 0.4
+
+	p := NewSearchSpotsPresenter()
+
+	resp := p.Output(query, []entities.SpotSearchHit{
+		{Spot: ramen, Score: 1.8, DistanceKm: &km},
+		{Spot: fuzzy, Score: 0.3},
+	})
+	if assert.Len(t, resp.Results, 2) {
+		// 全角カタカナ・半角カナのどちらの表記でも、元の表記のままハイライトされる
+		assert.Equal(t, "<mark>ラーメン</mark>二郎 <mark>ﾗｰﾒﾝ</mark>館", resp.Results[0].HighlightedName)
+		assert.Equal(t, 0.4, *resp.Results[0].DistanceKm)
+		// 部分一致しない（類似度のみでヒットした）店名はそのまま返す
+		assert.Equal(t, "らあめん屋", resp.Results[1].HighlightedName)
+		assert.Nil(t, resp.Results[1].DistanceKm)
+	}
+
+	resp = p.Output(latinQuery, []entities.SpotSearchHit{{Spot: cafe, Score: 1.2}})
+	assert.Equal(t, "Cafe <mark>ＲＡＭＥＮ</mark>", resp.Results[0].HighlightedName)
+}
+
+// 店名に含まれる HTML の特殊文字は、ハイライトの内外を問わずエスケープされる
+func TestSearchSpotsPresenter_EscapesName(t *testing.T) {
+	p := NewSearchSpotsPresenter()
+	tests := []struct {
+		name     string
+		spotName string
+		query    string
+		want     string
+	}{
+		{
+			name:     "【正常系】一致箇所の外のタグと属性値の引用符をエスケープする",
+			spotName: `<img src=x onerror="alert(1)">ラーメン`,
+			query:    "らーめん",
+			want:     `&lt;img src=x onerror=&#34;alert(1)&#34;&gt;<mark>ラーメン</mark>`,
+		},
+		{
+			name:     "【正常系】一致箇所の内側の & もエスケープする",
+			spotName: "Fish & Chips",
+			query:    "h & c",
+			want:     "Fis<mark>h &amp; C</mark>hips",
+		},
+		{
+			name:     "【正常系】部分一致しない店名もエスケープして返す",
+			spotName: `"Bar" <b>`,
+			query:    "らーめん",
+			want:     `&#34;Bar&#34; &lt;b&gt;`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spot, err := entities.NewSpot(1, tt.spotName, 35.6467, 139.7101, 1)
+			if !assert.NoError(t, err) {
+				return
+			}
+			query, _ := value_objects.NewSpotSearchQuery(tt.query)
+
+			resp := p.Output(query, []entities.SpotSearchHit{{Spot: spot, Score: 1}})
+
+			assert.Equal(t, tt.want, resp.Results[0].HighlightedName)
+		})
+	}
+}
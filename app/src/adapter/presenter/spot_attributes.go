@@ -0,0 +1,28 @@
+package presenter
+
+import (
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+)
+
+// spotAttributesPayloadは、スポットの付帯情報をレスポンス形式に整形します。
+// 未登録の項目（空文字・価格帯0・営業時間なし）は null として返します。
+func spotAttributesPayload(spot *entities.Spot) usecase.SpotAttributesPayload {
+	var out usecase.SpotAttributesPayload
+	if spot.Category != value_objects.CategoryUnknown {
+		category := spot.Category.String()
+		out.Category = &category
+	}
+	if address := spot.Address.String(); address != "" {
+		out.Address = &address
+	}
+	if spot.PriceRange != value_objects.PriceRangeUnknown {
+		priceRange := spot.PriceRange.Int()
+		out.PriceRange = &priceRange
+	}
+	if !spot.OpeningHours.IsEmpty() {
+		out.OpeningHours = spot.OpeningHours.Weekly()
+	}
+	return out
+}
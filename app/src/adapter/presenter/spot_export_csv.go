@@ -0,0 +1,52 @@
+package presenter
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"app/src/usecase"
+)
+
+// csvSpotExporterは、スポットを表計算ソフトで開ける CSV（ヘッダー付き）として書き出します。
+type csvSpotExporter struct{}
+
+func (e *csvSpotExporter) ContentType() string   { return "text/csv; charset=utf-8" }
+func (e *csvSpotExporter) FileExtension() string { return "csv" }
+
+func (e *csvSpotExporter) Export(w io.Writer, items []usecase.UserSpotDomainItem) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"spot_id", "name", "latitude", "longitude", "mesh_id", "category", "address", "caption", "image_url", "posted_at"}); err != nil {
+		return err
+	}
+	for _, item := range items {
+		rec := newExportRecord(item)
+		err := cw.Write([]string{
+			strconv.Itoa(rec.SpotID),
+			csvText(rec.Name),
+			strconv.FormatFloat(rec.Latitude, 'f', -1, 64),
+			strconv.FormatFloat(rec.Longitude, 'f', -1, 64),
+			rec.MeshID,
+			csvText(rec.Category),
+			csvText(rec.Address),
+			csvText(rec.Caption),
+			csvText(rec.ImageURL),
+			rec.PostedAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvText は、ユーザーが入力した文字列が表計算ソフトで数式として解釈されないよう、
+// 先頭が = + - @ の場合に ' を前置します（CSV インジェクション対策）。数値の列には使いません。
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
@@ -0,0 +1,77 @@
+package presenter
+
+import (
+	"encoding/json"
+	"io"
+
+	"app/src/usecase"
+)
+
+// geoJSONSpotExporterは、スポットを Point Feature の FeatureCollection として書き出します（RFC 7946）。
+type geoJSONSpotExporter struct{}
+
+type geoJSONExportFeature struct {
+	Type     string `json:"type"`
+	Geometry struct {
+		Type        string     `json:"type"`
+		Coordinates [2]float64 `json:"coordinates"`
+	} `json:"geometry"`
+	Properties struct {
+		SpotID   int     `json:"spot_id"`
+		Name     string  `json:"name"`
+		MeshID   string  `json:"mesh_id"`
+		Category *string `json:"category"`
+		Address  *string `json:"address"`
+		Caption  *string `json:"caption"`
+		ImageURL *string `json:"image_url"`
+		PostedAt *string `json:"posted_at"`
+	} `json:"properties"`
+}
+
+func (e *geoJSONSpotExporter) ContentType() string   { return "application/geo+json" }
+func (e *geoJSONSpotExporter) FileExtension() string { return "geojson" }
+
+func (e *geoJSONSpotExporter) Export(w io.Writer, items []usecase.UserSpotDomainItem) error {
+	if _, err := io.WriteString(w, `{"type":"FeatureCollection","features":[`); err != nil {
+		return err
+	}
+	for i, item := range items {
+		rec := newExportRecord(item)
+
+		var f geoJSONExportFeature
+		f.Type = "Feature"
+		f.Geometry.Type = "Point"
+		// GeoJSON の座標は [経度, 緯度] の順
+		f.Geometry.Coordinates = [2]float64{rec.Longitude, rec.Latitude}
+		f.Properties.SpotID = rec.SpotID
+		f.Properties.Name = rec.Name
+		f.Properties.MeshID = rec.MeshID
+		f.Properties.Category = nullableString(rec.Category)
+		f.Properties.Address = nullableString(rec.Address)
+		f.Properties.Caption = nullableString(rec.Caption)
+		f.Properties.ImageURL = nullableString(rec.ImageURL)
+		f.Properties.PostedAt = nullableString(rec.PostedAt)
+
+		b, err := json.Marshal(f)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "]}\n")
+	return err
+}
+
+func nullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,60 @@
+package presenter
+
+import (
+	"encoding/xml"
+	"io"
+
+	"app/src/usecase"
+)
+
+// gpxSpotExporterは、スポットを GPS アプリで読み込める GPX 1.1 のウェイポイントとして書き出します。
+type gpxSpotExporter struct{}
+
+type gpxWaypoint struct {
+	XMLName   xml.Name `xml:"wpt"`
+	Latitude  float64  `xml:"lat,attr"`
+	Longitude float64  `xml:"lon,attr"`
+	Time      string   `xml:"time,omitempty"`
+	Name      string   `xml:"name"`
+	Desc      string   `xml:"desc,omitempty"`
+	Link      *gpxLink `xml:"link,omitempty"`
+	Type      string   `xml:"type,omitempty"`
+}
+
+type gpxLink struct {
+	Href string `xml:"href,attr"`
+}
+
+func (e *gpxSpotExporter) ContentType() string   { return "application/gpx+xml" }
+func (e *gpxSpotExporter) FileExtension() string { return "gpx" }
+
+func (e *gpxSpotExporter) Export(w io.Writer, items []usecase.UserSpotDomainItem) error {
+	if _, err := io.WriteString(w, xml.Header+`<gpx version="1.1" creator="TRAPIZZINO" xmlns="http://www.topografix.com/GPX/1/1">`); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	for _, item := range items {
+		rec := newExportRecord(item)
+		wpt := gpxWaypoint{
+			Latitude:  rec.Latitude,
+			Longitude: rec.Longitude,
+			Time:      rec.PostedAt,
+			Name:      rec.Name,
+			Desc:      rec.Caption,
+			Type:      rec.Category,
+		}
+		if rec.ImageURL != "" {
+			wpt.Link = &gpxLink{Href: rec.ImageURL}
+		}
+		if err := enc.Encode(wpt); err != nil {
+			return err
+		}
+	}
+	if err := enc.Flush(); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "</gpx>\n")
+	return err
+}
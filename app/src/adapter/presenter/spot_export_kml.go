@@ -0,0 +1,72 @@
+package presenter
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+
+	"app/src/usecase"
+)
+
+// kmlSpotExporterは、スポットを Google マイマップ等で読み込める KML 2.2 の Placemark として書き出します。
+type kmlSpotExporter struct{}
+
+type kmlPlacemark struct {
+	XMLName     xml.Name      `xml:"Placemark"`
+	Name        string        `xml:"name"`
+	Description string        `xml:"description,omitempty"`
+	TimeStamp   *kmlTimeStamp `xml:"TimeStamp,omitempty"`
+	Data        []kmlData     `xml:"ExtendedData>Data"`
+	Coordinates string        `xml:"Point>coordinates"`
+}
+
+type kmlTimeStamp struct {
+	When string `xml:"when"`
+}
+
+type kmlData struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+}
+
+func (e *kmlSpotExporter) ContentType() string   { return "application/vnd.google-earth.kml+xml" }
+func (e *kmlSpotExporter) FileExtension() string { return "kml" }
+
+func (e *kmlSpotExporter) Export(w io.Writer, items []usecase.UserSpotDomainItem) error {
+	if _, err := io.WriteString(w, xml.Header+`<kml xmlns="http://www.opengis.net/kml/2.2"><Document><name>TRAPIZZINO</name>`); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	for _, item := range items {
+		rec := newExportRecord(item)
+		pm := kmlPlacemark{
+			Name:        rec.Name,
+			Description: rec.Caption,
+			Data:        []kmlData{{Name: "spot_id", Value: strconv.Itoa(rec.SpotID)}},
+			// KML の座標は「経度,緯度」の順
+			Coordinates: strconv.FormatFloat(rec.Longitude, 'f', -1, 64) + "," + strconv.FormatFloat(rec.Latitude, 'f', -1, 64),
+		}
+		if rec.PostedAt != "" {
+			pm.TimeStamp = &kmlTimeStamp{When: rec.PostedAt}
+		}
+		for _, d := range []kmlData{
+			{Name: "category", Value: rec.Category},
+			{Name: "address", Value: rec.Address},
+			{Name: "image_url", Value: rec.ImageURL},
+		} {
+			if d.Value != "" {
+				pm.Data = append(pm.Data, d)
+			}
+		}
+		if err := enc.Encode(pm); err != nil {
+			return err
+		}
+	}
+	if err := enc.Flush(); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "</Document></kml>\n")
+	return err
+}
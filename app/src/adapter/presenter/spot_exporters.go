@@ -0,0 +1,50 @@
+package presenter
+
+import (
+	"time"
+
+	"app/src/usecase"
+)
+
+// NewSpotExportersは、GET /v1/users/me/export で選択できる書き出し形式の一覧を返します。
+// キーはクエリパラメータ format の値です。
+func NewSpotExporters() map[string]usecase.SpotExporter {
+	return map[string]usecase.SpotExporter{
+		"geojson": &geoJSONSpotExporter{},
+		"kml":     &kmlSpotExporter{},
+		"gpx":     &gpxSpotExporter{},
+		"csv":     &csvSpotExporter{},
+	}
+}
+
+// exportRecordは、各形式に共通する1スポット分の書き出し項目です。
+type exportRecord struct {
+	SpotID    int
+	Name      string
+	MeshID    string
+	Latitude  float64
+	Longitude float64
+	Category  string
+	Address   string
+	Caption   string
+	ImageURL  string
+	PostedAt  string
+}
+
+func newExportRecord(item usecase.UserSpotDomainItem) exportRecord {
+	rec := exportRecord{
+		SpotID:    item.Spot.ID.Value(),
+		Name:      item.Spot.Name.String(),
+		MeshID:    item.Spot.MeshID.String(),
+		Latitude:  item.Spot.Latitude.Value(),
+		Longitude: item.Spot.Longitude.Value(),
+		Category:  item.Spot.Category.String(),
+		Address:   item.Spot.Address.String(),
+	}
+	if item.Post != nil {
+		rec.Caption = item.Post.Caption.String()
+		rec.ImageURL = item.Post.ImageURL.String()
+		rec.PostedAt = item.Post.PostedAt.UTC().Format(time.RFC3339)
+	}
+	return rec
+}
@@ -0,0 +1,122 @@
+package presenter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func exportTestItems() []usecase.UserSpotDomainItem {
+	udon, _ := entities.NewSpot(1, "恵比寿うどん & そば", 35.6467, 139.7101, 2)
+	_ = udon.SetAttributes("udon", "東京都渋谷区恵比寿1-1-1", 1, nil)
+	pizza, _ := entities.NewSpot(2, "中目黒ピッツァ", 35.644, 139.699, 2)
+	post, _ := entities.NewPost(10, 2, 1, "local_malloy", "https://example.com/udon.jpg", "出汁が<絶品>", time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC))
+
+	return []usecase.UserSpotDomainItem{
+		{Spot: udon, Post: post},
+		{Spot: pizza},
+	}
+}
+
+func TestSpotExporters_GeoJSON(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, NewSpotExporters()["geojson"].Export(&buf, exportTestItems()))
+
+	var fc struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Geometry struct {
+				Coordinates []float64 `json:"coordinates"`
+			} `json:"geometry"`
+			Properties map[string]any `json:"properties"`
+		} `json:"features"`
+	}
+	if assert.NoError(t, json.Unmarshal(buf.Bytes(), &fc)) && assert.Len(t, fc.Features, 2) {
+		assert.Equal(t, "FeatureCollection", fc.Type)
+		assert.Equal(t, []float64{139.7101, 35.6467}, fc.Features[0].Geometry.Coordinates)
+		assert.Equal(t, "出汁が<絶品>", fc.Features[0].Properties["caption"])
+		assert.Equal(t, "2026-03-02T09:00:00Z", fc.Features[0].Properties["posted_at"])
+		assert.Nil(t, fc.Features[1].Properties["caption"])
+	}
+}
+
+func TestSpotExporters_KML(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, NewSpotExporters()["kml"].Export(&buf, exportTestItems()))
+
+	var doc struct {
+		Placemarks []struct {
+			Name        string `xml:"name"`
+			Description string `xml:"description"`
+			When        string `xml:"TimeStamp>when"`
+			Coordinates string `xml:"Point>coordinates"`
+		} `xml:"Document>Placemark"`
+	}
+	if assert.NoError(t, xml.Unmarshal(buf.Bytes(), &doc)) && assert.Len(t, doc.Placemarks, 2) {
+		assert.Equal(t, "恵比寿うどん & そば", doc.Placemarks[0].Name)
+		assert.Equal(t, "出汁が<絶品>", doc.Placemarks[0].Description)
+		assert.Equal(t, "2026-03-02T09:00:00Z", doc.Placemarks[0].When)
+		assert.Equal(t, "139.7101,35.6467", doc.Placemarks[0].Coordinates)
+	}
+}
+
+func TestSpotExporters_GPX(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, NewSpotExporters()["gpx"].Export(&buf, exportTestItems()))
+
+	var doc struct {
+		Waypoints []struct {
+			Lat  float64 `xml:"lat,attr"`
+			Lon  float64 `xml:"lon,attr"`
+			Name string  `xml:"name"`
+			Link struct {
+				Href string `xml:"href,attr"`
+			} `xml:"link"`
+		} `xml:"wpt"`
+	}
+	if assert.NoError(t, xml.Unmarshal(buf.Bytes(), &doc)) && assert.Len(t, doc.Waypoints, 2) {
+		assert.Equal(t, 35.6467, doc.Waypoints[0].Lat)
+		assert.Equal(t, 139.7101, doc.Waypoints[0].Lon)
+		assert.Equal(t, "https://example.com/udon.jpg", doc.Waypoints[0].Link.Href)
+		assert.Equal(t, "中目黒ピッツァ", doc.Waypoints[1].Name)
+	}
+}
+
+func TestSpotExporters_CSV(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, NewSpotExporters()["csv"].Export(&buf, exportTestItems()))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if assert.NoError(t, err) && assert.Len(t, records, 3) {
+		assert.Equal(t, "spot_id", records[0][0])
+		assert.Equal(t, []string{"1", "恵比寿うどん & そば", "35.6467", "139.7101"}, records[1][:4])
+		assert.Equal(t, "udon", records[1][5])
+		assert.Equal(t, "", records[2][7])
+	}
+}
+
+// 先頭が = + - @ の文字列は数式として実行されないよう ' を前置し、負の座標はそのまま書き出す
+func TestSpotExporters_CSVEscapesFormulas(t *testing.T) {
+	spot, _ := entities.NewSpot(3, "=HYPERLINK(\"https://evil.example\")", -33.8688, -151.2093, 2)
+	_ = spot.SetAttributes("", "@SUM(A1)", 0, nil)
+	post, _ := entities.NewPost(11, 2, 3, "local_malloy", "", "-2+3", time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC))
+
+	var buf bytes.Buffer
+	assert.NoError(t, NewSpotExporters()["csv"].Export(&buf, []usecase.UserSpotDomainItem{{Spot: spot, Post: post}}))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if assert.NoError(t, err) && assert.Len(t, records, 2) {
+		assert.Equal(t, "'=HYPERLINK(\"https://evil.example\")", records[1][1])
+		assert.Equal(t, []string{"-33.8688", "-151.2093"}, records[1][2:4])
+		assert.Equal(t, "'@SUM(A1)", records[1][6])
+		assert.Equal(t, "'-2+3", records[1][7])
+	}
+}
@@ -0,0 +1,21 @@
+package presenter
+
+import (
+	"app/src/domain/entities"
+	"app/src/usecase"
+)
+
+// updateSpotStatusPresenterは、運営者によるスポットの状態変更の結果をJSONレスポンス形式に整形します。
+type updateSpotStatusPresenter struct{}
+
+func NewUpdateSpotStatusPresenter() usecase.UpdateSpotStatusPresenter {
+	return &updateSpotStatusPresenter{}
+}
+
+func (p *updateSpotStatusPresenter) Output(spot *entities.Spot, previous entities.SpotStatus) *usecase.UpdateSpotStatusResponse {
+	return &usecase.UpdateSpotStatusResponse{
+		SpotID:         spot.ID.Value(),
+		PreviousStatus: string(previous),
+		Status:         string(spot.Status),
+	}
+}
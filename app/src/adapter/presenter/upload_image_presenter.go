@@ -0,0 +1,45 @@
+package presenter
+
+import (
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/usecase"
+)
+
+// uploadImagePresenterは、保存した画像のメタデータをJSONレスポンス形式に整形します。
+type uploadImagePresenter struct{}
+
+func NewUploadImagePresenter() usecase.UploadImagePresenter {
+	return &uploadImagePresenter{}
+}
+
+func (p *uploadImagePresenter) Output(image *entities.Image) *usecase.UploadImageResponse {
+	var location *usecase.ImageLocationPayload
+	if image.GeoTag != nil {
+		location = &usecase.ImageLocationPayload{
+			Latitude:  image.GeoTag.Latitude.Value(),
+			Longitude: image.GeoTag.Longitude.Value(),
+		}
+	}
+	var capturedAt *string
+	if image.CapturedAt != nil {
+		s := image.CapturedAt.Format(time.RFC3339)
+		capturedAt = &s
+	}
+
+	return &usecase.UploadImageResponse{
+		ID:          image.ID.Value(),
+		URL:         image.URL.String(),
+		ContentType: image.ContentType,
+		Size:        image.Size,
+		Width:       image.Width,
+		Height:      image.Height,
+		Images: imageVariantsPayload(image.URL, entities.ImageVariants{
+			Thumb:  image.Thumb.URL,
+			Medium: image.Medium.URL,
+		}),
+		Location:   location,
+		CapturedAt: capturedAt,
+	}
+}
@@ -0,0 +1,44 @@
+package presenter
+
+import (
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/usecase"
+)
+
+// userProfilePresenterは、自分のプロフィール（取得・更新の結果）をJSONレスポンス形式に整形します。
+type userProfilePresenter struct{}
+
+func NewUserProfilePresenter() usecase.UserProfilePresenter {
+	return &userProfilePresenter{}
+}
+
+func (p *userProfilePresenter) Output(user *entities.User) *usecase.UserProfileResponse {
+	return &usecase.UserProfileResponse{
+		ID:          user.ID.Value(),
+		Username:    user.Username.String(),
+		Email:       user.Email.String(),
+		DisplayName: user.DisplayName.String(),
+		Bio:         user.Bio.String(),
+		HomeArea:    user.HomeArea.String(),
+		Avatar:      userAvatarPayload(user.Avatar),
+		CreatedAt:   user.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// userAvatarPayload はアイコン画像を整形します。未設定なら nil を返します。
+func userAvatarPayload(avatar entities.UserAvatar) *usecase.UserAvatarPayload {
+	if !avatar.IsSet() {
+		return nil
+	}
+	var thumb *string
+	if url := avatar.Thumb.String(); url != "" {
+		thumb = &url
+	}
+	return &usecase.UserAvatarPayload{
+		ImageID:  avatar.ImageID.Value(),
+		URL:      avatar.URL.String(),
+		ThumbURL: thumb,
+	}
+}
@@ -0,0 +1,33 @@
+package entities
+
+import (
+	"context"
+	"time"
+
+	"app/src/domain/value_objects"
+)
+
+// AccountPurgeResult は退会したユーザー1人を完全に削除した結果です。
+type AccountPurgeResult struct {
+	UserID value_objects.ID
+	// ReassignedSpots は王座を他の投稿者（最新の現行の投稿者）へ移したスポットの数です。
+	ReassignedSpots int
+	// DeletedSpots は本人の投稿しかなかったため削除したスポットの数です。
+	DeletedSpots int
+}
+
+// AccountDeletionRepository は退会の申請（猶予期間）と、猶予期間を過ぎたユーザーの完全な削除を扱います。
+type AccountDeletionRepository interface {
+	// RequestDeletion は退会を申請した時刻を記録し、記録された時刻を返します。
+	// 申請済みの場合は最初の申請時刻を保ったまま返します。
+	RequestDeletion(ctx context.Context, userID value_objects.ID, at time.Time) (time.Time, error)
+	// CancelDeletion は退会の申請を取り消します（申請していなければ何もしない）。
+	CancelDeletion(ctx context.Context, userID value_objects.ID) error
+	// FindDue は cutoff 以前に退会を申請したユーザーを、申請の古い順に最大 limit 件返します。
+	FindDue(ctx context.Context, cutoff time.Time, limit int) ([]value_objects.ID, error)
+	// Purge は、cutoff 以前に退会を申請したままのユーザーを単一トランザクションで削除します。
+	// 王座を持つスポットは、他の投稿者がいれば最新の現行の投稿者へ王座を移し、本人の投稿しかなければ削除します。
+	// 投稿・リアクション・コメント・フォローなど本人の記録はユーザーの削除に連鎖して消えます。
+	// 申請が取り消されていた場合は何もせず nil を返します。
+	Purge(ctx context.Context, userID value_objects.ID, cutoff time.Time) (*AccountPurgeResult, error)
+}
@@ -0,0 +1,98 @@
+package entities
+
+import (
+	"context"
+	"time"
+
+	"app/src/domain/value_objects"
+)
+
+// CommentStatus はコメントの公開状態です。hidden のコメントは投稿者本人にしか表示されません。
+type CommentStatus string
+
+const (
+	CommentVisible CommentStatus = "visible"
+	CommentHidden  CommentStatus = "hidden"
+)
+
+// Comment は投稿へのコメントです。返信は1階層までで、ParentID は常にトップレベルのコメントを指します。
+type Comment struct {
+	ID       value_objects.ID
+	PostID   value_objects.ID
+	UserID   value_objects.ID
+	Username value_objects.Username
+	// ParentID はトップレベルのコメントでは nil です。
+	ParentID *value_objects.ID
+	Body     value_objects.CommentBody
+
+	Status CommentStatus
+	// ModerationReason は非表示にした理由（自動判定・運営者の判断）です。
+	ModerationReason string
+
+	CreatedAt time.Time
+	// UpdatedAt は本文を編集した時刻です（未編集なら nil）。
+	UpdatedAt *time.Time
+	// DeletedAt は投稿者が削除した時刻です。返信が付いているコメントは返信を残すため、本文を伏せて一覧に残します。
+	DeletedAt *time.Time
+
+	// ReplyCount は表示対象の返信の件数です（トップレベルのコメントのみ）。
+	ReplyCount int
+	// Replies は一覧で先頭に添える返信です（古い順）。
+	Replies []*Comment
+}
+
+func NewComment(postID, userID int, parentID *int, body string, createdAt time.Time) (*Comment, error) {
+	pid, err := value_objects.NewID(postID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := value_objects.NewID(userID)
+	if err != nil {
+		return nil, err
+	}
+	b, err := value_objects.NewCommentBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Comment{PostID: pid, UserID: uid, Body: b, Status: CommentVisible, CreatedAt: createdAt}
+	if parentID != nil {
+		parent, err := value_objects.NewID(*parentID)
+		if err != nil {
+			return nil, err
+		}
+		c.ParentID = &parent
+	}
+	return c, nil
+}
+
+// IsReply は返信（2階層目）のコメントかどうかを返します。
+func (c *Comment) IsReply() bool {
+	return c.ParentID != nil
+}
+
+// IsDeleted は投稿者が削除したコメントかどうかを返します。
+func (c *Comment) IsDeleted() bool {
+	return c.DeletedAt != nil
+}
+
+// CommentCursor はコメント一覧のカーソルページングにおける基準点です。
+// コメントは会話の流れに沿って古い順（created_at, id）に並び、次ページはこの基準点より「新しい」コメントから始まります。
+type CommentCursor struct {
+	CreatedAt time.Time
+	ID        value_objects.ID
+}
+
+// CommentRepository の一覧系は、viewerID 本人のものを除き、非表示のコメントと、返信のない削除済みコメントを返しません。
+type CommentRepository interface {
+	Create(ctx context.Context, comment *Comment) (*Comment, error)
+	// FindByID は削除済み・非表示のコメントも返します（存在しなければ nil）。
+	FindByID(ctx context.Context, id value_objects.ID) (*Comment, error)
+	// Update は本文・公開状態・削除時刻を保存します。
+	Update(ctx context.Context, comment *Comment) error
+	// FindByPost は投稿のトップレベルのコメントを古い順に返し、各コメントに先頭 repliesPerComment 件の返信と返信数を添えます。
+	// FindByPost / FindReplies は viewerID がブロック・ミュートしているユーザー（ブロックされている相手を含む）のコメントを除きます。
+	FindByPost(ctx context.Context, postID, viewerID value_objects.ID, after *CommentCursor, limit, repliesPerComment int) ([]*Comment, error)
+	// FindReplies はコメントへの返信を古い順に返します。
+	FindReplies(ctx context.Context, parentID, viewerID value_objects.ID, after *CommentCursor, limit int) ([]*Comment, error)
+}
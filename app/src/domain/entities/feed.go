@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"context"
+
+	"app/src/domain/value_objects"
+)
+
+// FeedItem はアクティビティフィードの1件（共鳴ユーザーの現行の投稿と、その投稿先のスポット）です。
+type FeedItem struct {
+	Post *Post
+	Spot *Spot
+	// IsOverwrite は、同じユーザーが同じスポットに残していた過去の投稿を上書きした投稿かどうかです。
+	IsOverwrite bool
+	// DistanceKm は FeedCriteria で現在地が指定された場合の、現在地からスポットまでの距離です。
+	DistanceKm *float64
+}
+
+// FeedCriteria はアクティビティフィードの取得条件です。
+// Latitude / Longitude が両方指定された場合は、各スポットまでの距離を合わせて返します。
+// ViewerID はフィードの閲覧者で、閲覧者とブロック・ミュートの関係にあるユーザーの投稿を除くために使います。
+type FeedCriteria struct {
+	ViewerID  value_objects.ID
+	UserIDs   []value_objects.ID
+	Before    *PostCursor
+	Latitude  *value_objects.Latitude
+	Longitude *value_objects.Longitude
+	Limit     int
+}
+
+// FeedRepository は複数ユーザーの最新の投稿をまとめて検索します。
+type FeedRepository interface {
+	// FindByUsers は criteria.UserIDs の現行の投稿（閉店済みのスポットと、閲覧者から隠すユーザーを除く）を新しい順に返します（Before より古いもののみ）。
+	FindByUsers(ctx context.Context, criteria FeedCriteria) ([]FeedItem, error)
+}
@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"context"
+	"time"
+
+	"app/src/domain/value_objects"
+)
+
+// FollowEdge はフォロワー・フォロー中一覧の1件（相手のユーザーとフォローした時刻）です。
+type FollowEdge struct {
+	User       *User
+	FollowedAt time.Time
+}
+
+// FollowCounts はユーザーのフォロワー数とフォロー中の数です。
+type FollowCounts struct {
+	Followers int
+	Following int
+}
+
+// FollowCursor はフォロワー・フォロー中一覧のカーソルページングにおける基準点です（followed_at DESC, user_id DESC の順）。
+type FollowCursor struct {
+	FollowedAt time.Time
+	UserID     value_objects.ID
+}
+
+type FollowRepository interface {
+	// Follow は followerID から followeeID へのフォローを記録します（フォロー済みなら何もしない）。
+	Follow(ctx context.Context, followerID, followeeID value_objects.ID) error
+	// Unfollow はフォローを解除します（フォローしていなければ何もしない）。
+	Unfollow(ctx context.Context, followerID, followeeID value_objects.ID) error
+	CountByUser(ctx context.Context, userID value_objects.ID) (FollowCounts, error)
+	// FindFollowers / FindFollowing はフォローした時刻の新しい順に返します（before より古いもののみ）。
+	// 閲覧者とブロックの関係にある相手、閲覧者がミュートしている相手は一覧に含めません。
+	FindFollowers(ctx context.Context, userID, viewerID value_objects.ID, before *FollowCursor, limit int) ([]FollowEdge, error)
+	FindFollowing(ctx context.Context, userID, viewerID value_objects.ID, before *FollowCursor, limit int) ([]FollowEdge, error)
+	// FindFolloweeIDs はユーザーがフォローしている全ユーザー（ミュート中を除く）のIDを返します（推薦で共鳴者に加える）。
+	FindFolloweeIDs(ctx context.Context, followerID value_objects.ID) ([]value_objects.ID, error)
+}
@@ -0,0 +1,50 @@
+package entities
+
+import (
+	"context"
+	"time"
+
+	"app/src/domain/value_objects"
+)
+
+// TaggedSpot はハッシュタグ付きの投稿を持つスポットと、そのタグでの投稿数です。
+type TaggedSpot struct {
+	Spot           *Spot
+	PostCount      int
+	LatestPostedAt time.Time
+}
+
+// TrendingTag は集計期間内に使われたハッシュタグと、その投稿数・スポット数です。
+type TrendingTag struct {
+	Tag       value_objects.Hashtag
+	PostCount int
+	SpotCount int
+}
+
+// PostMention はキャプションの @ユーザー名 を実在するユーザーに解決したものです（保存時に解決します）。
+type PostMention struct {
+	UserID   value_objects.ID
+	Username value_objects.Username
+}
+
+// Mention は閲覧者をメンションしている投稿と、その投稿先のスポット名です。
+type Mention struct {
+	Post     *Post
+	SpotName value_objects.SpotName
+}
+
+// HashtagRepository はキャプションから抽出したハッシュタグの索引（post_tags）を検索します。
+// 索引の更新は PostRepository が投稿の保存と同じトランザクションで行います。
+type HashtagRepository interface {
+	// FindSpotsByTag はタグ付きの現行の投稿を持つスポット（閉店済みを除く）を、投稿数の多い順に返します。
+	FindSpotsByTag(ctx context.Context, tag value_objects.Hashtag, limit int) ([]TaggedSpot, error)
+	// FindTrending は since 以降の現行の投稿で使われたタグを、投稿数の多い順に返します。
+	FindTrending(ctx context.Context, since time.Time, limit int) ([]TrendingTag, error)
+}
+
+// MentionRepository は保存時に解決したメンション（post_mentions）を検索します。
+type MentionRepository interface {
+	// FindByMentionedUser は userID をメンションしている現行の投稿を新しい順に返します（before より古いもののみ）。
+	// userID がブロック・ミュートしているユーザー（ブロックされている相手を含む）の投稿は除きます。
+	FindByMentionedUser(ctx context.Context, userID value_objects.ID, before *PostCursor, limit int) ([]Mention, error)
+}
@@ -0,0 +1,137 @@
+package entities
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"app/src/domain/value_objects"
+)
+
+// Image は BlobStore に保存されたアップロード画像のメタデータです。
+// 投稿の image_url やプロフィールのアイコンから参照されないまま猶予期間を過ぎた画像は、孤立画像として削除されます。
+type Image struct {
+	ID          value_objects.ID
+	OwnerID     value_objects.ID
+	StorageKey  string
+	URL         value_objects.ImageURL
+	ContentType string
+	Size        int64
+	Width       int
+	Height      int
+	CreatedAt   time.Time
+
+	// Thumb（一覧用の正方形サムネイル）と Medium（推薦カード用）は、生成できない形式では空です。
+	Thumb  ImageVariant
+	Medium ImageVariant
+
+	// GeoTag / CapturedAt は EXIF から読み取った撮影地点・撮影日時で、情報がなければ nil です。
+	// 画像ファイル自体からは取り除いているため、公開せず投稿者本人の座標確認にのみ使います。
+	GeoTag     *ImageGeoTag
+	CapturedAt *time.Time
+}
+
+// ImageGeoTag は画像の撮影地点です。
+type ImageGeoTag struct {
+	Latitude  value_objects.Latitude
+	Longitude value_objects.Longitude
+}
+
+func NewImageGeoTag(lat, lng float64) (*ImageGeoTag, error) {
+	latitude, err := value_objects.NewLatitude(lat)
+	if err != nil {
+		return nil, err
+	}
+	longitude, err := value_objects.NewLongitude(lng)
+	if err != nil {
+		return nil, err
+	}
+	return &ImageGeoTag{Latitude: latitude, Longitude: longitude}, nil
+}
+
+// DistanceMeters は撮影地点から指定地点までの大圏距離（メートル）を返します。
+func (g *ImageGeoTag) DistanceMeters(lat, lng float64) float64 {
+	const earthRadius = 6371000.0
+	lat1, lat2 := g.Latitude.Value()*math.Pi/180, lat*math.Pi/180
+	dLat := lat2 - lat1
+	dLng := (lng - g.Longitude.Value()) * math.Pi / 180
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return earthRadius * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// ImageVariant はアップロード画像から生成した派生サイズ1枚分の保存先です。
+type ImageVariant struct {
+	StorageKey string
+	URL        value_objects.ImageURL
+}
+
+func NewImageVariant(storageKey, url string) (ImageVariant, error) {
+	imageURL, err := value_objects.NewImageURL(url)
+	if err != nil {
+		return ImageVariant{}, err
+	}
+	return ImageVariant{StorageKey: storageKey, URL: imageURL}, nil
+}
+
+// StorageKeys は元画像と派生サイズの保存キーをすべて返します（削除時に使う）。
+func (i *Image) StorageKeys() []string {
+	keys := []string{i.StorageKey}
+	for _, v := range []ImageVariant{i.Thumb, i.Medium} {
+		if v.StorageKey != "" {
+			keys = append(keys, v.StorageKey)
+		}
+	}
+	return keys
+}
+
+// ImageVariants は投稿画像の派生サイズのURLです。
+// 外部URLの画像や派生サイズを持たない画像では空で、利用側は元の image_url で代替します。
+type ImageVariants struct {
+	Thumb  value_objects.ImageURL
+	Medium value_objects.ImageURL
+}
+
+func NewImageVariants(thumbURL, mediumURL string) (ImageVariants, error) {
+	thumb, err := value_objects.NewImageURL(thumbURL)
+	if err != nil {
+		return ImageVariants{}, err
+	}
+	medium, err := value_objects.NewImageURL(mediumURL)
+	if err != nil {
+		return ImageVariants{}, err
+	}
+	return ImageVariants{Thumb: thumb, Medium: medium}, nil
+}
+
+func NewImage(id, ownerID int, storageKey, url, contentType string, size int64) (*Image, error) {
+	imageID, err := value_objects.NewID(id)
+	if err != nil {
+		return nil, err
+	}
+	oID, err := value_objects.NewID(ownerID)
+	if err != nil {
+		return nil, err
+	}
+	imageURL, err := value_objects.NewImageURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Image{
+		ID:          imageID,
+		OwnerID:     oID,
+		StorageKey:  storageKey,
+		URL:         imageURL,
+		ContentType: contentType,
+		Size:        size,
+	}, nil
+}
+
+type ImageRepository interface {
+	Create(ctx context.Context, image *Image) (*Image, error)
+	FindByID(ctx context.Context, id value_objects.ID) (*Image, error)
+	// FindOrphans は createdBefore より前にアップロードされ、どの投稿（上書き済みを含む）のカバー画像・添付画像からも、
+	// プロフィールのアイコン画像からも参照されていない画像を古い順に返します。
+	FindOrphans(ctx context.Context, createdBefore time.Time, limit int) ([]*Image, error)
+	Delete(ctx context.Context, id value_objects.ID) error
+}
@@ -0,0 +1,20 @@
+package entities
+
+import (
+	"context"
+
+	"app/src/domain/value_objects"
+)
+
+// PersonalDataSection は個人データの書き出し1区分（プロフィール・スポット・投稿など）です。
+// JSON は区分ごとの記録をそのまま JSON にしたもので、DB の列名をキーにします。
+type PersonalDataSection struct {
+	Name string
+	JSON []byte
+}
+
+// PersonalDataRepository はユーザー本人に紐づく記録を、書き出し用に区分ごとの JSON として読み出します。
+// パスワードのハッシュなど、本人に開示しない値は含めません。
+type PersonalDataRepository interface {
+	FindSections(ctx context.Context, userID value_objects.ID) ([]PersonalDataSection, error)
+}
@@ -2,9 +2,14 @@ package entities
 
 import (
 	"app/src/domain/value_objects"
+	"errors"
+	"fmt"
 	"time"
 )
 
+// MaxPostImages は1件の投稿に添付できる画像の上限です。
+const MaxPostImages = 5
+
 type Post struct {
 	ID       value_objects.ID
 	UserID   value_objects.ID
@@ -13,6 +18,108 @@ type Post struct {
 	ImageURL value_objects.ImageURL
 	Caption  value_objects.Caption
 	PostedAt time.Time
+
+	// Rating は投稿者による評価（任意）です。
+	Rating PostRating
+
+	// Visibility は投稿の公開範囲です（NewPost の既定は public）。
+	Visibility value_objects.PostVisibility
+
+	// SupersededAt は同じユーザーが同じスポットへ上書き投稿した時刻です。nil の場合は現行の投稿です。
+	// 上書きされた投稿も削除せずに残し、激戦区度（延べ投稿数）の算定に使います。
+	SupersededAt *time.Time
+
+	// ImageVariants は image_url がアップロード画像の場合に引き当てた派生サイズのURLです。
+	ImageVariants ImageVariants
+
+	// Images は添付画像の一覧（表示順）です。先頭がカバー画像で、ImageURL と一致します。
+	Images []PostImage
+
+	// Mentions はキャプションの @ユーザー名 のうち、保存時に実在するユーザーへ解決できたものです（投稿者本人を除く）。
+	Mentions []PostMention
+
+	// Reactions は投稿に付いたリアクションの種類別の件数です。
+	Reactions ReactionCounts
+
+	// CommentCount は表示対象のコメント（返信を含む）の件数です。
+	CommentCount int
+}
+
+// PostRating は投稿者による5段階の総合評価と、味・コスパ・雰囲気の内訳です（いずれも任意、0 は未評価）。
+// 内訳だけの評価はできず、内訳を付ける場合は総合評価も必須です。
+type PostRating struct {
+	Overall    value_objects.Rating
+	Taste      value_objects.Rating
+	Value      value_objects.Rating
+	Atmosphere value_objects.Rating
+}
+
+func NewPostRating(overall, taste, value, atmosphere int) (PostRating, error) {
+	o, err := value_objects.NewRating(overall)
+	if err != nil {
+		return PostRating{}, fmt.Errorf("overall: %w", err)
+	}
+	t, err := value_objects.NewRating(taste)
+	if err != nil {
+		return PostRating{}, fmt.Errorf("taste: %w", err)
+	}
+	v, err := value_objects.NewRating(value)
+	if err != nil {
+		return PostRating{}, fmt.Errorf("value: %w", err)
+	}
+	a, err := value_objects.NewRating(atmosphere)
+	if err != nil {
+		return PostRating{}, fmt.Errorf("atmosphere: %w", err)
+	}
+	if !o.IsRated() && (t.IsRated() || v.IsRated() || a.IsRated()) {
+		return PostRating{}, errors.New("overall rating is required when sub-scores are given")
+	}
+	return PostRating{Overall: o, Taste: t, Value: v, Atmosphere: a}, nil
+}
+
+// IsRated は総合評価が付いているかどうかを返します。
+func (r PostRating) IsRated() bool {
+	return r.Overall.IsRated()
+}
+
+// PostImage は投稿に添付された画像1枚分です。Position 0 がカバー画像です。
+type PostImage struct {
+	Position int
+	URL      value_objects.ImageURL
+	AltText  value_objects.AltText
+	Variants ImageVariants
+}
+
+func NewPostImage(position int, url, altText string) (PostImage, error) {
+	imageURL, err := value_objects.NewImageURL(url)
+	if err != nil {
+		return PostImage{}, err
+	}
+	if imageURL == "" {
+		return PostImage{}, errors.New("image url is required")
+	}
+	alt, err := value_objects.NewAltText(altText)
+	if err != nil {
+		return PostImage{}, err
+	}
+	return PostImage{Position: position, URL: imageURL, AltText: alt}, nil
+}
+
+// SetImages は添付画像を差し替え、先頭の画像をカバー画像（ImageURL）にします。
+func (p *Post) SetImages(images []PostImage) error {
+	if len(images) > MaxPostImages {
+		return fmt.Errorf("a post can have at most %d images", MaxPostImages)
+	}
+	p.Images = make([]PostImage, len(images))
+	for i, img := range images {
+		img.Position = i
+		p.Images[i] = img
+	}
+	p.ImageURL = ""
+	if len(images) > 0 {
+		p.ImageURL = images[0].URL
+	}
+	return nil
 }
 
 // NewPost の引数に spotID (int) を追加し、内部で VO に変換します
@@ -52,14 +159,36 @@ func NewPost(id, userID, spotID int, username, imageURL, caption string, postedA
 		ImageURL: imgURL,
 		Caption:  capVO,
 		PostedAt: postedAt,
+
+		Visibility: value_objects.PostVisibilityPublic,
 	}, nil
 }
 
+// IsCurrent は上書きされていない現行の投稿かどうかを返します。
+func (p *Post) IsCurrent() bool {
+	return p.SupersededAt == nil
+}
+
+// PostCursor は投稿一覧のカーソルページングにおける基準点です。
+// 一覧は posted_at DESC, id DESC の順で並び、次ページはこの基準点より「古い」投稿から始まります。
+type PostCursor struct {
+	PostedAt time.Time
+	ID       value_objects.ID
+}
+
+// PostRepository の FindBySpotID / FindByUserID は現行の投稿のみを返します。
+// 上書きされた過去の投稿を含む全履歴は FindHistoryBySpotID / FindHistoryByUserID で取得します。
+// 検索系のメソッドは viewerID の閲覧者に見える投稿だけを返します（本人の投稿は公開範囲に関わらず見える）。
 type PostRepository interface {
 	Create(post *Post) (*Post, error)
-	FindByID(id value_objects.ID) (*Post, error)
-	FindBySpotID(spotID value_objects.ID) ([]*Post, error)
-	FindByUserID(userID value_objects.ID) ([]*Post, error)
+	// Supersede は、同じユーザーが同じスポットに残している現行の投稿を上書き済みにしたうえで、
+	// post を新しい現行の投稿として作成します（単一トランザクション）。
+	Supersede(post *Post) (*Post, error)
+	FindByID(id, viewerID value_objects.ID) (*Post, error)
+	FindBySpotID(spotID, viewerID value_objects.ID) ([]*Post, error)
+	FindByUserID(userID, viewerID value_objects.ID) ([]*Post, error)
+	FindHistoryBySpotID(spotID, viewerID value_objects.ID) ([]*Post, error)
+	FindHistoryByUserID(userID, viewerID value_objects.ID) ([]*Post, error)
 	Update(post *Post) error
 	Delete(id value_objects.ID) error
 }
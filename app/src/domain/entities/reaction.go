@@ -0,0 +1,77 @@
+package entities
+
+import (
+	"context"
+	"time"
+
+	"app/src/domain/value_objects"
+)
+
+// Reaction は投稿へのリアクション（共鳴・行きたい・参考になった）です。
+// 1人のユーザーが1件の投稿に付けられるのは1種類だけで、付け直すと種類が置き換わります。
+type Reaction struct {
+	PostID    value_objects.ID
+	UserID    value_objects.ID
+	Username  value_objects.Username
+	Kind      value_objects.ReactionKind
+	CreatedAt time.Time
+}
+
+func NewReaction(postID, userID int, kind string, createdAt time.Time) (*Reaction, error) {
+	pid, err := value_objects.NewID(postID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := value_objects.NewID(userID)
+	if err != nil {
+		return nil, err
+	}
+	k, err := value_objects.NewReactionKind(kind)
+	if err != nil {
+		return nil, err
+	}
+	return &Reaction{PostID: pid, UserID: uid, Kind: k, CreatedAt: createdAt}, nil
+}
+
+// ReactionCounts は投稿に付いたリアクションの種類別の件数です。
+type ReactionCounts struct {
+	Resonate int
+	WantToGo int
+	Helpful  int
+}
+
+// Add は kind の件数に n を加えます。
+func (c *ReactionCounts) Add(kind value_objects.ReactionKind, n int) {
+	switch kind {
+	case value_objects.ReactionResonate:
+		c.Resonate += n
+	case value_objects.ReactionWantToGo:
+		c.WantToGo += n
+	case value_objects.ReactionHelpful:
+		c.Helpful += n
+	}
+}
+
+// Total はすべての種類の合計件数です。
+func (c ReactionCounts) Total() int {
+	return c.Resonate + c.WantToGo + c.Helpful
+}
+
+// ReactionCursor はリアクション一覧のカーソルページングにおける基準点です（created_at DESC, user_id DESC の順）。
+type ReactionCursor struct {
+	CreatedAt time.Time
+	UserID    value_objects.ID
+}
+
+type ReactionRepository interface {
+	// Upsert は (post_id, user_id) のリアクションを作成し、既にあれば種類を置き換えます。
+	Upsert(ctx context.Context, reaction *Reaction) error
+	Delete(ctx context.Context, postID, userID value_objects.ID) error
+	// FindByPost は投稿へのリアクションを新しい順に返します。kind が空なら全種類です。
+	FindByPost(ctx context.Context, postID value_objects.ID, kind value_objects.ReactionKind, before *ReactionCursor, limit int) ([]*Reaction, error)
+	// FindByPostAndUser はユーザーが投稿に付けているリアクションを返します（なければ nil）。
+	FindByPostAndUser(ctx context.Context, postID, userID value_objects.ID) (*Reaction, error)
+	CountByPost(ctx context.Context, postID value_objects.ID) (ReactionCounts, error)
+	// CountBySpots はスポットごとに、閲覧者に見える現行の投稿に付いたリアクションの合計件数を返します（推薦スコアの補助指標）。
+	CountBySpots(ctx context.Context, spotIDs []value_objects.ID, viewerID value_objects.ID) (map[int]int, error)
+}
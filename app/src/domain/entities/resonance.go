@@ -0,0 +1,51 @@
+package entities
+
+import (
+	"context"
+	"time"
+
+	"app/src/domain/value_objects"
+)
+
+// ResonanceSort は共鳴者一覧の並び順です。
+type ResonanceSort string
+
+const (
+	// ResonanceSortMatchCount は共通スポット数の多い順（同数なら直近に共通した順）です。
+	ResonanceSortMatchCount ResonanceSort = "match_count"
+	// ResonanceSortRecent は直近に共通した順です。
+	ResonanceSortRecent ResonanceSort = "recent"
+)
+
+// ResonantPeer は閲覧者の共鳴者1人分の詳細です。
+// LastSharedAt は共通スポットのうち、2人目がピンした（＝共通になった）最も新しい時刻です。
+// SharedSpots は共通スポットを直近に共通した順に、ResonanceCriteria.SharedSpotsPerUser 件まで返します。
+type ResonantPeer struct {
+	User         *User
+	MatchCount   int
+	LastSharedAt time.Time
+	SharedSpots  []*Spot
+}
+
+// ResonanceCursor は共鳴者一覧のカーソルページングにおける基準点です。
+// ResonanceSortRecent では MatchCount を使いません。
+type ResonanceCursor struct {
+	MatchCount   int
+	LastSharedAt time.Time
+	UserID       value_objects.ID
+}
+
+// ResonanceCriteria は共鳴者一覧の取得条件です。
+type ResonanceCriteria struct {
+	UserID             value_objects.ID
+	Sort               ResonanceSort
+	After              *ResonanceCursor
+	Limit              int
+	SharedSpotsPerUser int
+}
+
+// ResonanceRepository は共鳴者（現行の投稿先スポットが一致するユーザー）を詳細付きで検索します。
+// 共鳴の判定は SpotRepository.FindResonantUsersWithMatchCount と同じく、互いの現行の投稿の一致で行います（ブロック・ミュートの相手は除く）。
+type ResonanceRepository interface {
+	FindResonantPeers(ctx context.Context, criteria ResonanceCriteria) ([]ResonantPeer, error)
+}
@@ -2,9 +2,21 @@ package entities
 
 import (
     "context"
+    "time"
+
     "app/src/domain/value_objects"
 )
 
+// SpotStatus はスポットの営業状態です。
+// 閉店報告を受けると reported になり、報告数が閾値に達するか運営者が確認すると closed（論理削除）になります。
+type SpotStatus string
+
+const (
+    SpotActive   SpotStatus = "active"
+    SpotReported SpotStatus = "reported"
+    SpotClosed   SpotStatus = "closed"
+)
+
 type Spot struct {
     ID               value_objects.ID
     Name             value_objects.SpotName
@@ -12,6 +24,17 @@ type Spot struct {
     Latitude         value_objects.Latitude
     Longitude        value_objects.Longitude
     RegisteredUserID value_objects.ID 
+
+    // 付帯情報（いずれも任意。ゼロ値は「未登録」）
+    Category     value_objects.Category
+    Address      value_objects.Address
+    PriceRange   value_objects.PriceRange
+    OpeningHours value_objects.OpeningHours
+
+    Status SpotStatus
+
+    // Rating は現行の投稿に付いた評価の集計です（必要な場面でのみ FindRatingsBySpots で読み込む）。
+    Rating SpotRating
 }
 
 func NewSpot(id int, name string, lat, lng float64, userID int) (*Spot, error) {
@@ -47,17 +70,103 @@ func NewSpot(id int, name string, lat, lng float64, userID int) (*Spot, error) {
         Latitude:         latitude,
         Longitude:        longitude,
         RegisteredUserID: uID,
+        Status:           SpotActive,
     }, nil
 }
 
+// SetAttributes は店舗の付帯情報（カテゴリ・住所・価格帯・週間の営業時間）を検証して設定します。
+// いずれかが不正な場合は何も変更せずにエラーを返します。
+func (s *Spot) SetAttributes(category, address string, priceRange int, openingHours map[string][]string) error {
+    c, err := value_objects.NewCategory(category)
+    if err != nil {
+        return err
+    }
+    a, err := value_objects.NewAddress(address)
+    if err != nil {
+        return err
+    }
+    p, err := value_objects.NewPriceRange(priceRange)
+    if err != nil {
+        return err
+    }
+    h, err := value_objects.NewOpeningHours(openingHours)
+    if err != nil {
+        return err
+    }
+
+    s.Category = c
+    s.Address = a
+    s.PriceRange = p
+    s.OpeningHours = h
+    return nil
+}
+
+// IsClosed は閉店済み（論理削除済み）かどうかを返します。
+func (s *Spot) IsClosed() bool {
+    return s.Status == SpotClosed
+}
+
+// SpotRating はスポットの現行の投稿に付いた評価の集計です。
+// 平均はそれぞれ評価が付いた投稿だけで算出し、評価が1件もなければ 0 です。
+type SpotRating struct {
+    // Count は総合評価が付いた現行の投稿の件数です。
+    Count      int
+    Overall    float64
+    Taste      float64
+    Value      float64
+    Atmosphere float64
+    // RegisteredUserRating は王座保持者（このスポットを選んだ共鳴者）自身の総合評価です。
+    RegisteredUserRating value_objects.Rating
+}
+
+// SpotFilter は推薦・検索の候補を絞り込む条件です。ゼロ値は「絞り込みなし」を表します。
+type SpotFilter struct {
+    Category value_objects.Category
+    // OpenAt が指定された場合、その時刻に営業中のスポットのみを残す（営業時間が未登録のスポットは除外）。
+    OpenAt *time.Time
+}
+
+// Matches はスポットが絞り込み条件を満たすかどうかを返します。閉店済みのスポットは常に除外されます。
+func (f SpotFilter) Matches(spot *Spot) bool {
+    if spot.IsClosed() {
+        return false
+    }
+    if f.Category != value_objects.CategoryUnknown && spot.Category != f.Category {
+        return false
+    }
+    if f.OpenAt != nil && !spot.OpeningHours.IsOpenAt(*f.OpenAt) {
+        return false
+    }
+    return true
+}
+
 type ResonantUser struct {
     ID         value_objects.ID
     MatchCount int
 }
 
+// SpotSearchCriteria は店名検索の条件です。
+// Latitude / Longitude が両方指定された場合は、検索地点に近い店舗ほど上位に並べます。
+type SpotSearchCriteria struct {
+    Query     value_objects.SpotSearchQuery
+    Latitude  *value_objects.Latitude
+    Longitude *value_objects.Longitude
+    Filter    SpotFilter
+    Limit     int
+}
+
+// SpotSearchHit は店名検索の結果1件分です。
+type SpotSearchHit struct {
+    Spot       *Spot
+    Score      float64  // 店名の一致度に距離バイアスを掛けた順位付けスコア
+    DistanceKm *float64 // 検索地点が指定された場合のみ
+}
+
 type SpotRepository interface {
     Create(spot *Spot) (*Spot, error)
     FindByID(ctx context.Context, id value_objects.ID) (*Spot, error)
+    // FindByIDs は複数のスポットを1回の問い合わせで取得し、スポットIDをキーに返します（存在しないIDは含まない）。
+    FindByIDs(ctx context.Context, ids []value_objects.ID) (map[int]*Spot, error)
     FindByMeshID(meshID value_objects.MeshID) ([]*Spot, error)
     FindByRegisteredUser(ctx context.Context, userID value_objects.ID) ([]*Spot, error)
     
@@ -67,9 +176,34 @@ type SpotRepository interface {
     Update(spot *Spot) error
     Delete(id value_objects.ID) error
 
+    // Merge は sourceID のスポットを targetID へ統合します。
+    // 投稿・修正提案・閉店報告の付け替え、王座の再判定、リダイレクトの記録、統合元の削除を単一トランザクションで行い、付け替えた投稿数を返します。
+    // 統合後は FindByID / FindByLocation が統合元のIDや座標を統合先へ解決します。
+    Merge(ctx context.Context, sourceID, targetID, mergedBy value_objects.ID) (int, error)
+
+    // FindResonantUsersWithMatchCount は、ユーザーとブロック・ミュートの関係にある相手を除いた共鳴者を返します。
+    // 相手の投稿はユーザーに見えるもの（公開範囲を満たすもの）だけで数えます。
     FindResonantUsersWithMatchCount(ctx context.Context, userID value_objects.ID) ([]ResonantUser, error)
     FindSpotByMeshAndUser(ctx context.Context, meshID value_objects.MeshID, userID value_objects.ID) (*Spot, error)
-    FindSpotsByMeshAndUsers(ctx context.Context, meshIDs []value_objects.MeshID, userIDs []value_objects.ID) ([]*Spot, error)
-    GetDensityScoreByMesh(ctx context.Context, meshID value_objects.MeshID) (value_objects.DensityScore, error)
-    FindPostsBySpot(ctx context.Context, spotID value_objects.ID) ([]*Post, error)
+    // 以下の viewerID を取るメソッドは、閲覧者に見えない投稿（他人の private、フォローしていない相手の followers）を除外します。
+    FindSpotsByMeshAndUsers(ctx context.Context, meshIDs []value_objects.MeshID, userIDs []value_objects.ID, viewerID value_objects.ID) ([]*Spot, error)
+    GetDensityScoreByMesh(ctx context.Context, meshID value_objects.MeshID, viewerID value_objects.ID) (value_objects.DensityScore, error)
+    FindPostsBySpot(ctx context.Context, spotID, viewerID value_objects.ID) ([]*Post, error)
+    // FindPostsBySpotPage は新しい順に最大 limit 件の投稿を返します。before が nil の場合は先頭ページです。
+    FindPostsBySpotPage(ctx context.Context, spotID, viewerID value_objects.ID, before *PostCursor, limit int) ([]*Post, error)
+    // FindRatingsBySpots は各スポットの現行の投稿に付いた評価を集計し、スポットIDをキーに返します（評価のないスポットは含まない）。
+    FindRatingsBySpots(ctx context.Context, spotIDs []value_objects.ID, viewerID value_objects.ID) (map[int]SpotRating, error)
+    // IsThroneVisible は王座保持者（登録者）のスポットでの現行の投稿が閲覧者に見えるかを返します。
+    // 見えない場合、王座保持者を明かすとその投稿の存在が伝わるため、呼び出し側は王座を伏せます。
+    IsThroneVisible(ctx context.Context, spotID, viewerID value_objects.ID) (bool, error)
+
+    // ReportClosure はユーザーの閉店報告を記録し（同一ユーザーの重複報告は1件として数える）、
+    // 営業中のスポットを reported に、報告者数が threshold に達したスポットを closed にしたうえで、
+    // 現在の報告者数と記録後の状態を返します。判定と状態の更新は単一トランザクションで行います。
+    ReportClosure(ctx context.Context, spotID, userID value_objects.ID, threshold int) (int, SpotStatus, error)
+    // UpdateStatus はスポットの状態を変更します。active へ戻す場合は、それまでの閉店報告を取り消します。
+    UpdateStatus(ctx context.Context, spotID value_objects.ID, status SpotStatus) error
+
+    // SearchByName は正規化済み店名に対するトライグラム類似度・部分一致で店舗を検索し、スコア順に返します。
+    SearchByName(ctx context.Context, criteria SpotSearchCriteria) ([]SpotSearchHit, error)
 }
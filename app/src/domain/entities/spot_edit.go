@@ -0,0 +1,166 @@
+package entities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"app/src/domain/value_objects"
+)
+
+// SpotEditStatus は修正提案の状態です。
+type SpotEditStatus string
+
+const (
+	SpotEditOpen     SpotEditStatus = "open"
+	SpotEditApplied  SpotEditStatus = "applied"
+	SpotEditRejected SpotEditStatus = "rejected"
+)
+
+// ErrSpotEditResolved は、適用済み・却下済みの提案を操作しようとした場合のエラーです。
+var ErrSpotEditResolved = errors.New("proposal is already resolved")
+
+// ErrSpotLocationTaken は、座標の修正先にすでに別のスポットが登録されている場合のエラーです。
+var ErrSpotLocationTaken = errors.New("another spot already exists at the proposed location")
+
+// SpotEditProposal は、ユーザーによるスポットの修正提案（店名・座標・カテゴリのいずれか1つ）です。
+// 賛成票と反対票の差が閾値に達すると自動で適用（または却下）されます。
+type SpotEditProposal struct {
+	ID         value_objects.ID
+	SpotID     value_objects.ID
+	ProposerID value_objects.ID
+	Kind       value_objects.SpotEditKind
+
+	// Kind に応じていずれか1つだけが意味を持つ
+	Name      value_objects.SpotName
+	Latitude  value_objects.Latitude
+	Longitude value_objects.Longitude
+	Category  value_objects.Category
+
+	Status     SpotEditStatus
+	Upvotes    int
+	Downvotes  int
+	CreatedAt  time.Time
+	ResolvedAt *time.Time
+}
+
+// NewSpotEditProposal は提案の種類に応じて必要な値だけを検証し、新しい提案を生成します。
+func NewSpotEditProposal(spotID, proposerID int, kind, name string, lat, lng float64, category string) (*SpotEditProposal, error) {
+	sid, err := value_objects.NewID(spotID)
+	if err != nil {
+		return nil, err
+	}
+	pid, err := value_objects.NewID(proposerID)
+	if err != nil {
+		return nil, err
+	}
+	k, err := value_objects.NewSpotEditKind(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &SpotEditProposal{SpotID: sid, ProposerID: pid, Kind: k, Status: SpotEditOpen}
+	switch k {
+	case value_objects.SpotEditRename:
+		if p.Name, err = value_objects.NewSpotName(name); err != nil {
+			return nil, err
+		}
+	case value_objects.SpotEditRelocate:
+		if p.Latitude, err = value_objects.NewLatitude(lat); err != nil {
+			return nil, err
+		}
+		if p.Longitude, err = value_objects.NewLongitude(lng); err != nil {
+			return nil, err
+		}
+	case value_objects.SpotEditRecategory:
+		if p.Category, err = value_objects.NewCategory(category); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// Score は賛成票から反対票を引いた純得票数です。
+func (p *SpotEditProposal) Score() int {
+	return p.Upvotes - p.Downvotes
+}
+
+// Value は提案内容を履歴・表示用の文字列で返します（座標は "緯度,経度"）。
+func (p *SpotEditProposal) Value() string {
+	switch p.Kind {
+	case value_objects.SpotEditRename:
+		return p.Name.String()
+	case value_objects.SpotEditRelocate:
+		return formatLatLng(p.Latitude.Value(), p.Longitude.Value())
+	default:
+		return p.Category.String()
+	}
+}
+
+// ApplyTo は提案内容をスポットへ反映し、変更前後の値を記録した履歴を返します。
+// 座標の修正ではメッシュIDも再計算されます。
+func (p *SpotEditProposal) ApplyTo(spot *Spot, appliedAt time.Time) (*SpotEditHistory, error) {
+	if p.Status != SpotEditOpen {
+		return nil, ErrSpotEditResolved
+	}
+	if spot.ID != p.SpotID {
+		return nil, fmt.Errorf("proposal is for spot %d", p.SpotID.Value())
+	}
+
+	history := &SpotEditHistory{
+		SpotID:     spot.ID,
+		ProposalID: p.ID,
+		Kind:       p.Kind,
+		NewValue:   p.Value(),
+		AppliedAt:  appliedAt,
+	}
+
+	switch p.Kind {
+	case value_objects.SpotEditRename:
+		history.OldValue = spot.Name.String()
+		spot.Name = p.Name
+	case value_objects.SpotEditRelocate:
+		history.OldValue = formatLatLng(spot.Latitude.Value(), spot.Longitude.Value())
+		meshID, err := value_objects.NewMeshID(p.Latitude.Value(), p.Longitude.Value())
+		if err != nil {
+			return nil, err
+		}
+		spot.Latitude, spot.Longitude, spot.MeshID = p.Latitude, p.Longitude, meshID
+	case value_objects.SpotEditRecategory:
+		history.OldValue = spot.Category.String()
+		spot.Category = p.Category
+	}
+	return history, nil
+}
+
+func formatLatLng(lat, lng float64) string {
+	return strconv.FormatFloat(lat, 'f', -1, 64) + "," + strconv.FormatFloat(lng, 'f', -1, 64)
+}
+
+// SpotEditHistory は、適用された修正1件分の変更記録です。
+type SpotEditHistory struct {
+	ID         value_objects.ID
+	SpotID     value_objects.ID
+	ProposalID value_objects.ID
+	Kind       value_objects.SpotEditKind
+	OldValue   string
+	NewValue   string
+	AppliedAt  time.Time
+}
+
+type SpotEditRepository interface {
+	CreateProposal(ctx context.Context, proposal *SpotEditProposal) (*SpotEditProposal, error)
+	FindProposalByID(ctx context.Context, id value_objects.ID) (*SpotEditProposal, error)
+	FindOpenProposalsBySpot(ctx context.Context, spotID value_objects.ID) ([]*SpotEditProposal, error)
+	// Vote はユーザーの票（+1 / -1）を記録し（再投票は上書き）、集計し直した提案を返します。
+	// 同時投票などで提案がすでに解決済みになっていた場合は ErrSpotEditResolved を返します。
+	Vote(ctx context.Context, proposalID, userID value_objects.ID, value int) (*SpotEditProposal, error)
+	// Apply は修正後のスポットの保存・提案の適用済み化・履歴の記録を1トランザクションで行います。
+	// スポットはロックして読み直し、提案の種類に対応する列だけを更新します（spot と history は最新の値で上書きされます）。
+	// 移転先の座標にすでに別のスポットがある場合は ErrSpotLocationTaken を返し、何も変更しません。
+	Apply(ctx context.Context, proposal *SpotEditProposal, spot *Spot, history *SpotEditHistory) error
+	Reject(ctx context.Context, proposalID value_objects.ID) error
+	FindHistoryBySpot(ctx context.Context, spotID value_objects.ID) ([]*SpotEditHistory, error)
+}
@@ -2,14 +2,51 @@ package entities
 
 import (
 	"context" // context を追加
+	"errors"
+	"time"
+
 	"app/src/domain/value_objects"
 )
 
+// ErrUsernameTaken は、保存しようとしたユーザー名が他のユーザーに使われている場合のエラーです。
+var ErrUsernameTaken = errors.New("username is already taken")
+
 type User struct {
 	ID             value_objects.ID
 	Username       value_objects.Username
 	Email          value_objects.Email
 	HashedPassword value_objects.HashedPassword
+	// IsAdmin は運営者権限（スポット統合などの管理操作）を持つかどうか。DB上でのみ付与する。
+	IsAdmin bool
+
+	// プロフィール（GET / PATCH /v1/users/me）。空文字・ImageID 0 は未設定を表す。
+	DisplayName value_objects.DisplayName
+	Bio         value_objects.Bio
+	HomeArea    value_objects.HomeArea
+	Avatar      UserAvatar
+	CreatedAt   time.Time
+
+	// DeletionRequestedAt は退会を申請した時刻です（nil なら申請していない）。
+	// 猶予期間中は再ログインで取り消せ、過ぎるとパージ処理で完全に削除されます。
+	DeletionRequestedAt *time.Time
+}
+
+// IsDeletionRequested は退会の猶予期間中かどうかを返します。
+func (u *User) IsDeletionRequested() bool {
+	return u.DeletionRequestedAt != nil
+}
+
+// UserAvatar はプロフィールのアイコン画像（POST /v1/images でアップロードした本人の画像）です。
+// Thumb は一覧用の正方形サムネイルで、生成できない形式では空です。
+type UserAvatar struct {
+	ImageID value_objects.ID
+	URL     value_objects.ImageURL
+	Thumb   value_objects.ImageURL
+}
+
+// IsSet はアイコン画像が設定されているかどうかを返します。
+func (a UserAvatar) IsSet() bool {
+	return a.ImageID != 0
 }
 
 func NewUser(id int, username, email, hashedPassword string) (*User, error) {
@@ -41,7 +78,11 @@ type UserRepository interface {
 	Create(user *User) (*User, error)
 	FindByID(id value_objects.ID) (*User, error)
 	FindByEmail(email value_objects.Email) (*User, error)
+	// FindByUsername は該当するユーザーがいなければ nil を返します。
 	FindByUsername(ctx context.Context, username string) (*User, error) 
+	// Update はプロフィールを含むユーザー情報を保存します。ユーザー名が変わった場合は、
+	// 投稿に複製しているユーザー名（posts.username）も同じトランザクションで書き換えます。
+	// ユーザー名が他のユーザーと重複した場合は ErrUsernameTaken を返します。
 	Update(user *User) error
 	Delete(id value_objects.ID) error
 }
\ No newline at end of file
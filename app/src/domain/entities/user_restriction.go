@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"context"
+	"time"
+
+	"app/src/domain/value_objects"
+)
+
+// UserRestrictionKind はユーザー間の制限の種類です。
+type UserRestrictionKind string
+
+const (
+	// UserRestrictionBlock は双方向の制限です。互いの共鳴・推薦・フィード・プロフィールから相手が消え、フォローも解除されます。
+	UserRestrictionBlock UserRestrictionKind = "block"
+	// UserRestrictionMute は一方向の制限です。ミュートした側の共鳴・推薦・フィードからのみ相手を外します。
+	UserRestrictionMute UserRestrictionKind = "mute"
+)
+
+// RestrictedUser はブロック・ミュート中のユーザー1人と、制限した時刻です。
+type RestrictedUser struct {
+	User         *User
+	RestrictedAt time.Time
+}
+
+// UserRestrictionRepository はブロック・ミュートを記録します。
+// 共鳴者・フィード・フォロー中の検索は、各リポジトリのクエリでこの制限を反映します。
+type UserRestrictionRepository interface {
+	// Restrict は userID から targetID への制限を記録します（記録済みなら何もしない）。
+	// ブロックの場合は、互いのフォローも同じトランザクションで解除します。
+	Restrict(ctx context.Context, kind UserRestrictionKind, userID, targetID value_objects.ID) error
+	// Unrestrict は制限を解除します（制限していなければ何もしない）。
+	Unrestrict(ctx context.Context, kind UserRestrictionKind, userID, targetID value_objects.ID) error
+	// FindByUser は userID が制限しているユーザーを、制限した時刻の新しい順に返します。
+	FindByUser(ctx context.Context, kind UserRestrictionKind, userID value_objects.ID) ([]RestrictedUser, error)
+	// IsBlockedBetween は2人のどちらかが相手をブロックしているかを返します。
+	IsBlockedBetween(ctx context.Context, a, b value_objects.ID) (bool, error)
+}
@@ -0,0 +1,15 @@
+package services
+
+import (
+	"context"
+	"io"
+)
+
+// BlobStore は、アップロードされた画像などのバイナリを保存する外部ストレージです。
+// key はストレージ内の相対パス（例: images/2026/10/abcd.jpg）で、実装ごとの公開URLへは URL で変換します。
+type BlobStore interface {
+	Put(ctx context.Context, key, contentType string, body io.Reader, size int64) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
@@ -0,0 +1,19 @@
+package services
+
+import (
+	"context"
+
+	"app/src/domain/value_objects"
+)
+
+// CommentModeration はコメント本文の審査結果です。Hide が true の場合、コメントは投稿者本人にしか表示されません。
+type CommentModeration struct {
+	Hide   bool
+	Reason string
+}
+
+// CommentModerator は、コメントの作成・編集時に本文を審査するフックです。
+// 禁止語による自動判定のほか、外部の審査サービスへの差し替えを想定しています。
+type CommentModerator interface {
+	Review(ctx context.Context, body value_objects.CommentBody) (CommentModeration, error)
+}
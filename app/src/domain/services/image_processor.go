@@ -0,0 +1,33 @@
+package services
+
+import "time"
+
+// ImageRendition は変換後の画像1枚分です。
+type ImageRendition struct {
+	Data        []byte
+	ContentType string
+	Width       int
+	Height      int
+}
+
+// ImageMetadata は、取り除く前のメタデータ（EXIF）から読み取った撮影情報です。読み取れない項目は nil です。
+type ImageMetadata struct {
+	Latitude   *float64
+	Longitude  *float64
+	CapturedAt *time.Time
+}
+
+// ProcessedImage は ImageProcessor の変換結果です。
+// Medium / Thumb は派生サイズを生成できない形式（WebP）では nil です。
+type ProcessedImage struct {
+	Original ImageRendition
+	Medium   *ImageRendition
+	Thumb    *ImageRendition
+	Metadata ImageMetadata
+}
+
+// ImageProcessor は、アップロードされた画像から位置情報などのメタデータ（EXIF 等）を取り除いた元画像と、
+// 一覧用の正方形サムネイル・推薦カード用の中サイズを生成します。
+type ImageProcessor interface {
+	Process(data []byte, contentType string) (*ProcessedImage, error)
+}
@@ -10,11 +10,13 @@ type RecommendationService interface {
 	// Distill は、蒸留メッシュアルゴリズムを用いて「運命の1軒」を算出します。
 	// 循環参照を回避し、かつドメイン層の純粋性を保つため、
 	// 構造体（DTO）を介さず各ドメインオブジェクトを個別に返却します。
+	// filter で候補をカテゴリ・営業中に絞り込めます（ゼロ値なら絞り込みなし）。
 	Distill(
 		ctx context.Context, 
 		user *entities.User, 
 		lat value_objects.Latitude, 
 		lng value_objects.Longitude,
+		filter entities.SpotFilter,
 	) (
 		spot *entities.Spot,
 		totalScore value_objects.TotalScore,
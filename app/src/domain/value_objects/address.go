@@ -0,0 +1,22 @@
+package value_objects
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// Address はスポットの住所です。空文字は「未登録」を表します。
+type Address string
+
+func NewAddress(value string) (Address, error) {
+	trimmed := strings.TrimSpace(value)
+	if utf8.RuneCountInString(trimmed) > 255 {
+		return "", errors.New("address must be <= 255 chars")
+	}
+	return Address(trimmed), nil
+}
+
+func (a Address) String() string {
+	return string(a)
+}
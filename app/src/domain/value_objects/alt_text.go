@@ -0,0 +1,20 @@
+package value_objects
+
+import (
+	"errors"
+	"unicode/utf8"
+)
+
+// AltText は投稿画像の代替テキスト（読み上げ・画像が表示できない場合の説明）です。
+type AltText string
+
+func NewAltText(value string) (AltText, error) {
+	if utf8.RuneCountInString(value) > 200 {
+		return "", errors.New("alt text must be <= 200 chars")
+	}
+	return AltText(value), nil
+}
+
+func (a AltText) String() string {
+	return string(a)
+}
@@ -0,0 +1,22 @@
+package value_objects
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// Bio はプロフィールの自己紹介文です（改行可）。空文字は「未設定」を表します。
+type Bio string
+
+func NewBio(value string) (Bio, error) {
+	trimmed := strings.TrimSpace(value)
+	if utf8.RuneCountInString(trimmed) > 300 {
+		return "", errors.New("bio must be <= 300 chars")
+	}
+	return Bio(trimmed), nil
+}
+
+func (b Bio) String() string {
+	return string(b)
+}
@@ -17,3 +17,13 @@ func NewCaption(value string) (Caption, error) {
 func (c Caption) String() string {
 	return string(c)
 }
+
+// Hashtags はキャプション中の #タグ を正規化して返します。
+func (c Caption) Hashtags() []Hashtag {
+	return ExtractHashtags(string(c))
+}
+
+// Mentions はキャプション中の @ユーザー名 を返します（実在するかどうかは保存時に確認します）。
+func (c Caption) Mentions() []Username {
+	return ExtractMentions(string(c))
+}
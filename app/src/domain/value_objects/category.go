@@ -0,0 +1,47 @@
+package value_objects
+
+import (
+	"errors"
+	"strings"
+)
+
+// Category はスポットのジャンルです。空文字は「未分類」を表します。
+type Category string
+
+const (
+	CategoryUnknown  Category = ""
+	CategoryPizza    Category = "pizza"
+	CategoryRamen    Category = "ramen"
+	CategoryUdon     Category = "udon"
+	CategorySoba     Category = "soba"
+	CategorySushi    Category = "sushi"
+	CategoryCurry    Category = "curry"
+	CategoryYakiniku Category = "yakiniku"
+	CategoryIzakaya  Category = "izakaya"
+	CategoryCafe     Category = "cafe"
+	CategoryBakery   Category = "bakery"
+	CategorySweets   Category = "sweets"
+	CategoryBar      Category = "bar"
+	CategoryOther    Category = "other"
+)
+
+var validCategories = map[Category]struct{}{
+	CategoryPizza: {}, CategoryRamen: {}, CategoryUdon: {}, CategorySoba: {}, CategorySushi: {},
+	CategoryCurry: {}, CategoryYakiniku: {}, CategoryIzakaya: {}, CategoryCafe: {}, CategoryBakery: {},
+	CategorySweets: {}, CategoryBar: {}, CategoryOther: {},
+}
+
+func NewCategory(value string) (Category, error) {
+	c := Category(strings.ToLower(strings.TrimSpace(value)))
+	if c == CategoryUnknown {
+		return CategoryUnknown, nil
+	}
+	if _, ok := validCategories[c]; !ok {
+		return "", errors.New("unknown category")
+	}
+	return c, nil
+}
+
+func (c Category) String() string {
+	return string(c)
+}
@@ -0,0 +1,22 @@
+package value_objects
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// CommentBody は投稿へのコメント本文です（前後の空白を除いて 1〜500 文字）。
+type CommentBody string
+
+func NewCommentBody(value string) (CommentBody, error) {
+	value = strings.TrimSpace(value)
+	if utf8.RuneCountInString(value) < 1 || utf8.RuneCountInString(value) > 500 {
+		return "", errors.New("comment must be 1-500 chars")
+	}
+	return CommentBody(value), nil
+}
+
+func (b CommentBody) String() string {
+	return string(b)
+}
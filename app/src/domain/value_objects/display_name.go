@@ -0,0 +1,26 @@
+package value_objects
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DisplayName はプロフィールに表示する名前です（ユーザー名とは別に自由に付けられる）。空文字は「未設定」を表します。
+type DisplayName string
+
+func NewDisplayName(value string) (DisplayName, error) {
+	trimmed := strings.TrimSpace(value)
+	if utf8.RuneCountInString(trimmed) > 50 {
+		return "", errors.New("display name must be <= 50 chars")
+	}
+	if strings.IndexFunc(trimmed, unicode.IsControl) >= 0 {
+		return "", errors.New("display name must not contain control characters")
+	}
+	return DisplayName(trimmed), nil
+}
+
+func (d DisplayName) String() string {
+	return string(d)
+}
@@ -0,0 +1,112 @@
+package value_objects
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/width"
+)
+
+// MaxHashtagLength はハッシュタグ（# を除く）の最大文字数です。
+const MaxHashtagLength = 50
+
+// Hashtag は正規化済みのハッシュタグ（先頭の # を含まない）です。
+// 全角英数 → 半角、半角カナ → 全角、英字 → 小文字 に揃え、「#Ｒａｍｅｎ」と「#ramen」を同じタグとして扱います。
+// 日本語（かな・漢字）はそのまま使えます。
+type Hashtag string
+
+func NewHashtag(value string) (Hashtag, error) {
+	value = strings.TrimLeft(strings.TrimSpace(value), "#＃")
+	normalized := strings.ToLower(width.Fold.String(value))
+
+	if utf8.RuneCountInString(normalized) < 1 || utf8.RuneCountInString(normalized) > MaxHashtagLength {
+		return "", errors.New("hashtag must be 1-50 chars")
+	}
+	onlyDigits := true
+	for _, r := range normalized {
+		if !isHashtagRune(r) {
+			return "", errors.New("hashtag may contain only letters, digits and underscores")
+		}
+		if !unicode.IsDigit(r) {
+			onlyDigits = false
+		}
+	}
+	// 「#1」のような番号だけのものはタグとして扱わない
+	if onlyDigits {
+		return "", errors.New("hashtag must contain a non-digit character")
+	}
+	return Hashtag(normalized), nil
+}
+
+func (h Hashtag) String() string {
+	return string(h)
+}
+
+// isHashtagRune はタグ・メンションの本体に使える文字かどうかを返します（濁点などの結合文字を含む）。
+func isHashtagRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) || r == '_'
+}
+
+// isMentionRune はユーザー名としてメンションに使える文字かどうかを返します。
+func isMentionRune(r rune) bool {
+	return isHashtagRune(r) || r == '.' || r == '-'
+}
+
+// ExtractHashtags は本文中の #タグ を出現順に重複なく取り出します。
+// 語の途中の #（「C#」「abc#def」など）や、正規化後に不正となるものは無視します。
+func ExtractHashtags(text string) []Hashtag {
+	var tags []Hashtag
+	seen := make(map[Hashtag]bool)
+	for _, token := range scanMarkedTokens(text, "#＃", isHashtagRune) {
+		tag, err := NewHashtag(token)
+		if err != nil || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+// ExtractMentions は本文中の @ユーザー名 を出現順に重複なく取り出します。
+// メールアドレスのような語の途中の @ は無視し、末尾の「.」「-」は文の区切りとして取り除きます。
+func ExtractMentions(text string) []Username {
+	var names []Username
+	seen := make(map[Username]bool)
+	for _, token := range scanMarkedTokens(text, "@＠", isMentionRune) {
+		name, err := NewUsername(strings.TrimRight(token, ".-"))
+		if err != nil || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	return names
+}
+
+// scanMarkedTokens は、語の先頭に置かれた記号（marks のいずれか）に続く文字列を取り出します。
+func scanMarkedTokens(text, marks string, body func(rune) bool) []string {
+	var tokens []string
+	runes := []rune(text)
+	for i := 0; i < len(runes); i++ {
+		if !strings.ContainsRune(marks, runes[i]) {
+			continue
+		}
+		// 英数字の直後の記号は語の一部（「C#」やメールアドレス）とみなす。
+		// 日本語は語を空白で区切らないため、かな・漢字の直後は語の先頭として扱う。
+		if i > 0 && runes[i-1] < utf8.RuneSelf && isMentionRune(runes[i-1]) {
+			continue
+		}
+		j := i + 1
+		for j < len(runes) && body(runes[j]) {
+			j++
+		}
+		if j > i+1 {
+			tokens = append(tokens, string(runes[i+1:j]))
+		}
+		i = j - 1
+	}
+	return tokens
+}
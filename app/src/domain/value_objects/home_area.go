@@ -0,0 +1,26 @@
+package value_objects
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// HomeArea はプロフィールに表示する拠点エリア（「渋谷・恵比寿」など自由記述）です。空文字は「未設定」を表します。
+type HomeArea string
+
+func NewHomeArea(value string) (HomeArea, error) {
+	trimmed := strings.TrimSpace(value)
+	if utf8.RuneCountInString(trimmed) > 50 {
+		return "", errors.New("home area must be <= 50 chars")
+	}
+	if strings.IndexFunc(trimmed, unicode.IsControl) >= 0 {
+		return "", errors.New("home area must not contain control characters")
+	}
+	return HomeArea(trimmed), nil
+}
+
+func (h HomeArea) String() string {
+	return string(h)
+}
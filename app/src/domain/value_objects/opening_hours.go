@@ -0,0 +1,124 @@
+package value_objects
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const minutesPerDay = 24 * 60
+
+// 営業時間は日本時間で判定する（alpine イメージに tzdata が無くても動くよう固定オフセットで持つ）。
+var openingHoursLocation = time.FixedZone("JST", 9*60*60)
+
+var weekdayKeys = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// OpeningPeriod は、ある曜日の1回分の営業時間帯です。
+// 分は当日0時からの経過分で、深夜営業は 1440 を超える値で表します（例: 17:00-26:00 → 1020〜1560）。
+type OpeningPeriod struct {
+	Weekday     time.Weekday
+	OpenMinute  int
+	CloseMinute int
+}
+
+// OpeningHours は週単位の営業時間です。空の場合は「未登録」を表します。
+type OpeningHours []OpeningPeriod
+
+// NewOpeningHours は {"mon": ["11:00-15:00", "17:00-23:00"], ...} 形式の入力から営業時間を生成します。
+// 閉店時刻が開店時刻以前（"18:00-02:00"）または 24:00 超（"18:00-26:00"）の場合は翌日にまたがる営業として扱います。
+// 同じ曜日の時間帯が重なる場合はエラーです。
+func NewOpeningHours(weekly map[string][]string) (OpeningHours, error) {
+	hours := make(OpeningHours, 0)
+	for key, ranges := range weekly {
+		weekday, ok := weekdayFromKey(key)
+		if !ok {
+			return nil, fmt.Errorf("unknown weekday: %s", key)
+		}
+		for _, r := range ranges {
+			var oh, om, ch, cm int
+			if _, err := fmt.Sscanf(r, "%d:%d-%d:%d", &oh, &om, &ch, &cm); err != nil {
+				return nil, fmt.Errorf("invalid opening period: %s", r)
+			}
+			if oh < 0 || oh > 23 || om < 0 || om > 59 || ch < 0 || ch > 47 || cm < 0 || cm > 59 {
+				return nil, fmt.Errorf("invalid opening period: %s", r)
+			}
+			open, close := oh*60+om, ch*60+cm
+			if close <= open {
+				close += minutesPerDay
+			}
+			if close-open > minutesPerDay {
+				return nil, fmt.Errorf("opening period must be <= 24 hours: %s", r)
+			}
+			hours = append(hours, OpeningPeriod{Weekday: weekday, OpenMinute: open, CloseMinute: close})
+		}
+	}
+	if len(hours) > 7*4 {
+		return nil, errors.New("too many opening periods")
+	}
+	sort.Slice(hours, func(i, j int) bool {
+		if hours[i].Weekday != hours[j].Weekday {
+			return hours[i].Weekday < hours[j].Weekday
+		}
+		return hours[i].OpenMinute < hours[j].OpenMinute
+	})
+	// 同じ曜日の時間帯が重なる（同じ時間帯の重複を含む）入力は受け付けない。終了と同時に始まる時間帯は可。
+	for i := 1; i < len(hours); i++ {
+		prev, cur := hours[i-1], hours[i]
+		if prev.Weekday == cur.Weekday && cur.OpenMinute < prev.CloseMinute {
+			return nil, fmt.Errorf("overlapping opening periods on %s", weekdayKeys[cur.Weekday])
+		}
+	}
+	return hours, nil
+}
+
+// IsEmpty は営業時間が未登録かどうかを返します。
+func (h OpeningHours) IsEmpty() bool {
+	return len(h) == 0
+}
+
+// IsOpenAt は指定時刻（日本時間に換算）に営業中かどうかを返します。
+// 前日から日付をまたいで続いている深夜営業も考慮します。未登録の場合は常に false です。
+func (h OpeningHours) IsOpenAt(t time.Time) bool {
+	local := t.In(openingHoursLocation)
+	minute := local.Hour()*60 + local.Minute()
+	today := local.Weekday()
+	yesterday := (today + 6) % 7
+
+	for _, p := range h {
+		if p.Weekday == today && p.OpenMinute <= minute && minute < p.CloseMinute {
+			return true
+		}
+		if p.Weekday == yesterday && minute+minutesPerDay < p.CloseMinute {
+			return true
+		}
+	}
+	return false
+}
+
+// Weekly は NewOpeningHours の入力と同じ {"mon": ["11:00-15:00"], ...} 形式で営業時間を返します。
+func (h OpeningHours) Weekly() map[string][]string {
+	weekly := make(map[string][]string)
+	for _, p := range h {
+		key := weekdayKeys[p.Weekday]
+		weekly[key] = append(weekly[key], fmt.Sprintf("%02d:%02d-%02d:%02d",
+			p.OpenMinute/60, p.OpenMinute%60, p.CloseMinute/60, p.CloseMinute%60))
+	}
+	return weekly
+}
+
+// OpeningHoursMinuteOf は、t を営業時間判定に使う「曜日」と「当日0時からの分」に変換します。
+// リポジトリが SQL 側で営業中判定を行う際に、IsOpenAt と同じ基準時刻を使うためのものです。
+func OpeningHoursMinuteOf(t time.Time) (time.Weekday, int) {
+	local := t.In(openingHoursLocation)
+	return local.Weekday(), local.Hour()*60 + local.Minute()
+}
+
+func weekdayFromKey(key string) (time.Weekday, bool) {
+	for i, k := range weekdayKeys {
+		if k == key {
+			return time.Weekday(i), true
+		}
+	}
+	return 0, false
+}
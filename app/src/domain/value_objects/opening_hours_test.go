@@ -0,0 +1,65 @@
+package value_objects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOpeningHours(t *testing.T) {
+	tests := []struct {
+		name    string
+		weekly  map[string][]string
+		wantErr bool
+		wantLen int
+	}{
+		{
+			name:    "【正常系】昼と夜の営業を登録できる",
+			weekly:  map[string][]string{"mon": {"17:00-23:00", "11:00-15:00"}},
+			wantLen: 2,
+		},
+		{
+			name:    "【正常系】終了と同時に始まる時間帯は重なりとみなさない",
+			weekly:  map[string][]string{"mon": {"11:00-15:00", "15:00-18:00"}},
+			wantLen: 2,
+		},
+		{
+			name:    "【正常系】別の曜日なら同じ時間帯を登録できる",
+			weekly:  map[string][]string{"mon": {"11:00-15:00"}, "tue": {"11:00-15:00"}},
+			wantLen: 2,
+		},
+		{
+			name:    "【異常系】同じ曜日に同じ時間帯を重複して登録できない",
+			weekly:  map[string][]string{"mon": {"11:00-15:00", "11:00-15:00"}},
+			wantErr: true,
+		},
+		{
+			name:    "【異常系】同じ曜日の時間帯が一部でも重なる場合はエラー",
+			weekly:  map[string][]string{"fri": {"11:00-15:00", "14:30-18:00"}},
+			wantErr: true,
+		},
+		{
+			name:    "【異常系】深夜営業の時間帯に重なる場合もエラー",
+			weekly:  map[string][]string{"sat": {"18:00-02:00", "20:00-22:00"}},
+			wantErr: true,
+		},
+		{
+			name:    "【異常系】時刻の形式が不正な場合はエラー",
+			weekly:  map[string][]string{"mon": {"11時-15時"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hours, err := NewOpeningHours(tt.weekly)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, hours, tt.wantLen)
+		})
+	}
+}
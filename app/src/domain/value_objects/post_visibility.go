@@ -0,0 +1,29 @@
+package value_objects
+
+import "errors"
+
+// PostVisibility は投稿の公開範囲です。
+// 非公開の投稿も投稿者本人の共鳴の判定には使いますが、他のユーザーには返さず、激戦区度にも数えません。
+type PostVisibility string
+
+const (
+	PostVisibilityPublic    PostVisibility = "public"
+	PostVisibilityFollowers PostVisibility = "followers"
+	PostVisibilityPrivate   PostVisibility = "private"
+)
+
+// NewPostVisibility は空文字を public として扱います。
+func NewPostVisibility(value string) (PostVisibility, error) {
+	switch v := PostVisibility(value); v {
+	case "":
+		return PostVisibilityPublic, nil
+	case PostVisibilityPublic, PostVisibilityFollowers, PostVisibilityPrivate:
+		return v, nil
+	default:
+		return "", errors.New("visibility must be public, followers or private")
+	}
+}
+
+func (v PostVisibility) String() string {
+	return string(v)
+}
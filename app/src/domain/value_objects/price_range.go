@@ -0,0 +1,23 @@
+package value_objects
+
+import "errors"
+
+// PriceRange は1人あたりの価格帯を表す段階値です。
+// 0: 未登録 / 1: 〜¥1,000 / 2: 〜¥3,000 / 3: 〜¥10,000 / 4: ¥10,000〜
+type PriceRange int
+
+const (
+	PriceRangeUnknown PriceRange = 0
+	PriceRangeMax     PriceRange = 4
+)
+
+func NewPriceRange(value int) (PriceRange, error) {
+	if value < int(PriceRangeUnknown) || value > int(PriceRangeMax) {
+		return 0, errors.New("price range must be 0-4")
+	}
+	return PriceRange(value), nil
+}
+
+func (p PriceRange) Int() int {
+	return int(p)
+}
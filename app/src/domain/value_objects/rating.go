@@ -0,0 +1,27 @@
+package value_objects
+
+import "errors"
+
+// Rating は投稿者による5段階の評価です。0 は「未評価」を表します。
+type Rating int
+
+const (
+	RatingUnrated Rating = 0
+	RatingMax     Rating = 5
+)
+
+func NewRating(value int) (Rating, error) {
+	if value < int(RatingUnrated) || value > int(RatingMax) {
+		return 0, errors.New("rating must be 1-5 (0 for unrated)")
+	}
+	return Rating(value), nil
+}
+
+func (r Rating) Int() int {
+	return int(r)
+}
+
+// IsRated は評価が付いているかどうかを返します。
+func (r Rating) IsRated() bool {
+	return r != RatingUnrated
+}
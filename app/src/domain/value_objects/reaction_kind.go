@@ -0,0 +1,25 @@
+package value_objects
+
+import "errors"
+
+// ReactionKind は投稿へのリアクションの種類です。1人のユーザーが1件の投稿に付けられるのは1種類だけです。
+type ReactionKind string
+
+const (
+	ReactionResonate ReactionKind = "resonate"
+	ReactionWantToGo ReactionKind = "want_to_go"
+	ReactionHelpful  ReactionKind = "helpful"
+)
+
+func NewReactionKind(value string) (ReactionKind, error) {
+	switch k := ReactionKind(value); k {
+	case ReactionResonate, ReactionWantToGo, ReactionHelpful:
+		return k, nil
+	default:
+		return "", errors.New("reaction kind must be resonate, want_to_go or helpful")
+	}
+}
+
+func (k ReactionKind) String() string {
+	return string(k)
+}
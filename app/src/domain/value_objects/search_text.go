@@ -0,0 +1,52 @@
+package value_objects
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/width"
+)
+
+// NormalizeSearchText は、店名検索のために表記ゆれを吸収した文字列を返します。
+//   - 全角英数記号 → 半角、半角カナ → 全角（width.Fold）
+//   - カタカナ → ひらがな（「ラーメン」と「らーめん」を同一視）
+//   - 英字 → 小文字
+//
+// 変換はすべて「1文字 → 1文字」で行うため、正規化の前後で文字位置（rune index）が一致します。
+// これにより、正規化後の文字列で見つけた一致箇所を、元の店名の上でそのままハイライトできます。
+func NormalizeSearchText(value string) string {
+	var b strings.Builder
+	b.Grow(len(value))
+	for _, r := range value {
+		b.WriteRune(normalizeSearchRune(r))
+	}
+	return b.String()
+}
+
+func normalizeSearchRune(r rune) rune {
+	if folded := width.Fold.String(string(r)); utf8.RuneCountInString(folded) == 1 {
+		r, _ = utf8.DecodeRuneInString(folded)
+	}
+	// カタカナ（ァ〜ヶ）はひらがな（ぁ〜ゖ）と同じ並びで 0x60 ずれている。
+	if r >= 'ァ' && r <= 'ヶ' {
+		r -= 0x60
+	}
+	return unicode.ToLower(r)
+}
+
+// SpotSearchQuery は正規化済みの店名検索キーワードです。
+type SpotSearchQuery string
+
+func NewSpotSearchQuery(value string) (SpotSearchQuery, error) {
+	normalized := strings.TrimSpace(NormalizeSearchText(value))
+	if utf8.RuneCountInString(normalized) < 1 || utf8.RuneCountInString(normalized) > 64 {
+		return "", errors.New("search query must be 1-64 chars")
+	}
+	return SpotSearchQuery(normalized), nil
+}
+
+func (q SpotSearchQuery) String() string {
+	return string(q)
+}
@@ -0,0 +1,25 @@
+package value_objects
+
+import "errors"
+
+// SpotEditKind はスポットの修正提案の種類です。
+type SpotEditKind string
+
+const (
+	SpotEditRename     SpotEditKind = "rename"
+	SpotEditRelocate   SpotEditKind = "relocate"
+	SpotEditRecategory SpotEditKind = "recategorize"
+)
+
+func NewSpotEditKind(value string) (SpotEditKind, error) {
+	switch k := SpotEditKind(value); k {
+	case SpotEditRename, SpotEditRelocate, SpotEditRecategory:
+		return k, nil
+	default:
+		return "", errors.New("edit kind must be rename, relocate or recategorize")
+	}
+}
+
+func (k SpotEditKind) String() string {
+	return string(k)
+}
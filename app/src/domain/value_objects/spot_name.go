@@ -17,3 +17,8 @@ func NewSpotName(value string) (SpotName, error) {
 func (n SpotName) String() string {
 	return string(n)
 }
+
+// Normalized は検索用に表記ゆれを吸収した店名を返します（NormalizeSearchText を参照）。
+func (n SpotName) Normalized() string {
+	return NormalizeSearchText(string(n))
+}
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"app/src/infrastructure/database/postgres"
+	"app/src/infrastructure/storage"
+	"app/src/usecase"
+)
+
+// RunGCImages は `app gc-images` サブコマンドの本体です。
+// 猶予期間を過ぎてもどの投稿からも参照されていないアップロード画像を削除し、結果を out に出力します。
+//
+//	app gc-images [-grace 24h] [-dry-run]
+func RunGCImages(ctx context.Context, db *sql.DB, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("gc-images", flag.ContinueOnError)
+	fs.SetOutput(out)
+	grace := fs.Duration("grace", ImageGCGracePeriodFromEnv(), "アップロードから削除対象になるまでの猶予期間")
+	dryRun := fs.Bool("dry-run", false, "削除を行わず、対象の一覧のみ出力する")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	interactor, err := newCollectOrphanImagesInteractor(db)
+	if err != nil {
+		return err
+	}
+	output, err := interactor.Execute(ctx, usecase.CollectOrphanImagesInput{
+		Now:         time.Now(),
+		GracePeriod: *grace,
+		DryRun:      *dryRun,
+	})
+	if output != nil {
+		for _, key := range output.Keys {
+			fmt.Fprintln(out, key)
+		}
+		fmt.Fprintf(out, "orphans: %d, deleted: %d, dry-run: %t\n", len(output.Keys), output.Deleted, output.DryRun)
+	}
+	return err
+}
+
+// RunImageGCLoop は interval ごとに孤立画像の回収を行います。ctx が終了するまで戻りません。
+func RunImageGCLoop(ctx context.Context, db *sql.DB, interval time.Duration) {
+	interactor, err := newCollectOrphanImagesInteractor(db)
+	if err != nil {
+		log.Printf("Image GC disabled: %v", err)
+		return
+	}
+	grace := ImageGCGracePeriodFromEnv()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			output, err := interactor.Execute(ctx, usecase.CollectOrphanImagesInput{Now: now, GracePeriod: grace})
+			if err != nil {
+				log.Printf("Image GC failed: %v", err)
+			}
+			if output != nil && output.Deleted > 0 {
+				log.Printf("Image GC deleted %d orphaned images", output.Deleted)
+			}
+		}
+	}
+}
+
+// ImageGCIntervalFromEnv は IMAGE_GC_INTERVAL（例: 1h）を読み取ります。未指定なら1時間、0 なら定期回収を行いません。
+func ImageGCIntervalFromEnv() time.Duration {
+	return durationFromEnv("IMAGE_GC_INTERVAL", time.Hour)
+}
+
+// ImageGCGracePeriodFromEnv は IMAGE_GC_GRACE_PERIOD（例: 24h）を読み取ります。
+func ImageGCGracePeriodFromEnv() time.Duration {
+	return durationFromEnv("IMAGE_GC_GRACE_PERIOD", usecase.DefaultOrphanImageGracePeriod)
+}
+
+func durationFromEnv(name string, fallback time.Duration) time.Duration {
+	if d, err := time.ParseDuration(os.Getenv(name)); err == nil && d >= 0 {
+		return d
+	}
+	return fallback
+}
+
+func newCollectOrphanImagesInteractor(db *sql.DB) (usecase.CollectOrphanImagesUseCase, error) {
+	blobStore, err := storage.NewBlobStore(storage.NewConfigFromEnv())
+	if err != nil {
+		return nil, err
+	}
+	return usecase.NewCollectOrphanImagesInteractor(postgres.NewImageRepository(db), blobStore), nil
+}
@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"app/src/adapter/presenter"
+	"app/src/infrastructure/database/postgres"
+	"app/src/usecase"
+)
+
+// RunImport は `app import` サブコマンドの本体です。
+// GeoJSON / CSV からスポット（-posts 指定時は投稿も）を取り込み、行ごとの結果と集計を out に出力します。
+//
+//	app import -file tokyo.geojson -user trapizzino_admin [-format geojson|csv] [-posts] [-dry-run]
+func RunImport(ctx context.Context, db *sql.DB, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	fs.SetOutput(out)
+	file := fs.String("file", "", "取り込むファイルのパス（必須）")
+	format := fs.String("format", "", "geojson または csv（省略時は拡張子から判定）")
+	username := fs.String("user", "", "新規スポットの登録者・投稿者となるユーザー名（必須）")
+	withPosts := fs.Bool("posts", false, "各行の caption / image_url から投稿も作成する")
+	dryRun := fs.Bool("dry-run", false, "書き込みを行わず、結果のレポートのみ出力する")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *file == "" || *username == "" {
+		fs.Usage()
+		return errors.New("-file and -user are required")
+	}
+
+	// 1. ファイルの読み取り
+	rows, err := parseImportFile(*file, *format)
+	if err != nil {
+		return err
+	}
+
+	// 2. ユースケースの組み立てと実行（API と同じリポジトリ・検証を通す）
+	interactor := usecase.NewImportSpotsInteractor(
+		presenter.NewImportSpotsPresenter(),
+		postgres.NewSpotRepository(db),
+		postgres.NewPostRepository(db),
+		postgres.NewUserRepository(db),
+	)
+	output, err := interactor.Execute(ctx, usecase.ImportSpotsInput{
+		Username:  *username,
+		Rows:      rows,
+		WithPosts: *withPosts,
+		DryRun:    *dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	// 3. レポート出力
+	return writeImportReport(out, output)
+}
+
+func parseImportFile(path, format string) ([]usecase.ImportSpotRow, error) {
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".geojson", ".json":
+			format = "geojson"
+		case ".csv":
+			format = "csv"
+		default:
+			return nil, fmt.Errorf("cannot detect format of %s; use -format", path)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch format {
+	case "geojson":
+		return ParseGeoJSON(f)
+	case "csv":
+		return ParseCSV(f)
+	default:
+		return nil, fmt.Errorf("unknown format: %s", format)
+	}
+}
+
+func writeImportReport(out io.Writer, output *usecase.ImportSpotsOutput) error {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "LINE\tSTATUS\tSPOT_ID\tPOST\tNAME\tREASON")
+	for _, row := range output.Rows {
+		spotID := "-"
+		if row.SpotID != nil {
+			spotID = fmt.Sprint(*row.SpotID)
+		}
+		post := "-"
+		if row.PostCreated {
+			post = "yes"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n", row.Line, row.Status, spotID, post, row.Name, row.Reason)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	mode := ""
+	if output.DryRun {
+		mode = " (dry-run: nothing was written)"
+	}
+	_, err := fmt.Fprintf(out, "\ncreated: %d, merged: %d, rejected: %d, posts created: %d%s\n",
+		output.Created, output.Merged, output.Rejected, output.PostsCreated, mode)
+	return err
+}
@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"app/src/usecase"
+)
+
+// --- GeoJSON ---
+// FeatureCollection の Point Feature を1行として読み取ります。座標は GeoJSON の仕様どおり [経度, 緯度] の順です。
+// properties: name, category, address, price_range, opening_hours, caption, image_url
+
+type geoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []geoJSONFeature `json:"features"`
+}
+
+type geoJSONFeature struct {
+	Geometry *struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	} `json:"geometry"`
+	Properties struct {
+		Name         string              `json:"name"`
+		Category     string              `json:"category"`
+		Address      string              `json:"address"`
+		PriceRange   int                 `json:"price_range"`
+		OpeningHours map[string][]string `json:"opening_hours"`
+		Caption      string              `json:"caption"`
+		ImageURL     string              `json:"image_url"`
+	} `json:"properties"`
+}
+
+func ParseGeoJSON(r io.Reader) ([]usecase.ImportSpotRow, error) {
+	var fc geoJSONFeatureCollection
+	if err := json.NewDecoder(r).Decode(&fc); err != nil {
+		return nil, fmt.Errorf("invalid geojson: %w", err)
+	}
+	if fc.Type != "FeatureCollection" {
+		return nil, errors.New("geojson must be a FeatureCollection")
+	}
+
+	rows := make([]usecase.ImportSpotRow, 0, len(fc.Features))
+	for i, f := range fc.Features {
+		row := usecase.ImportSpotRow{
+			Line:         i + 1,
+			Name:         f.Properties.Name,
+			Category:     f.Properties.Category,
+			Address:      f.Properties.Address,
+			PriceRange:   f.Properties.PriceRange,
+			OpeningHours: f.Properties.OpeningHours,
+			Caption:      f.Properties.Caption,
+			ImageURL:     f.Properties.ImageURL,
+		}
+		var coordinates []float64
+		switch {
+		case f.Geometry == nil || f.Geometry.Type != "Point":
+			row.ParseError = "geometry must be a Point"
+		case json.Unmarshal(f.Geometry.Coordinates, &coordinates) != nil || len(coordinates) < 2:
+			row.ParseError = "point must have [longitude, latitude]"
+		default:
+			row.Longitude = coordinates[0]
+			row.Latitude = coordinates[1]
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// --- CSV ---
+// 1行目はヘッダー。name と緯度・経度の列は必須で、それ以外の列は任意です。
+// opening_hours 列は {"mon": ["11:00-15:00"]} 形式の JSON 文字列で指定します。
+
+var csvColumnAliases = map[string]string{
+	"name":          "name",
+	"lat":           "latitude",
+	"latitude":      "latitude",
+	"lng":           "longitude",
+	"lon":           "longitude",
+	"longitude":     "longitude",
+	"category":      "category",
+	"address":       "address",
+	"price_range":   "price_range",
+	"opening_hours": "opening_hours",
+	"caption":       "caption",
+	"image_url":     "image_url",
+}
+
+func ParseCSV(r io.Reader) ([]usecase.ImportSpotRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("invalid csv header: %w", err)
+	}
+	columns := make(map[string]int)
+	for i, h := range header {
+		key := strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))
+		if name, ok := csvColumnAliases[key]; ok {
+			columns[name] = i
+		}
+	}
+	for _, required := range []string{"name", "latitude", "longitude"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("csv header must contain %s column", required)
+		}
+	}
+
+	rows := make([]usecase.ImportSpotRow, 0)
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		get := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		row := usecase.ImportSpotRow{
+			Line:     line,
+			Name:     get("name"),
+			Category: get("category"),
+			Address:  get("address"),
+			Caption:  get("caption"),
+			ImageURL: get("image_url"),
+		}
+		row.ParseError = parseCSVFields(&row, get)
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// parseCSVFields は数値・JSON の列を読み取り、不備があればその理由を返します。
+func parseCSVFields(row *usecase.ImportSpotRow, get func(string) string) string {
+	var err error
+	if row.Latitude, err = strconv.ParseFloat(get("latitude"), 64); err != nil {
+		return "invalid latitude"
+	}
+	if row.Longitude, err = strconv.ParseFloat(get("longitude"), 64); err != nil {
+		return "invalid longitude"
+	}
+	if v := get("price_range"); v != "" {
+		if row.PriceRange, err = strconv.Atoi(v); err != nil {
+			return "invalid price_range"
+		}
+	}
+	if v := get("opening_hours"); v != "" {
+		if err := json.Unmarshal([]byte(v), &row.OpeningHours); err != nil {
+			return "invalid opening_hours"
+		}
+	}
+	return ""
+}
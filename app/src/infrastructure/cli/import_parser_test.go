@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGeoJSON(t *testing.T) {
+	src := `{
+	  "type": "FeatureCollection",
+	  "features": [
+	    {"type": "Feature", "geometry": {"type": "Point", "coordinates": [139.7101, 35.6467]},
+	     "properties": {"name": "恵比寿うどん", "category": "udon", "price_range": 1, "opening_hours": {"mon": ["11:00-15:00"]}}},
+	    {"type": "Feature", "geometry": {"type": "LineString", "coordinates": [[139.0, 35.0], [139.1, 35.1]]},
+	     "properties": {"name": "線の店"}}
+	  ]
+	}`
+
+	rows, err := ParseGeoJSON(strings.NewReader(src))
+	assert.NoError(t, err)
+	if assert.Len(t, rows, 2) {
+		// GeoJSON は [経度, 緯度] の順
+		assert.Equal(t, 35.6467, rows[0].Latitude)
+		assert.Equal(t, 139.7101, rows[0].Longitude)
+		assert.Equal(t, "udon", rows[0].Category)
+		assert.Equal(t, []string{"11:00-15:00"}, rows[0].OpeningHours["mon"])
+		assert.Empty(t, rows[0].ParseError)
+		assert.Equal(t, 2, rows[1].Line)
+		assert.NotEmpty(t, rows[1].ParseError)
+	}
+
+	_, err = ParseGeoJSON(strings.NewReader(`{"type": "Feature"}`))
+	assert.Error(t, err)
+}
+
+func TestParseCSV(t *testing.T) {
+	src := "name,lat,lng,category,price_range,opening_hours,caption\n" +
+		"恵比寿うどん,35.6467,139.7101,udon,1,\"{\"\"fri\"\": [\"\"18:00-02:00\"\"]}\",出汁が絶品\n" +
+		"座標なし,,139.0,,,,\n"
+
+	rows, err := ParseCSV(strings.NewReader(src))
+	assert.NoError(t, err)
+	if assert.Len(t, rows, 2) {
+		assert.Equal(t, 2, rows[0].Line)
+		assert.Equal(t, "恵比寿うどん", rows[0].Name)
+		assert.Equal(t, 35.6467, rows[0].Latitude)
+		assert.Equal(t, 1, rows[0].PriceRange)
+		assert.Equal(t, []string{"18:00-02:00"}, rows[0].OpeningHours["fri"])
+		assert.Equal(t, "出汁が絶品", rows[0].Caption)
+		assert.Empty(t, rows[0].ParseError)
+		assert.Equal(t, "invalid latitude", rows[1].ParseError)
+	}
+
+	_, err = ParseCSV(strings.NewReader("name,address\n恵比寿うどん,渋谷区\n"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"app/src/infrastructure/database/postgres"
+	"app/src/usecase"
+)
+
+// RunPurgeAccounts は `app purge-accounts` サブコマンドの本体です。
+// 退会の申請から猶予期間を過ぎたユーザーを完全に削除し、結果を out に出力します。
+//
+//	app purge-accounts [-grace 720h] [-dry-run]
+func RunPurgeAccounts(ctx context.Context, db *sql.DB, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("purge-accounts", flag.ContinueOnError)
+	fs.SetOutput(out)
+	grace := fs.Duration("grace", AccountDeletionGracePeriodFromEnv(), "退会の申請から完全に削除するまでの猶予期間")
+	dryRun := fs.Bool("dry-run", false, "削除を行わず、対象のユーザー ID のみ出力する")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	interactor := usecase.NewPurgeDeletedAccountsInteractor(postgres.NewAccountDeletionRepository(db))
+	output, err := interactor.Execute(ctx, usecase.PurgeDeletedAccountsInput{
+		Now:         time.Now(),
+		GracePeriod: *grace,
+		DryRun:      *dryRun,
+	})
+	if output != nil {
+		for _, id := range output.UserIDs {
+			fmt.Fprintln(out, id)
+		}
+		fmt.Fprintf(out, "purged: %d, failed: %d, reassigned spots: %d, deleted spots: %d, dry-run: %t\n",
+			output.Purged, len(output.FailedUserIDs), output.ReassignedSpots, output.DeletedSpots, output.DryRun)
+	}
+	return err
+}
+
+// RunAccountPurgeLoop は interval ごとに退会済みユーザーのパージを行います。ctx が終了するまで戻りません。
+func RunAccountPurgeLoop(ctx context.Context, db *sql.DB, interval time.Duration) {
+	interactor := usecase.NewPurgeDeletedAccountsInteractor(postgres.NewAccountDeletionRepository(db))
+	grace := AccountDeletionGracePeriodFromEnv()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			output, err := interactor.Execute(ctx, usecase.PurgeDeletedAccountsInput{Now: now, GracePeriod: grace})
+			if err != nil {
+				log.Printf("Account purge failed: %v", err)
+			}
+			if output != nil && output.Purged > 0 {
+				log.Printf("Account purge deleted %d users (reassigned %d spots, deleted %d spots)",
+					output.Purged, output.ReassignedSpots, output.DeletedSpots)
+			}
+		}
+	}
+}
+
+// AccountPurgeIntervalFromEnv は ACCOUNT_PURGE_INTERVAL（例: 1h）を読み取ります。未指定なら1時間、0 なら定期パージを行いません。
+func AccountPurgeIntervalFromEnv() time.Duration {
+	return durationFromEnv("ACCOUNT_PURGE_INTERVAL", time.Hour)
+}
+
+// AccountDeletionGracePeriodFromEnv は ACCOUNT_DELETION_GRACE_PERIOD（例: 720h）を読み取ります。
+func AccountDeletionGracePeriodFromEnv() time.Duration {
+	return durationFromEnv("ACCOUNT_DELETION_GRACE_PERIOD", usecase.DefaultAccountDeletionGracePeriod)
+}
@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+)
+
+type accountDeletionRepository struct {
+	db *sql.DB
+}
+
+func NewAccountDeletionRepository(db *sql.DB) entities.AccountDeletionRepository {
+	return &accountDeletionRepository{db: db}
+}
+
+func (r *accountDeletionRepository) RequestDeletion(ctx context.Context, userID value_objects.ID, at time.Time) (time.Time, error) {
+	var requestedAt time.Time
+	err := r.db.QueryRowContext(ctx, `
+		UPDATE users SET deletion_requested_at = COALESCE(deletion_requested_at, $2)
+		WHERE id = $1
+		RETURNING deletion_requested_at`, userID.Value(), at).Scan(&requestedAt)
+	return requestedAt, err
+}
+
+func (r *accountDeletionRepository) CancelDeletion(ctx context.Context, userID value_objects.ID) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE users SET deletion_requested_at = NULL WHERE id = $1`, userID.Value())
+	return err
+}
+
+func (r *accountDeletionRepository) FindDue(ctx context.Context, cutoff time.Time, limit int) ([]value_objects.ID, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id FROM users
+		WHERE deletion_requested_at IS NOT NULL AND deletion_requested_at <= $1
+		ORDER BY deletion_requested_at, id
+		LIMIT $2`, cutoff, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]value_objects.ID, 0)
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		uid, _ := value_objects.NewID(id)
+		ids = append(ids, uid)
+	}
+	return ids, rows.Err()
+}
+
+func (r *accountDeletionRepository) Purge(ctx context.Context, userID value_objects.ID, cutoff time.Time) (*entities.AccountPurgeResult, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// 1. 申請が取り消されていないことを確かめ、パージが終わるまで取り消し（再ログイン）を待たせる。
+	var id int
+	err = tx.QueryRowContext(ctx, `
+		SELECT id FROM users
+		WHERE id = $1 AND deletion_requested_at IS NOT NULL AND deletion_requested_at <= $2
+		FOR UPDATE`, userID.Value(), cutoff).Scan(&id)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 王座を持つスポットは、本人以外のユーザーへ王座を移す。
+	//    現行の投稿者（最新順）を優先し、次に上書き済みの投稿者、最後に閉店報告・修正提案・投票をしたユーザーを候補にする。
+	res, err := tx.ExecContext(ctx, `
+		UPDATE spots
+		SET registered_user_id = heir.user_id
+		FROM (
+		    SELECT DISTINCT ON (c.spot_id) c.spot_id, c.user_id
+		    FROM (
+		        SELECT p.spot_id, p.user_id, (p.superseded_at IS NOT NULL)::int AS rank, p.posted_at AS at, p.id FROM posts p
+		        UNION ALL
+		        SELECT r.spot_id, r.user_id, 2, r.created_at, 0 FROM spot_closure_reports r
+		        UNION ALL
+		        SELECT e.spot_id, e.proposer_id, 2, e.created_at, e.id FROM spot_edit_proposals e
+		        UNION ALL
+		        SELECT e.spot_id, v.user_id, 2, v.created_at, 0 FROM spot_edit_votes v JOIN spot_edit_proposals e ON e.id = v.proposal_id
+		    ) c
+		    JOIN spots s ON s.id = c.spot_id
+		    WHERE s.registered_user_id = $1 AND c.user_id <> $1
+		    ORDER BY c.spot_id, c.rank, c.at DESC, c.id DESC
+		) heir
+		WHERE spots.id = heir.spot_id`, userID.Value())
+	if err != nil {
+		return nil, err
+	}
+	reassigned, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 本人以外のユーザーの記録が1件も残っていないスポット（引き継ぐユーザーがいない）だけを削除する。
+	res, err = tx.ExecContext(ctx, `DELETE FROM spots WHERE registered_user_id = $1`, userID.Value())
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. ユーザーを削除する。投稿・リアクション・コメント・フォロー・ブロックなどは外部キーで連鎖して消え、
+	//    アップロード画像は所有者が外れて孤立画像の回収で削除される。
+	if _, err := tx.ExecContext(ctx, `DELETE FROM users WHERE id = $1`, userID.Value()); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &entities.AccountPurgeResult{
+		UserID:          userID,
+		ReassignedSpots: int(reassigned),
+		DeletedSpots:    int(deleted),
+	}, nil
+}
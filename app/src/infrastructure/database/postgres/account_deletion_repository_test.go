@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// openTestDB は TEST_DATABASE_DSN（マイグレーション適用済みの PostgreSQL）に接続します。未設定ならテストを飛ばします。
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN is not set")
+	}
+	db, err := sql.Open("postgres", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// 他のユーザーの投稿（上書き済みを含む）や閉店報告が残るスポットは王座を移して残し、本人の記録しかないスポットだけを削除すること
+func TestAccountDeletionRepository_Purge(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	users, spots, posts := NewUserRepository(db), NewSpotRepository(db), NewPostRepository(db)
+	suffix := time.Now().UnixNano() % 1_000_000_000
+
+	newUser := func(name string) *entities.User {
+		u, err := entities.NewUser(0, fmt.Sprintf("%s_%d", name, suffix), fmt.Sprintf("%s_%d@example.com", name, suffix), "hashed_password")
+		require.NoError(t, err)
+		u, err = users.Create(u)
+		require.NoError(t, err)
+		return u
+	}
+	bob, alice := newUser("purge_bob"), newUser("purge_alice")
+
+	var spotIDs []int
+	t.Cleanup(func() {
+		db.Exec(`DELETE FROM spots WHERE id = ANY($1)`, pq.Array(spotIDs))
+		db.Exec(`DELETE FROM users WHERE id IN ($1, $2)`, bob.ID.Value(), alice.ID.Value())
+	})
+	newSpot := func(i int) *entities.Spot {
+		// 同一座標の一意制約にかからないよう、実行ごとに座標をずらす
+		lat := 35.0 + float64(suffix%100000)/1e6 + float64(i)/1e7
+		s, err := entities.NewSpot(0, fmt.Sprintf("退会テスト%d", i), lat, 139.7, bob.ID.Value())
+		require.NoError(t, err)
+		s, err = spots.Create(s)
+		require.NoError(t, err)
+		spotIDs = append(spotIDs, s.ID.Value())
+		return s
+	}
+	newPost := func(u *entities.User, s *entities.Spot) *entities.Post {
+		p, err := entities.NewPost(0, u.ID.Value(), s.ID.Value(), u.Username.String(), "", "", time.Now())
+		require.NoError(t, err)
+		p, err = posts.Create(p)
+		require.NoError(t, err)
+		return p
+	}
+
+	// 1: alice の上書き済みの投稿だけが残る 2: alice の閉店報告だけが残る 3: bob の記録しかない
+	withOldPost, withReport, onlyBob := newSpot(1), newSpot(2), newSpot(3)
+	for _, s := range []*entities.Spot{withOldPost, withReport, onlyBob} {
+		newPost(bob, s)
+	}
+	oldPost := newPost(alice, withOldPost)
+	_, err := db.Exec(`UPDATE posts SET superseded_at = CURRENT_TIMESTAMP WHERE id = $1`, oldPost.ID.Value())
+	require.NoError(t, err)
+	_, _, err = spots.ReportClosure(ctx, withReport.ID, alice.ID, 100)
+	require.NoError(t, err)
+
+	repo := NewAccountDeletionRepository(db)
+	_, err = repo.RequestDeletion(ctx, bob.ID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+
+	result, err := repo.Purge(ctx, bob.ID, time.Now())
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, 2, result.ReassignedSpots)
+	assert.Equal(t, 1, result.DeletedSpots)
+
+	for _, id := range []value_objects.ID{withOldPost.ID, withReport.ID} {
+		s, err := spots.FindByID(ctx, id)
+		require.NoError(t, err)
+		if assert.NotNil(t, s) {
+			assert.Equal(t, alice.ID, s.RegisteredUserID)
+		}
+	}
+	gone, err := spots.FindByID(ctx, onlyBob.ID)
+	require.NoError(t, err)
+	assert.Nil(t, gone)
+}
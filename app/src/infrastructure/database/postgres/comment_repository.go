@@ -0,0 +1,270 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+
+	"github.com/lib/pq"
+)
+
+type commentRepository struct {
+	db *sql.DB
+}
+
+func NewCommentRepository(db *sql.DB) entities.CommentRepository {
+	return &commentRepository{db: db}
+}
+
+// commentColumns は Comment の復元に必要な列です（comments を c、users を u で参照する前提）。scanComment と対で使います。
+const commentColumns = `c.id, c.post_id, c.user_id, u.username, c.parent_id, c.body, c.status, c.moderation_reason,
+       c.created_at, c.updated_at, c.deleted_at`
+
+// commentVisibleTo は、閲覧者（$2）から見えるコメントの条件です（非表示のコメントは本人にだけ見える）。
+const commentVisibleTo = `(c.status = 'visible' OR c.user_id = $2)`
+
+func scanComment(row rowScanner, extra ...any) (*entities.Comment, error) {
+	var id, postID, userID int
+	var parentID sql.NullInt64
+	var username, body, status, reason string
+	var createdAt time.Time
+	var updatedAt, deletedAt sql.NullTime
+
+	dest := append([]any{&id, &postID, &userID, &username, &parentID, &body, &status, &reason, &createdAt, &updatedAt, &deletedAt}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	cid, _ := value_objects.NewID(id)
+	pid, _ := value_objects.NewID(postID)
+	uid, _ := value_objects.NewID(userID)
+	uname, _ := value_objects.NewUsername(username)
+	c := &entities.Comment{
+		ID:               cid,
+		PostID:           pid,
+		UserID:           uid,
+		Username:         uname,
+		Body:             value_objects.CommentBody(body),
+		Status:           entities.CommentStatus(status),
+		ModerationReason: reason,
+		CreatedAt:        createdAt,
+	}
+	if parentID.Valid {
+		parent, _ := value_objects.NewID(int(parentID.Int64))
+		c.ParentID = &parent
+	}
+	if updatedAt.Valid {
+		c.UpdatedAt = &updatedAt.Time
+	}
+	if deletedAt.Valid {
+		c.DeletedAt = &deletedAt.Time
+	}
+	return c, nil
+}
+
+func (r *commentRepository) Create(ctx context.Context, comment *entities.Comment) (*entities.Comment, error) {
+	var parentID sql.NullInt64
+	if comment.ParentID != nil {
+		parentID = sql.NullInt64{Int64: int64(comment.ParentID.Value()), Valid: true}
+	}
+	var id int
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO comments (post_id, user_id, parent_id, body, status, moderation_reason, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id`,
+		comment.PostID.Value(), comment.UserID.Value(), parentID, comment.Body.String(),
+		string(comment.Status), comment.ModerationReason, comment.CreatedAt,
+	).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+	comment.ID, _ = value_objects.NewID(id)
+	return comment, nil
+}
+
+func (r *commentRepository) FindByID(ctx context.Context, id value_objects.ID) (*entities.Comment, error) {
+	comment, err := scanComment(r.db.QueryRowContext(ctx, `
+		SELECT `+commentColumns+`
+		FROM comments c JOIN users u ON u.id = c.user_id
+		WHERE c.id = $1`, id.Value()))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return comment, nil
+}
+
+func (r *commentRepository) Update(ctx context.Context, comment *entities.Comment) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE comments SET body = $1, status = $2, moderation_reason = $3, updated_at = $4, deleted_at = $5
+		WHERE id = $6`,
+		comment.Body.String(), string(comment.Status), comment.ModerationReason,
+		nullTime(comment.UpdatedAt), nullTime(comment.DeletedAt), comment.ID.Value())
+	return err
+}
+
+func (r *commentRepository) FindByPost(ctx context.Context, postID, viewerID value_objects.ID, after *entities.CommentCursor, limit, repliesPerComment int) ([]*entities.Comment, error) {
+	// 削除済みのコメントは、表示できる返信が残っている場合だけ（本文を伏せて）一覧に残す。
+	query := `
+		SELECT ` + commentColumns + `
+		FROM comments c JOIN users u ON u.id = c.user_id
+		WHERE c.post_id = $1 AND c.parent_id IS NULL AND ` + commentVisibleTo + `
+		  AND c.user_id NOT IN ` + hiddenUserIDs("$2") + `
+		  AND (c.deleted_at IS NULL OR EXISTS (
+		      SELECT 1 FROM comments rc
+		      WHERE rc.parent_id = c.id AND rc.deleted_at IS NULL AND (rc.status = 'visible' OR rc.user_id = $2)
+		        AND rc.user_id NOT IN ` + hiddenUserIDs("$2") + `
+		  ))
+		  AND ($3::timestamptz IS NULL OR (c.created_at, c.id) > ($3::timestamptz, $4))
+		ORDER BY c.created_at, c.id
+		LIMIT $5`
+
+	afterAt, afterID := commentCursorArgs(after)
+	rows, err := r.db.QueryContext(ctx, query, postID.Value(), viewerID.Value(), afterAt, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	comments := make([]*entities.Comment, 0, limit)
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, comment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := r.attachReplies(ctx, comments, viewerID, repliesPerComment); err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+// attachReplies は各コメントに先頭 perComment 件の返信（古い順）と返信数をまとめて設定します。
+func (r *commentRepository) attachReplies(ctx context.Context, parents []*entities.Comment, viewerID value_objects.ID, perComment int) error {
+	if len(parents) == 0 {
+		return nil
+	}
+	byID := make(map[int]*entities.Comment, len(parents))
+	ids := make([]int64, 0, len(parents))
+	for _, p := range parents {
+		byID[p.ID.Value()] = p
+		ids = append(ids, int64(p.ID.Value()))
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT * FROM (
+			SELECT `+commentColumns+`,
+			       ROW_NUMBER() OVER (PARTITION BY c.parent_id ORDER BY c.created_at, c.id) AS rn,
+			       COUNT(*) OVER (PARTITION BY c.parent_id) AS reply_count
+			FROM comments c JOIN users u ON u.id = c.user_id
+			WHERE c.parent_id = ANY($1) AND c.deleted_at IS NULL AND `+commentVisibleTo+`
+			  AND c.user_id NOT IN `+hiddenUserIDs("$2")+`
+		) replies
+		WHERE replies.rn <= $3
+		ORDER BY replies.parent_id, replies.rn`, pq.Array(ids), viewerID.Value(), perComment)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rn, replyCount int
+		reply, err := scanComment(rows, &rn, &replyCount)
+		if err != nil {
+			return err
+		}
+		parent := byID[reply.ParentID.Value()]
+		if parent == nil {
+			continue
+		}
+		parent.ReplyCount = replyCount
+		parent.Replies = append(parent.Replies, reply)
+	}
+	return rows.Err()
+}
+
+func (r *commentRepository) FindReplies(ctx context.Context, parentID, viewerID value_objects.ID, after *entities.CommentCursor, limit int) ([]*entities.Comment, error) {
+	query := `
+		SELECT ` + commentColumns + `
+		FROM comments c JOIN users u ON u.id = c.user_id
+		WHERE c.parent_id = $1 AND c.deleted_at IS NULL AND ` + commentVisibleTo + `
+		  AND c.user_id NOT IN ` + hiddenUserIDs("$2") + `
+		  AND ($3::timestamptz IS NULL OR (c.created_at, c.id) > ($3::timestamptz, $4))
+		ORDER BY c.created_at, c.id
+		LIMIT $5`
+
+	afterAt, afterID := commentCursorArgs(after)
+	rows, err := r.db.QueryContext(ctx, query, parentID.Value(), viewerID.Value(), afterAt, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	replies := make([]*entities.Comment, 0, limit)
+	for rows.Next() {
+		reply, err := scanComment(rows)
+		if err != nil {
+			return nil, err
+		}
+		replies = append(replies, reply)
+	}
+	return replies, rows.Err()
+}
+
+func commentCursorArgs(after *entities.CommentCursor) (sql.NullTime, int) {
+	if after == nil {
+		return sql.NullTime{}, 0
+	}
+	return sql.NullTime{Time: after.CreatedAt, Valid: true}, after.ID.Value()
+}
+
+func nullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+// attachPostCommentCounts は投稿ごとの公開中のコメント（返信を含む）の件数をまとめて読み込み、各投稿に設定します。
+func attachPostCommentCounts(ctx context.Context, db *sql.DB, posts []*entities.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(posts))
+	for _, p := range posts {
+		ids = append(ids, int64(p.ID.Value()))
+	}
+	rows, err := db.QueryContext(ctx, `
+		SELECT post_id, COUNT(*) FROM comments
+		WHERE post_id = ANY($1) AND status = 'visible' AND deleted_at IS NULL
+		GROUP BY post_id`, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	counts := make(map[int]int, len(posts))
+	for rows.Next() {
+		var postID, n int
+		if err := rows.Scan(&postID, &n); err != nil {
+			return err
+		}
+		counts[postID] = n
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, p := range posts {
+		p.CommentCount = counts[p.ID.Value()]
+	}
+	return nil
+}
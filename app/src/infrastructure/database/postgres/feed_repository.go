@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"app/src/domain/entities"
+
+	"github.com/lib/pq"
+)
+
+type feedRepository struct {
+	db *sql.DB
+}
+
+func NewFeedRepository(db *sql.DB) entities.FeedRepository {
+	return &feedRepository{db: db}
+}
+
+// FindByUsers は共鳴ユーザーの現行の投稿を、投稿先のスポットと合わせて新しい順に返します。
+// 同じユーザー・同じスポットに上書き済みの投稿が残っていれば、その投稿は「上書き」として扱います。
+// 閲覧者がブロック・ミュートしている（または閲覧者をブロックしている）ユーザーの投稿と、閲覧者に見えない投稿は含めません。
+func (r *feedRepository) FindByUsers(ctx context.Context, criteria entities.FeedCriteria) ([]entities.FeedItem, error) {
+	query := `
+        SELECT ` + spotColumns + `,
+               p.id, p.user_id, p.username, p.image_url, p.caption, p.posted_at, ` + postImageVariantColumns + `, ` + postRatingColumns + `, p.visibility,
+               EXISTS (
+                   SELECT 1 FROM posts prev
+                   WHERE prev.user_id = p.user_id AND prev.spot_id = p.spot_id AND prev.superseded_at IS NOT NULL
+               ) AS is_overwrite,
+               CASE WHEN $4::float8 IS NULL OR $5::float8 IS NULL THEN NULL
+                    ELSE ST_Distance(s.location, ST_SetSRID(ST_MakePoint($5, $4), 4326)::geography)
+               END AS distance_m
+        FROM posts p
+        JOIN spots s ON s.id = p.spot_id ` + postImageJoin + `
+        WHERE p.user_id = ANY($1)
+          AND p.superseded_at IS NULL
+          AND s.status <> 'closed'
+          AND ($2::timestamptz IS NULL OR (p.posted_at, p.id) < ($2::timestamptz, $3))
+          AND p.user_id NOT IN ` + hiddenUserIDs("$7") + `
+          AND ` + visiblePostCond("p", "$7") + `
+        ORDER BY p.posted_at DESC, p.id DESC
+        LIMIT $6`
+
+	userIDs := make([]int64, 0, len(criteria.UserIDs))
+	for _, id := range criteria.UserIDs {
+		userIDs = append(userIDs, int64(id.Value()))
+	}
+
+	var beforeAt sql.NullTime
+	var beforeID int
+	if criteria.Before != nil {
+		beforeAt = sql.NullTime{Time: criteria.Before.PostedAt, Valid: true}
+		beforeID = criteria.Before.ID.Value()
+	}
+
+	var lat, lng sql.NullFloat64
+	if criteria.Latitude != nil && criteria.Longitude != nil {
+		lat = sql.NullFloat64{Float64: criteria.Latitude.Value(), Valid: true}
+		lng = sql.NullFloat64{Float64: criteria.Longitude.Value(), Valid: true}
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(userIDs), beforeAt, beforeID, lat, lng, criteria.Limit, criteria.ViewerID.Value())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]entities.FeedItem, 0, criteria.Limit)
+	posts := make([]*entities.Post, 0, criteria.Limit)
+	for rows.Next() {
+		var pid, uid int
+		var uname, capStr, thumbURL, mediumURL string
+		var ratings [4]int
+		var visibility string
+		var img sql.NullString
+		var postedAt time.Time
+		var isOverwrite bool
+		var distance sql.NullFloat64
+		spot, err := scanSpot(rows, &pid, &uid, &uname, &img, &capStr, &postedAt, &thumbURL, &mediumURL,
+			&ratings[0], &ratings[1], &ratings[2], &ratings[3], &visibility, &isOverwrite, &distance)
+		if err != nil {
+			return nil, err
+		}
+		p, err := entities.NewPost(pid, uid, spot.ID.Value(), uname, img.String, capStr, postedAt)
+		if err != nil {
+			return nil, err
+		}
+		p.ImageVariants = variantsOf(thumbURL, mediumURL)
+		p.Rating = ratingOf(ratings)
+		p.Visibility = visibilityOf(visibility)
+
+		item := entities.FeedItem{Post: p, Spot: spot, IsOverwrite: isOverwrite}
+		if distance.Valid {
+			km := distance.Float64 / 1000.0
+			item.DistanceKm = &km
+		}
+		items = append(items, item)
+		posts = append(posts, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := attachPostDetails(ctx, r.db, posts); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
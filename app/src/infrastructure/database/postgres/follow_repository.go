@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+)
+
+type followRepository struct {
+	db *sql.DB
+}
+
+func NewFollowRepository(db *sql.DB) entities.FollowRepository {
+	return &followRepository{db: db}
+}
+
+func (r *followRepository) Follow(ctx context.Context, followerID, followeeID value_objects.ID) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO follows (follower_id, followee_id) VALUES ($1, $2)
+		ON CONFLICT (follower_id, followee_id) DO NOTHING`,
+		followerID.Value(), followeeID.Value())
+	return err
+}
+
+func (r *followRepository) Unfollow(ctx context.Context, followerID, followeeID value_objects.ID) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM follows WHERE follower_id = $1 AND followee_id = $2`,
+		followerID.Value(), followeeID.Value())
+	return err
+}
+
+func (r *followRepository) CountByUser(ctx context.Context, userID value_objects.ID) (entities.FollowCounts, error) {
+	var counts entities.FollowCounts
+	err := r.db.QueryRowContext(ctx, `
+		SELECT (SELECT COUNT(*) FROM follows WHERE followee_id = $1),
+		       (SELECT COUNT(*) FROM follows WHERE follower_id = $1)`,
+		userID.Value()).Scan(&counts.Followers, &counts.Following)
+	return counts, err
+}
+
+func (r *followRepository) FindFollowers(ctx context.Context, userID, viewerID value_objects.ID, before *entities.FollowCursor, limit int) ([]entities.FollowEdge, error) {
+	return r.findEdges(ctx, "f.followee_id", "f.follower_id", userID, viewerID, before, limit)
+}
+
+func (r *followRepository) FindFollowing(ctx context.Context, userID, viewerID value_objects.ID, before *entities.FollowCursor, limit int) ([]entities.FollowEdge, error) {
+	return r.findEdges(ctx, "f.follower_id", "f.followee_id", userID, viewerID, before, limit)
+}
+
+// findEdges は ownerColumn = userID のフォローを、相手（otherColumn）のユーザー情報付きで新しい順に返します。
+// 閲覧者から見えない相手（hiddenUserIDs）は除きます。
+func (r *followRepository) findEdges(ctx context.Context, ownerColumn, otherColumn string, userID, viewerID value_objects.ID, before *entities.FollowCursor, limit int) ([]entities.FollowEdge, error) {
+	query := `SELECT ` + userColumns + `, f.created_at
+	          FROM follows f
+	          JOIN users u ON u.id = ` + otherColumn + ` ` + userAvatarJoin + `
+	          WHERE ` + ownerColumn + ` = $1
+	            AND u.id NOT IN ` + hiddenUserIDs("$5") + `
+	            AND ($2::timestamptz IS NULL OR (f.created_at, ` + otherColumn + `) < ($2::timestamptz, $3))
+	          ORDER BY f.created_at DESC, ` + otherColumn + ` DESC
+	          LIMIT $4`
+
+	var beforeAt sql.NullTime
+	var beforeID int
+	if before != nil {
+		beforeAt = sql.NullTime{Time: before.FollowedAt, Valid: true}
+		beforeID = before.UserID.Value()
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, userID.Value(), beforeAt, beforeID, limit, viewerID.Value())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	edges := make([]entities.FollowEdge, 0, limit)
+	for rows.Next() {
+		var followedAt time.Time
+		user, err := scanUser(rows, &followedAt)
+		if err != nil {
+			return nil, err
+		}
+		edges = append(edges, entities.FollowEdge{User: user, FollowedAt: followedAt})
+	}
+	return edges, rows.Err()
+}
+
+// FindFolloweeIDs はミュート中のユーザーを除きます（ブロックした相手とのフォローはブロック時に解除済み）。
+func (r *followRepository) FindFolloweeIDs(ctx context.Context, followerID value_objects.ID) ([]value_objects.ID, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT followee_id FROM follows
+		WHERE follower_id = $1 AND followee_id NOT IN `+hiddenUserIDs("$1"), followerID.Value())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []value_objects.ID
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		idVO, _ := value_objects.NewID(id)
+		ids = append(ids, idVO)
+	}
+	return ids, rows.Err()
+}
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+)
+
+type hashtagRepository struct {
+	db *sql.DB
+}
+
+func NewHashtagRepository(db *sql.DB) entities.HashtagRepository {
+	return &hashtagRepository{db: db}
+}
+
+func (r *hashtagRepository) FindSpotsByTag(ctx context.Context, tag value_objects.Hashtag, limit int) ([]entities.TaggedSpot, error) {
+	query := `
+        SELECT ` + spotColumns + `, tagged.post_count, tagged.latest_posted_at
+        FROM (
+            SELECT p.spot_id, COUNT(*) AS post_count, MAX(p.posted_at) AS latest_posted_at
+            FROM tags t
+            JOIN post_tags pt ON pt.tag_id = t.id
+            JOIN posts p ON p.id = pt.post_id AND p.superseded_at IS NULL
+            WHERE t.name = $1 AND ` + publicPostCond("p") + `
+            GROUP BY p.spot_id
+        ) tagged
+        JOIN spots s ON s.id = tagged.spot_id
+        WHERE s.status <> 'closed'
+        ORDER BY tagged.post_count DESC, tagged.latest_posted_at DESC, s.id DESC
+        LIMIT $2`
+
+	rows, err := r.db.QueryContext(ctx, query, tag.String(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	spots := make([]entities.TaggedSpot, 0, limit)
+	for rows.Next() {
+		var item entities.TaggedSpot
+		spot, err := scanSpot(rows, &item.PostCount, &item.LatestPostedAt)
+		if err != nil {
+			return nil, err
+		}
+		item.Spot = spot
+		spots = append(spots, item)
+	}
+	return spots, rows.Err()
+}
+
+func (r *hashtagRepository) FindTrending(ctx context.Context, since time.Time, limit int) ([]entities.TrendingTag, error) {
+	query := `
+        SELECT t.name, COUNT(*) AS post_count, COUNT(DISTINCT p.spot_id) AS spot_count
+        FROM posts p
+        JOIN post_tags pt ON pt.post_id = p.id
+        JOIN tags t ON t.id = pt.tag_id
+        WHERE p.posted_at >= $1 AND p.superseded_at IS NULL AND ` + publicPostCond("p") + `
+        GROUP BY t.name
+        ORDER BY post_count DESC, spot_count DESC, t.name
+        LIMIT $2`
+
+	rows, err := r.db.QueryContext(ctx, query, since, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make([]entities.TrendingTag, 0, limit)
+	for rows.Next() {
+		var name string
+		var item entities.TrendingTag
+		if err := rows.Scan(&name, &item.PostCount, &item.SpotCount); err != nil {
+			return nil, err
+		}
+		item.Tag = value_objects.Hashtag(name)
+		tags = append(tags, item)
+	}
+	return tags, rows.Err()
+}
@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+)
+
+type imageRepository struct {
+	db *sql.DB
+}
+
+func NewImageRepository(db *sql.DB) entities.ImageRepository {
+	return &imageRepository{db: db}
+}
+
+const imageColumns = `i.id, COALESCE(i.owner_id, 0), i.storage_key, i.url, i.content_type, i.size_bytes, i.width, i.height, i.created_at,
+	COALESCE(i.thumb_key, ''), COALESCE(i.thumb_url, ''), COALESCE(i.medium_key, ''), COALESCE(i.medium_url, ''),
+	i.gps_latitude, i.gps_longitude, i.captured_at`
+
+// postImageJoin / postImageVariantColumns は、投稿（別名 p）の image_url からアップロード画像の派生サイズを引き当てます。
+// 外部URLの投稿では結合先がなく、派生サイズは空になります。
+const (
+	postImageJoin           = `LEFT JOIN images pi ON pi.url = p.image_url`
+	postImageVariantColumns = `COALESCE(pi.thumb_url, ''), COALESCE(pi.medium_url, '')`
+)
+
+// variantsOf は postImageVariantColumns で読み取った派生サイズのURLを復元します（DB上の値は保存時に検証済み）。
+func variantsOf(thumbURL, mediumURL string) entities.ImageVariants {
+	variants, _ := entities.NewImageVariants(thumbURL, mediumURL)
+	return variants
+}
+
+func scanImage(row rowScanner) (*entities.Image, error) {
+	var id, ownerID, width, height int
+	var key, url, contentType string
+	var thumbKey, thumbURL, mediumKey, mediumURL string
+	var size int64
+	var createdAt time.Time
+	var gpsLat, gpsLng sql.NullFloat64
+	var capturedAt sql.NullTime
+	if err := row.Scan(&id, &ownerID, &key, &url, &contentType, &size, &width, &height, &createdAt,
+		&thumbKey, &thumbURL, &mediumKey, &mediumURL, &gpsLat, &gpsLng, &capturedAt); err != nil {
+		return nil, err
+	}
+
+	image, err := entities.NewImage(id, ownerID, key, url, contentType, size)
+	if err != nil {
+		return nil, err
+	}
+	if image.Thumb, err = entities.NewImageVariant(thumbKey, thumbURL); err != nil {
+		return nil, err
+	}
+	if image.Medium, err = entities.NewImageVariant(mediumKey, mediumURL); err != nil {
+		return nil, err
+	}
+	if gpsLat.Valid && gpsLng.Valid {
+		if image.GeoTag, err = entities.NewImageGeoTag(gpsLat.Float64, gpsLng.Float64); err != nil {
+			return nil, err
+		}
+	}
+	if capturedAt.Valid {
+		image.CapturedAt = &capturedAt.Time
+	}
+	image.Width, image.Height = width, height
+	image.CreatedAt = createdAt
+	return image, nil
+}
+
+func (r *imageRepository) Create(ctx context.Context, image *entities.Image) (*entities.Image, error) {
+	query := `INSERT INTO images (owner_id, storage_key, url, content_type, size_bytes, width, height,
+	                            thumb_key, thumb_url, medium_key, medium_url, gps_latitude, gps_longitude, captured_at)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''), NULLIF($9, ''), NULLIF($10, ''), NULLIF($11, ''), $12, $13, $14)
+	          RETURNING id, created_at`
+
+	var gpsLat, gpsLng sql.NullFloat64
+	if image.GeoTag != nil {
+		gpsLat = sql.NullFloat64{Float64: image.GeoTag.Latitude.Value(), Valid: true}
+		gpsLng = sql.NullFloat64{Float64: image.GeoTag.Longitude.Value(), Valid: true}
+	}
+	var capturedAt sql.NullTime
+	if image.CapturedAt != nil {
+		capturedAt = sql.NullTime{Time: *image.CapturedAt, Valid: true}
+	}
+
+	var id int
+	err := r.db.QueryRowContext(ctx, query,
+		image.OwnerID.Value(), image.StorageKey, image.URL.String(), image.ContentType, image.Size, image.Width, image.Height,
+		image.Thumb.StorageKey, image.Thumb.URL.String(), image.Medium.StorageKey, image.Medium.URL.String(),
+		gpsLat, gpsLng, capturedAt,
+	).Scan(&id, &image.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	image.ID, _ = value_objects.NewID(id)
+	return image, nil
+}
+
+func (r *imageRepository) FindByID(ctx context.Context, id value_objects.ID) (*entities.Image, error) {
+	query := `SELECT ` + imageColumns + ` FROM images i WHERE i.id = $1`
+	image, err := scanImage(r.db.QueryRowContext(ctx, query, id.Value()))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return image, nil
+}
+
+func (r *imageRepository) FindOrphans(ctx context.Context, createdBefore time.Time, limit int) ([]*entities.Image, error) {
+	query := `SELECT ` + imageColumns + `
+	          FROM images i
+	          WHERE i.created_at < $1
+	            AND NOT EXISTS (SELECT 1 FROM posts p WHERE p.image_url = i.url)
+	            AND NOT EXISTS (SELECT 1 FROM post_images pm WHERE pm.image_url = i.url)
+	            AND NOT EXISTS (SELECT 1 FROM users u WHERE u.avatar_image_id = i.id)
+	          ORDER BY i.created_at, i.id
+	          LIMIT $2`
+	rows, err := r.db.QueryContext(ctx, query, createdBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	images := make([]*entities.Image, 0)
+	for rows.Next() {
+		image, err := scanImage(rows)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, image)
+	}
+	return images, rows.Err()
+}
+
+func (r *imageRepository) Delete(ctx context.Context, id value_objects.ID) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM images WHERE id = $1`, id.Value())
+	return err
+}
@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+)
+
+type mentionRepository struct {
+	db *sql.DB
+}
+
+func NewMentionRepository(db *sql.DB) entities.MentionRepository {
+	return &mentionRepository{db: db}
+}
+
+func (r *mentionRepository) FindByMentionedUser(ctx context.Context, userID value_objects.ID, before *entities.PostCursor, limit int) ([]entities.Mention, error) {
+	query := `SELECT p.id, p.user_id, p.spot_id, p.username, p.image_url, p.caption, p.posted_at, ` + postImageVariantColumns + `, ` + postRatingColumns + `, p.visibility, s.name
+              FROM post_mentions pm
+              JOIN posts p ON p.id = pm.post_id AND p.superseded_at IS NULL
+              JOIN spots s ON s.id = p.spot_id ` + postImageJoin + `
+              WHERE pm.user_id = $1
+                AND ` + visiblePostCond("p", "$1") + `
+                AND p.user_id NOT IN ` + hiddenUserIDs("$1") + `
+                AND ($2::timestamptz IS NULL OR (p.posted_at, p.id) < ($2::timestamptz, $3))
+              ORDER BY p.posted_at DESC, p.id DESC
+              LIMIT $4`
+
+	var beforeAt sql.NullTime
+	var beforeID int
+	if before != nil {
+		beforeAt = sql.NullTime{Time: before.PostedAt, Valid: true}
+		beforeID = before.ID.Value()
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, userID.Value(), beforeAt, beforeID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	mentions := make([]entities.Mention, 0, limit)
+	posts := make([]*entities.Post, 0, limit)
+	for rows.Next() {
+		var pid, uid, sid int
+		var uname, capStr, thumbURL, mediumURL, spotName string
+		var ratings [4]int
+		var visibility string
+		var img sql.NullString
+		var postedAt time.Time
+		if err := rows.Scan(&pid, &uid, &sid, &uname, &img, &capStr, &postedAt, &thumbURL, &mediumURL, &ratings[0], &ratings[1], &ratings[2], &ratings[3], &visibility, &spotName); err != nil {
+			return nil, err
+		}
+		p, err := entities.NewPost(pid, uid, sid, uname, img.String, capStr, postedAt)
+		if err != nil {
+			return nil, err
+		}
+		p.ImageVariants = variantsOf(thumbURL, mediumURL)
+		p.Rating = ratingOf(ratings)
+		p.Visibility = visibilityOf(visibility)
+		name, _ := value_objects.NewSpotName(spotName)
+		mentions = append(mentions, entities.Mention{Post: p, SpotName: name})
+		posts = append(posts, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := attachPostDetails(ctx, r.db, posts); err != nil {
+		return nil, err
+	}
+	return mentions, nil
+}
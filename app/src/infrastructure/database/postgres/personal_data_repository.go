@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+)
+
+// personalDataArray は、$1 のユーザーの記録を返す副問い合わせの各行を JSON 配列にまとめます（0件なら []）。
+func personalDataArray(query string) string {
+	return `SELECT COALESCE(json_agg(t), '[]'::json) FROM (` + query + `) t`
+}
+
+// personalDataSections は書き出す区分と、その JSON を1値で返すクエリです（$1 は本人のユーザーID）。
+// 王座・投稿の公開範囲・上書き済みの投稿を問わず、本人に紐づく記録はすべて含めます。
+var personalDataSections = []struct {
+	name  string
+	query string
+}{
+	{"profile", `
+		SELECT row_to_json(t) FROM (
+		    SELECT id, username, email, display_name, bio, home_area, avatar_image_id, is_admin,
+		           created_at, updated_at, deletion_requested_at
+		    FROM users WHERE id = $1
+		) t`},
+	{"spots", personalDataArray(`
+		SELECT s.id, s.name, s.mesh_id,
+		       ST_Y(s.location::geometry) AS latitude, ST_X(s.location::geometry) AS longitude,
+		       s.status, s.registered_user_id = $1 AS is_throne, s.created_at
+		FROM spots s
+		WHERE s.registered_user_id = $1 OR s.id IN (SELECT spot_id FROM posts WHERE user_id = $1)
+		ORDER BY s.id`)},
+	{"posts", personalDataArray(`
+		SELECT p.id, p.spot_id, p.username, p.image_url, p.caption,
+		       p.rating, p.rating_taste, p.rating_value, p.rating_atmosphere, p.visibility,
+		       p.posted_at, p.superseded_at,
+		       COALESCE((SELECT json_agg(json_build_object('position', pi.position, 'image_url', pi.image_url, 'alt_text', pi.alt_text) ORDER BY pi.position)
+		                 FROM post_images pi WHERE pi.post_id = p.id), '[]'::json) AS images,
+		       COALESCE((SELECT json_agg(tg.name ORDER BY tg.name)
+		                 FROM post_tags pt JOIN tags tg ON tg.id = pt.tag_id WHERE pt.post_id = p.id), '[]'::json) AS tags
+		FROM posts p
+		WHERE p.user_id = $1
+		ORDER BY p.posted_at, p.id`)},
+	{"reactions", personalDataArray(`
+		SELECT post_id, kind, created_at FROM post_reactions
+		WHERE user_id = $1
+		ORDER BY created_at, post_id`)},
+	{"comments", personalDataArray(`
+		SELECT id, post_id, parent_id, body, status, moderation_reason, created_at, updated_at, deleted_at
+		FROM comments
+		WHERE user_id = $1
+		ORDER BY created_at, id`)},
+	{"follows", personalDataArray(`
+		SELECT 'following' AS direction, u.id AS user_id, u.username, f.created_at
+		FROM follows f JOIN users u ON u.id = f.followee_id
+		WHERE f.follower_id = $1
+		UNION ALL
+		SELECT 'follower', u.id, u.username, f.created_at
+		FROM follows f JOIN users u ON u.id = f.follower_id
+		WHERE f.followee_id = $1
+		ORDER BY created_at`)},
+	{"restrictions", personalDataArray(`
+		SELECT ur.kind, u.id AS user_id, u.username, ur.created_at
+		FROM user_restrictions ur JOIN users u ON u.id = ur.target_id
+		WHERE ur.user_id = $1
+		ORDER BY ur.created_at`)},
+	{"images", personalDataArray(`
+		SELECT id, url, thumb_url, medium_url, content_type, size_bytes, width, height,
+		       gps_latitude, gps_longitude, captured_at, created_at
+		FROM images
+		WHERE owner_id = $1
+		ORDER BY created_at, id`)},
+	// logs は本人の操作の記録（スポットの修正提案・投票、閉店報告、運営者としての統合）です。
+	{"logs", `
+		SELECT json_build_object(
+		    'spot_edit_proposals', (` + personalDataArray(`
+		        SELECT id, spot_id, kind, proposed_name,
+		               ST_Y(proposed_location::geometry) AS proposed_latitude, ST_X(proposed_location::geometry) AS proposed_longitude,
+		               proposed_category, status, created_at, resolved_at
+		        FROM spot_edit_proposals WHERE proposer_id = $1 ORDER BY created_at, id`) + `),
+		    'spot_edit_votes', (` + personalDataArray(`
+		        SELECT proposal_id, value, created_at FROM spot_edit_votes WHERE user_id = $1 ORDER BY created_at`) + `),
+		    'spot_closure_reports', (` + personalDataArray(`
+		        SELECT spot_id, created_at FROM spot_closure_reports WHERE user_id = $1 ORDER BY created_at`) + `),
+		    'spot_merges', (` + personalDataArray(`
+		        SELECT from_spot_id, to_spot_id, merged_at FROM spot_redirects WHERE merged_by = $1 ORDER BY merged_at`) + `)
+		)`},
+}
+
+type personalDataRepository struct {
+	db *sql.DB
+}
+
+func NewPersonalDataRepository(db *sql.DB) entities.PersonalDataRepository {
+	return &personalDataRepository{db: db}
+}
+
+// FindSections は全区分を同じスナップショットから読み出すため、読み取り専用のトランザクションで問い合わせます。
+func (r *personalDataRepository) FindSections(ctx context.Context, userID value_objects.ID) ([]entities.PersonalDataSection, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	sections := make([]entities.PersonalDataSection, 0, len(personalDataSections))
+	for _, s := range personalDataSections {
+		var data []byte
+		if err := tx.QueryRowContext(ctx, s.query, userID.Value()).Scan(&data); err != nil {
+			return nil, err
+		}
+		sections = append(sections, entities.PersonalDataSection{Name: s.name, JSON: data})
+	}
+	return sections, tx.Commit()
+}
@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"app/src/domain/entities"
+
+	"github.com/lib/pq"
+)
+
+// insertPostImages は投稿の添付画像を post_images に保存します（投稿の作成と同じトランザクションで呼ぶ）。
+// 添付画像の指定がなく image_url だけがある投稿（一括取り込みなど）は、その画像をカバー画像として保存します。
+func insertPostImages(tx *sql.Tx, post *entities.Post) error {
+	if len(post.Images) == 0 && post.ImageURL != "" {
+		cover, err := entities.NewPostImage(0, post.ImageURL.String(), "")
+		if err != nil {
+			return err
+		}
+		cover.Variants = post.ImageVariants
+		post.Images = []entities.PostImage{cover}
+	}
+
+	for _, img := range post.Images {
+		_, err := tx.Exec(`INSERT INTO post_images (post_id, position, image_url, alt_text) VALUES ($1, $2, $3, $4)`,
+			post.ID.Value(), img.Position, img.URL.String(), img.AltText.String())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attachPostDetails は一覧・詳細で返す投稿の付帯情報（添付画像・リアクション件数・コメント数）をまとめて読み込みます。
+func attachPostDetails(ctx context.Context, db *sql.DB, posts []*entities.Post) error {
+	if err := attachPostImages(ctx, db, posts); err != nil {
+		return err
+	}
+	if err := attachPostReactions(ctx, db, posts); err != nil {
+		return err
+	}
+	return attachPostCommentCounts(ctx, db, posts)
+}
+
+// attachPostImages は投稿の添付画像（派生サイズのURLを含む）をまとめて読み込み、各投稿に設定します。
+func attachPostImages(ctx context.Context, db *sql.DB, posts []*entities.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+	byID := make(map[int]*entities.Post, len(posts))
+	ids := make([]int64, 0, len(posts))
+	for _, p := range posts {
+		p.Images = nil
+		byID[p.ID.Value()] = p
+		ids = append(ids, int64(p.ID.Value()))
+	}
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT pm.post_id, pm.position, pm.image_url, pm.alt_text, COALESCE(i.thumb_url, ''), COALESCE(i.medium_url, '')
+		FROM post_images pm
+		LEFT JOIN images i ON i.url = pm.image_url
+		WHERE pm.post_id = ANY($1)
+		ORDER BY pm.post_id, pm.position`, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var postID, position int
+		var url, altText, thumbURL, mediumURL string
+		if err := rows.Scan(&postID, &position, &url, &altText, &thumbURL, &mediumURL); err != nil {
+			return err
+		}
+		img, err := entities.NewPostImage(position, url, altText)
+		if err != nil {
+			return err
+		}
+		img.Variants = variantsOf(thumbURL, mediumURL)
+		if p := byID[postID]; p != nil {
+			p.Images = append(p.Images, img)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	// post_images を持たない投稿は image_url をカバー画像1枚として扱う。
+	for _, p := range posts {
+		if len(p.Images) == 0 && p.ImageURL != "" {
+			cover, _ := entities.NewPostImage(0, p.ImageURL.String(), "")
+			cover.Variants = p.ImageVariants
+			p.Images = []entities.PostImage{cover}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+
+	"github.com/lib/pq"
+)
+
+// postRatingColumns は投稿（別名 p）の評価の列です。ratingOf と対で使います。
+const postRatingColumns = `p.rating, p.rating_taste, p.rating_value, p.rating_atmosphere`
+
+// ratingOf は postRatingColumns で読み取った評価を復元します（DB上の値は保存時に検証済み）。
+func ratingOf(ratings [4]int) entities.PostRating {
+	rating, _ := entities.NewPostRating(ratings[0], ratings[1], ratings[2], ratings[3])
+	return rating
+}
+
+func (r *spotRepository) FindRatingsBySpots(ctx context.Context, spotIDs []value_objects.ID, viewerID value_objects.ID) (map[int]entities.SpotRating, error) {
+	ratings := make(map[int]entities.SpotRating, len(spotIDs))
+	if len(spotIDs) == 0 {
+		return ratings, nil
+	}
+	ids := make([]int64, 0, len(spotIDs))
+	for _, id := range spotIDs {
+		ids = append(ids, int64(id.Value()))
+	}
+
+	// 平均は評価が付いた投稿だけで算出する（AVG は NULL を無視する）。
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT p.spot_id,
+		       COUNT(*) FILTER (WHERE p.rating > 0),
+		       COALESCE(AVG(NULLIF(p.rating, 0)), 0),
+		       COALESCE(AVG(NULLIF(p.rating_taste, 0)), 0),
+		       COALESCE(AVG(NULLIF(p.rating_value, 0)), 0),
+		       COALESCE(AVG(NULLIF(p.rating_atmosphere, 0)), 0),
+		       COALESCE(MAX(p.rating) FILTER (WHERE p.user_id = s.registered_user_id), 0)
+		FROM posts p
+		JOIN spots s ON s.id = p.spot_id
+		WHERE p.spot_id = ANY($1) AND p.superseded_at IS NULL
+		  AND `+visiblePostCond("p", "$2")+`
+		GROUP BY p.spot_id
+		HAVING COUNT(*) FILTER (WHERE p.rating > 0) > 0`, pq.Array(ids), viewerID.Value())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var spotID, registeredUserRating int
+		var rating entities.SpotRating
+		if err := rows.Scan(&spotID, &rating.Count, &rating.Overall, &rating.Taste, &rating.Value, &rating.Atmosphere, &registeredUserRating); err != nil {
+			return nil, err
+		}
+		rating.RegisteredUserRating, _ = value_objects.NewRating(registeredUserRating)
+		ratings[spotID] = rating
+	}
+	return ratings, rows.Err()
+}
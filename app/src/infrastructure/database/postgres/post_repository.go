@@ -3,7 +3,9 @@ package postgres
 import (
 	"app/src/domain/entities"
 	"app/src/domain/value_objects"
+	"context"
 	"database/sql"
+	"time"
 )
 
 type PostRepository struct {
@@ -15,41 +17,125 @@ func NewPostRepository(db *sql.DB) *PostRepository {
 }
 
 func (r *PostRepository) Create(post *entities.Post) (*entities.Post, error) {
-	// 修正ポイント：username カラムと $3 パラメータを追加。引数の順番も整理。
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// username はトークンの値ではなく users テーブルの現在の値を使う（改名直後の古いトークン対策）
 	query := `
-		INSERT INTO posts (user_id, spot_id, username, image_url, caption, posted_at) 
-		VALUES ($1, $2, $3, $4, $5, $6) 
-		RETURNING id`
+		INSERT INTO posts (user_id, spot_id, username, image_url, caption, posted_at, rating, rating_taste, rating_value, rating_atmosphere, visibility) 
+		VALUES ($1, $2, (SELECT username FROM users WHERE id = $1 FOR SHARE), $3, $4, $5, $6, $7, $8, $9, $10) 
+		RETURNING id, username`
 
 	var id int
-	err := r.db.QueryRow(
+	var username string
+	err = tx.QueryRow(
 		query,
 		post.UserID.Value(),
-		post.SpotID.Value(),    // 修正：post.ID ではなく post.SpotID を渡す
-		post.UserName.String(), // 修正：UserName を追加
+		post.SpotID.Value(), // 修正：post.ID ではなく post.SpotID を渡す
 		post.ImageURL.String(),
 		post.Caption.String(),
 		post.PostedAt,
-	).Scan(&id)
+		post.Rating.Overall.Int(),
+		post.Rating.Taste.Int(),
+		post.Rating.Value.Int(),
+		post.Rating.Atmosphere.Int(),
+		post.Visibility.String(),
+	).Scan(&id, &username)
+
+	if err != nil {
+		return nil, err
+	}
+	post.ID, _ = value_objects.NewID(id)
+	post.UserName, _ = value_objects.NewUsername(username)
+
+	// 添付画像・ハッシュタグ・メンションは投稿と同じトランザクションで保存する
+	if err := insertPostImages(tx, post); err != nil {
+		return nil, err
+	}
+	if err := indexPostCaption(tx, post); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+func (r *PostRepository) Supersede(post *entities.Post) (*entities.Post, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
 
+	// 1. 同じユーザーが同じスポットに残している現行の投稿を上書き済みにする（削除はしない）
+	_, err = tx.Exec(`
+		UPDATE posts SET superseded_at = $3
+		WHERE user_id = $1 AND spot_id = $2 AND superseded_at IS NULL`,
+		post.UserID.Value(), post.SpotID.Value(), post.PostedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 新しい現行の投稿を作成する（username は users テーブルの現在の値を使う）
+	var id int
+	var username string
+	err = tx.QueryRow(`
+		INSERT INTO posts (user_id, spot_id, username, image_url, caption, posted_at, rating, rating_taste, rating_value, rating_atmosphere, visibility)
+		VALUES ($1, $2, (SELECT username FROM users WHERE id = $1 FOR SHARE), $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, username`,
+		post.UserID.Value(),
+		post.SpotID.Value(),
+		post.ImageURL.String(),
+		post.Caption.String(),
+		post.PostedAt,
+		post.Rating.Overall.Int(),
+		post.Rating.Taste.Int(),
+		post.Rating.Value.Int(),
+		post.Rating.Atmosphere.Int(),
+		post.Visibility.String(),
+	).Scan(&id, &username)
 	if err != nil {
 		return nil, err
 	}
 	post.ID, _ = value_objects.NewID(id)
+	post.UserName, _ = value_objects.NewUsername(username)
+
+	// 3. 添付画像・ハッシュタグ・メンションを保存する（上書きされた投稿の分は履歴として残る）
+	if err := insertPostImages(tx, post); err != nil {
+		return nil, err
+	}
+	if err := indexPostCaption(tx, post); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 	return post, nil
 }
 
-func (r *PostRepository) FindByID(id value_objects.ID) (*entities.Post, error) {
+// FindByID は閲覧者に見えない投稿を、存在しない投稿と同じく nil で返します。
+func (r *PostRepository) FindByID(id, viewerID value_objects.ID) (*entities.Post, error) {
 	// SELECT に username と spot_id を追加して、entities.Post の構造に合わせる
-	query := `SELECT id, user_id, spot_id, username, image_url, caption, posted_at FROM posts WHERE id = $1`
-	row := r.db.QueryRow(query, id.Value())
+	query := `SELECT p.id, p.user_id, p.spot_id, p.username, p.image_url, p.caption, p.posted_at, p.superseded_at, ` + postImageVariantColumns + `, ` + postRatingColumns + `, p.visibility
+	          FROM posts p ` + postImageJoin + ` WHERE p.id = $1 AND ` + visiblePostCond("p", "$2")
+	row := r.db.QueryRow(query, id.Value(), viewerID.Value())
 
 	var pid, userID, spotID int
-	var userName, caption string
+	var userName, caption, thumbURL, mediumURL string
+	var ratings [4]int
+	var visibility string
 	var imageURL sql.NullString
-	var postedAt sql.NullTime
+	var postedAt, supersededAt sql.NullTime
 
-	if err := row.Scan(&pid, &userID, &spotID, &userName, &imageURL, &caption, &postedAt); err != nil {
+	if err := row.Scan(&pid, &userID, &spotID, &userName, &imageURL, &caption, &postedAt, &supersededAt, &thumbURL, &mediumURL, &ratings[0], &ratings[1], &ratings[2], &ratings[3], &visibility); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
 		return nil, err
 	}
 
@@ -60,7 +146,7 @@ func (r *PostRepository) FindByID(id value_objects.ID) (*entities.Post, error) {
 	imgURL, _ := value_objects.NewImageURL(imageURL.String)
 	capVO, _ := value_objects.NewCaption(caption)
 
-	return &entities.Post{
+	post := &entities.Post{
 		ID:       postID,
 		UserID:   uID,
 		SpotID:   sID,
@@ -68,12 +154,23 @@ func (r *PostRepository) FindByID(id value_objects.ID) (*entities.Post, error) {
 		ImageURL: imgURL,
 		Caption:  capVO,
 		PostedAt: postedAt.Time,
-	}, nil
+	}
+	post.ImageVariants = variantsOf(thumbURL, mediumURL)
+	post.Rating = ratingOf(ratings)
+	post.Visibility = visibilityOf(visibility)
+	if supersededAt.Valid {
+		post.SupersededAt = &supersededAt.Time
+	}
+	if err := attachPostDetails(context.Background(), r.db, []*entities.Post{post}); err != nil {
+		return nil, err
+	}
+	return post, nil
 }
 
-func (r *PostRepository) FindBySpotID(spotID value_objects.ID) ([]*entities.Post, error) {
-	query := `SELECT id, user_id, spot_id, username, image_url, caption, posted_at FROM posts WHERE spot_id = $1`
-	rows, err := r.db.Query(query, spotID.Value())
+func (r *PostRepository) FindBySpotID(spotID, viewerID value_objects.ID) ([]*entities.Post, error) {
+	query := `SELECT p.id, p.user_id, p.spot_id, p.username, p.image_url, p.caption, p.posted_at, ` + postImageVariantColumns + `, ` + postRatingColumns + `, p.visibility
+	          FROM posts p ` + postImageJoin + ` WHERE p.spot_id = $1 AND p.superseded_at IS NULL AND ` + visiblePostCond("p", "$2")
+	rows, err := r.db.Query(query, spotID.Value(), viewerID.Value())
 	if err != nil {
 		return nil, err
 	}
@@ -82,10 +179,12 @@ func (r *PostRepository) FindBySpotID(spotID value_objects.ID) ([]*entities.Post
 	var posts []*entities.Post
 	for rows.Next() {
 		var pid, userID, sid int
-		var userName, caption string
+		var userName, caption, thumbURL, mediumURL string
+		var ratings [4]int
+		var visibility string
 		var imageURL sql.NullString
 		var postedAt sql.NullTime
-		if err := rows.Scan(&pid, &userID, &sid, &userName, &imageURL, &caption, &postedAt); err != nil {
+		if err := rows.Scan(&pid, &userID, &sid, &userName, &imageURL, &caption, &postedAt, &thumbURL, &mediumURL, &ratings[0], &ratings[1], &ratings[2], &ratings[3], &visibility); err != nil {
 			return nil, err
 		}
 
@@ -104,14 +203,23 @@ func (r *PostRepository) FindBySpotID(spotID value_objects.ID) ([]*entities.Post
 			ImageURL: imgURL,
 			Caption:  capVO,
 			PostedAt: postedAt.Time,
+
+			ImageVariants: variantsOf(thumbURL, mediumURL),
+			Rating:        ratingOf(ratings),
+			Visibility:    visibilityOf(visibility),
 		})
 	}
+	if err := attachPostDetails(context.Background(), r.db, posts); err != nil {
+		return nil, err
+	}
 	return posts, nil
 }
 
-func (r *PostRepository) FindByUserID(userID value_objects.ID) ([]*entities.Post, error) {
-	query := `SELECT id, user_id, spot_id, username, image_url, caption, posted_at FROM posts WHERE user_id = $1 ORDER BY posted_at DESC, id DESC`
-	rows, err := r.db.Query(query, userID.Value())
+func (r *PostRepository) FindByUserID(userID, viewerID value_objects.ID) ([]*entities.Post, error) {
+	query := `SELECT p.id, p.user_id, p.spot_id, p.username, p.image_url, p.caption, p.posted_at, ` + postImageVariantColumns + `, ` + postRatingColumns + `, p.visibility
+	          FROM posts p ` + postImageJoin + ` WHERE p.user_id = $1 AND p.superseded_at IS NULL AND ` + visiblePostCond("p", "$2") + `
+	          ORDER BY p.posted_at DESC, p.id DESC`
+	rows, err := r.db.Query(query, userID.Value(), viewerID.Value())
 	if err != nil {
 		return nil, err
 	}
@@ -120,10 +228,12 @@ func (r *PostRepository) FindByUserID(userID value_objects.ID) ([]*entities.Post
 	var posts []*entities.Post
 	for rows.Next() {
 		var pid, uid, sid int
-		var userName, imageURL, caption string
+		var userName, imageURL, caption, thumbURL, mediumURL string
+		var ratings [4]int
+		var visibility string
 		var postedAt sql.NullTime
 
-		if err := rows.Scan(&pid, &uid, &sid, &userName, &imageURL, &caption, &postedAt); err != nil {
+		if err := rows.Scan(&pid, &uid, &sid, &userName, &imageURL, &caption, &postedAt, &thumbURL, &mediumURL, &ratings[0], &ratings[1], &ratings[2], &ratings[3], &visibility); err != nil {
 			return nil, err
 		}
 
@@ -142,16 +252,92 @@ func (r *PostRepository) FindByUserID(userID value_objects.ID) ([]*entities.Post
 			ImageURL: imgURL,
 			Caption:  capVO,
 			PostedAt: postedAt.Time,
+
+			ImageVariants: variantsOf(thumbURL, mediumURL),
+			Rating:        ratingOf(ratings),
+			Visibility:    visibilityOf(visibility),
 		})
 	}
+	if err := attachPostDetails(context.Background(), r.db, posts); err != nil {
+		return nil, err
+	}
 
 	return posts, nil
 }
 
+func (r *PostRepository) FindHistoryBySpotID(spotID, viewerID value_objects.ID) ([]*entities.Post, error) {
+	query := `SELECT p.id, p.user_id, p.spot_id, p.username, p.image_url, p.caption, p.posted_at, p.superseded_at, ` + postImageVariantColumns + `, ` + postRatingColumns + `, p.visibility
+	          FROM posts p ` + postImageJoin + ` WHERE p.spot_id = $1 AND ` + visiblePostCond("p", "$2") + ` ORDER BY p.posted_at DESC, p.id DESC`
+	return r.findHistory(query, spotID.Value(), viewerID.Value())
+}
+
+func (r *PostRepository) FindHistoryByUserID(userID, viewerID value_objects.ID) ([]*entities.Post, error) {
+	query := `SELECT p.id, p.user_id, p.spot_id, p.username, p.image_url, p.caption, p.posted_at, p.superseded_at, ` + postImageVariantColumns + `, ` + postRatingColumns + `, p.visibility
+	          FROM posts p ` + postImageJoin + ` WHERE p.user_id = $1 AND ` + visiblePostCond("p", "$2") + ` ORDER BY p.posted_at DESC, p.id DESC`
+	return r.findHistory(query, userID.Value(), viewerID.Value())
+}
+
+// findHistory は上書き済みの投稿を含めて読み取り、SupersededAt を復元します。
+func (r *PostRepository) findHistory(query string, args ...any) ([]*entities.Post, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	posts := make([]*entities.Post, 0)
+	for rows.Next() {
+		var pid, uid, sid int
+		var userName, caption, thumbURL, mediumURL string
+		var ratings [4]int
+		var visibility string
+		var imageURL sql.NullString
+		var postedAt time.Time
+		var supersededAt sql.NullTime
+		if err := rows.Scan(&pid, &uid, &sid, &userName, &imageURL, &caption, &postedAt, &supersededAt, &thumbURL, &mediumURL, &ratings[0], &ratings[1], &ratings[2], &ratings[3], &visibility); err != nil {
+			return nil, err
+		}
+
+		post, err := entities.NewPost(pid, uid, sid, userName, imageURL.String, caption, postedAt)
+		if err != nil {
+			return nil, err
+		}
+		post.ImageVariants = variantsOf(thumbURL, mediumURL)
+		post.Rating = ratingOf(ratings)
+		post.Visibility = visibilityOf(visibility)
+		if supersededAt.Valid {
+			post.SupersededAt = &supersededAt.Time
+		}
+		posts = append(posts, post)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := attachPostDetails(context.Background(), r.db, posts); err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
 func (r *PostRepository) Update(post *entities.Post) error {
-	query := `UPDATE posts SET image_url = $1, caption = $2, posted_at = $3, username = $4 WHERE id = $5`
-	_, err := r.db.Exec(query, post.ImageURL.String(), post.Caption.String(), post.PostedAt, post.UserName.String(), post.ID.Value())
-	return err
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE posts SET image_url = $1, caption = $2, posted_at = $3, username = $4,
+	          rating = $5, rating_taste = $6, rating_value = $7, rating_atmosphere = $8, visibility = $10 WHERE id = $9`
+	if _, err := tx.Exec(query, post.ImageURL.String(), post.Caption.String(), post.PostedAt, post.UserName.String(),
+		post.Rating.Overall.Int(), post.Rating.Taste.Int(), post.Rating.Value.Int(), post.Rating.Atmosphere.Int(), post.ID.Value(),
+		post.Visibility.String()); err != nil {
+		return err
+	}
+	// キャプションが変わった場合に備えて索引を作り直す
+	if err := indexPostCaption(tx, post); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 func (r *PostRepository) Delete(id value_objects.ID) error {
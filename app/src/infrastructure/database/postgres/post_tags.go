@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+
+	"github.com/lib/pq"
+)
+
+// indexPostCaption はキャプションのハッシュタグとメンションを post_tags / post_mentions に保存します（投稿の保存と同じトランザクションで呼ぶ）。
+// メンションは実在するユーザー名だけを解決し、解決できたユーザーを post.Mentions に設定します。
+func indexPostCaption(tx *sql.Tx, post *entities.Post) error {
+	if _, err := tx.Exec(`DELETE FROM post_tags WHERE post_id = $1`, post.ID.Value()); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`DELETE FROM post_mentions WHERE post_id = $1`, post.ID.Value()); err != nil {
+		return err
+	}
+
+	if tags := post.Caption.Hashtags(); len(tags) > 0 {
+		names := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			names = append(names, tag.String())
+		}
+		// DO UPDATE にしているのは、既存のタグでも RETURNING で id を返させるため
+		_, err := tx.Exec(`
+			WITH t AS (
+				INSERT INTO tags (name) SELECT unnest($2::text[])
+				ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+				RETURNING id
+			)
+			INSERT INTO post_tags (post_id, tag_id) SELECT $1, id FROM t`,
+			post.ID.Value(), pq.Array(names))
+		if err != nil {
+			return err
+		}
+	}
+
+	post.Mentions = nil
+	usernames := post.Caption.Mentions()
+	if len(usernames) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(usernames))
+	for _, name := range usernames {
+		names = append(names, name.String())
+	}
+	rows, err := tx.Query(`
+		WITH m AS (
+			INSERT INTO post_mentions (post_id, user_id)
+			SELECT $1, u.id FROM users u WHERE u.username = ANY($2) AND u.id <> $3
+			RETURNING user_id
+		)
+		SELECT u.id, u.username FROM m JOIN users u ON u.id = m.user_id ORDER BY u.username`,
+		post.ID.Value(), pq.Array(names), post.UserID.Value())
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var userID int
+		var username string
+		if err := rows.Scan(&userID, &username); err != nil {
+			return err
+		}
+		uid, _ := value_objects.NewID(userID)
+		uname, _ := value_objects.NewUsername(username)
+		post.Mentions = append(post.Mentions, entities.PostMention{UserID: uid, Username: uname})
+	}
+	return rows.Err()
+}
@@ -0,0 +1,22 @@
+package postgres
+
+import "app/src/domain/value_objects"
+
+// visibilityOf は posts.visibility を復元します（DB上の値は CHECK 制約で検証済み）。
+func visibilityOf(visibility string) value_objects.PostVisibility {
+	return value_objects.PostVisibility(visibility)
+}
+
+// visiblePostCond は、投稿（別名 alias）が閲覧者（プレースホルダー viewer）に見えるかどうかの条件です。
+// 本人の投稿はすべて、他人の投稿は public と、投稿者をフォローしていれば followers が見えます（private は見えない）。
+// 投稿を返す・数えるクエリは、閲覧者を受け取ってこの条件で絞り込みます。
+func visiblePostCond(alias, viewer string) string {
+	return `(` + alias + `.visibility = 'public' OR ` + alias + `.user_id = ` + viewer + `
+	         OR (` + alias + `.visibility = 'followers' AND EXISTS (
+	             SELECT 1 FROM follows vf WHERE vf.follower_id = ` + viewer + ` AND vf.followee_id = ` + alias + `.user_id)))`
+}
+
+// publicPostCond は、閲覧者を特定しない集計（タグ一覧など）で数える投稿の条件です。
+func publicPostCond(alias string) string {
+	return alias + `.visibility = 'public'`
+}
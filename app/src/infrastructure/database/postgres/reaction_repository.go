@@ -0,0 +1,178 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+
+	"github.com/lib/pq"
+)
+
+type reactionRepository struct {
+	db *sql.DB
+}
+
+func NewReactionRepository(db *sql.DB) entities.ReactionRepository {
+	return &reactionRepository{db: db}
+}
+
+func (r *reactionRepository) Upsert(ctx context.Context, reaction *entities.Reaction) error {
+	// 種類を付け直した場合は created_at も更新し、一覧の先頭に来るようにする
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO post_reactions (post_id, user_id, kind, created_at) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (post_id, user_id) DO UPDATE SET kind = EXCLUDED.kind, created_at = EXCLUDED.created_at`,
+		reaction.PostID.Value(), reaction.UserID.Value(), reaction.Kind.String(), reaction.CreatedAt)
+	return err
+}
+
+func (r *reactionRepository) Delete(ctx context.Context, postID, userID value_objects.ID) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM post_reactions WHERE post_id = $1 AND user_id = $2`, postID.Value(), userID.Value())
+	return err
+}
+
+func (r *reactionRepository) FindByPost(ctx context.Context, postID value_objects.ID, kind value_objects.ReactionKind, before *entities.ReactionCursor, limit int) ([]*entities.Reaction, error) {
+	query := `
+		SELECT pr.post_id, pr.user_id, u.username, pr.kind, pr.created_at
+		FROM post_reactions pr
+		JOIN users u ON u.id = pr.user_id
+		WHERE pr.post_id = $1
+		  AND ($2 = '' OR pr.kind = $2)
+		  AND ($3::timestamptz IS NULL OR (pr.created_at, pr.user_id) < ($3::timestamptz, $4))
+		ORDER BY pr.created_at DESC, pr.user_id DESC
+		LIMIT $5`
+
+	var beforeAt sql.NullTime
+	var beforeID int
+	if before != nil {
+		beforeAt = sql.NullTime{Time: before.CreatedAt, Valid: true}
+		beforeID = before.UserID.Value()
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, postID.Value(), kind.String(), beforeAt, beforeID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reactions := make([]*entities.Reaction, 0, limit)
+	for rows.Next() {
+		reaction, err := scanReaction(rows)
+		if err != nil {
+			return nil, err
+		}
+		reactions = append(reactions, reaction)
+	}
+	return reactions, rows.Err()
+}
+
+func (r *reactionRepository) FindByPostAndUser(ctx context.Context, postID, userID value_objects.ID) (*entities.Reaction, error) {
+	reaction, err := scanReaction(r.db.QueryRowContext(ctx, `
+		SELECT pr.post_id, pr.user_id, u.username, pr.kind, pr.created_at
+		FROM post_reactions pr
+		JOIN users u ON u.id = pr.user_id
+		WHERE pr.post_id = $1 AND pr.user_id = $2`, postID.Value(), userID.Value()))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return reaction, nil
+}
+
+func (r *reactionRepository) CountByPost(ctx context.Context, postID value_objects.ID) (entities.ReactionCounts, error) {
+	counts, err := countReactions(ctx, r.db, []int64{int64(postID.Value())})
+	if err != nil {
+		return entities.ReactionCounts{}, err
+	}
+	return counts[postID.Value()], nil
+}
+
+func (r *reactionRepository) CountBySpots(ctx context.Context, spotIDs []value_objects.ID, viewerID value_objects.ID) (map[int]int, error) {
+	ids := make([]int64, 0, len(spotIDs))
+	for _, id := range spotIDs {
+		ids = append(ids, int64(id.Value()))
+	}
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT p.spot_id, COUNT(*)
+		FROM post_reactions pr
+		JOIN posts p ON p.id = pr.post_id AND p.superseded_at IS NULL
+		WHERE p.spot_id = ANY($1) AND `+visiblePostCond("p", "$2")+`
+		GROUP BY p.spot_id`, pq.Array(ids), viewerID.Value())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[int]int, len(spotIDs))
+	for rows.Next() {
+		var spotID, n int
+		if err := rows.Scan(&spotID, &n); err != nil {
+			return nil, err
+		}
+		counts[spotID] = n
+	}
+	return counts, rows.Err()
+}
+
+func scanReaction(row rowScanner) (*entities.Reaction, error) {
+	var postID, userID int
+	var username, kind string
+	var createdAt time.Time
+	if err := row.Scan(&postID, &userID, &username, &kind, &createdAt); err != nil {
+		return nil, err
+	}
+	reaction, err := entities.NewReaction(postID, userID, kind, createdAt)
+	if err != nil {
+		return nil, err
+	}
+	reaction.Username, _ = value_objects.NewUsername(username)
+	return reaction, nil
+}
+
+// countReactions は投稿ごとのリアクション件数を種類別にまとめて数えます。
+func countReactions(ctx context.Context, db *sql.DB, postIDs []int64) (map[int]entities.ReactionCounts, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT post_id, kind, COUNT(*) FROM post_reactions
+		WHERE post_id = ANY($1)
+		GROUP BY post_id, kind`, pq.Array(postIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[int]entities.ReactionCounts, len(postIDs))
+	for rows.Next() {
+		var postID, n int
+		var kind string
+		if err := rows.Scan(&postID, &kind, &n); err != nil {
+			return nil, err
+		}
+		c := counts[postID]
+		c.Add(value_objects.ReactionKind(kind), n)
+		counts[postID] = c
+	}
+	return counts, rows.Err()
+}
+
+// attachPostReactions は投稿ごとのリアクション件数をまとめて読み込み、各投稿に設定します。
+func attachPostReactions(ctx context.Context, db *sql.DB, posts []*entities.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(posts))
+	for _, p := range posts {
+		ids = append(ids, int64(p.ID.Value()))
+	}
+	counts, err := countReactions(ctx, db, ids)
+	if err != nil {
+		return err
+	}
+	for _, p := range posts {
+		p.Reactions = counts[p.ID.Value()]
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+
+	"github.com/lib/pq"
+)
+
+type resonanceRepository struct {
+	db *sql.DB
+}
+
+func NewResonanceRepository(db *sql.DB) entities.ResonanceRepository {
+	return &resonanceRepository{db: db}
+}
+
+// resonanceSharedPosts は、ユーザー $1 の現行の投稿と同じスポットにある他のユーザーの現行の投稿を、
+// 「共通になった時刻」（2人のうち後からピンした方の posted_at）付きで列挙します。
+// ブロック・ミュートしている（または自分をブロックしている）ユーザーと、自分に見えない相手の投稿は含めません
+// （自分の投稿は公開範囲に関わらず使う）。
+var resonanceSharedPosts = `
+        SELECT p.user_id, p.spot_id, GREATEST(p.posted_at, mine.posted_at) AS shared_at
+        FROM posts mine
+        JOIN posts p ON p.spot_id = mine.spot_id AND p.user_id <> mine.user_id AND p.superseded_at IS NULL
+        WHERE mine.user_id = $1 AND mine.superseded_at IS NULL
+          AND p.user_id NOT IN ` + hiddenUserIDs("$1") + `
+          AND ` + visiblePostCond("p", "$1")
+
+func (r *resonanceRepository) FindResonantPeers(ctx context.Context, criteria entities.ResonanceCriteria) ([]entities.ResonantPeer, error) {
+	var afterAt sql.NullTime
+	var afterCount, afterID int
+	if criteria.After != nil {
+		afterAt = sql.NullTime{Time: criteria.After.LastSharedAt, Valid: true}
+		afterCount = criteria.After.MatchCount
+		afterID = criteria.After.UserID.Value()
+	}
+
+	// 並び順ごとにカーソル条件を切り替える（いずれも最後は user_id で一意に並べる）
+	cursorCond := `($2::timestamptz IS NULL OR (peers.match_count, peers.last_shared_at, peers.user_id) < ($5::bigint, $2::timestamptz, $3))`
+	orderBy := `peers.match_count DESC, peers.last_shared_at DESC, peers.user_id DESC`
+	args := []any{criteria.UserID.Value(), afterAt, afterID, criteria.Limit, afterCount}
+	if criteria.Sort == entities.ResonanceSortRecent {
+		cursorCond = `($2::timestamptz IS NULL OR (peers.last_shared_at, peers.user_id) < ($2::timestamptz, $3))`
+		orderBy = `peers.last_shared_at DESC, peers.user_id DESC`
+		args = args[:4]
+	}
+
+	query := `
+        WITH shared AS (` + resonanceSharedPosts + `
+        ), peers AS (
+            SELECT user_id, COUNT(DISTINCT spot_id) AS match_count, MAX(shared_at) AS last_shared_at
+            FROM shared
+            GROUP BY user_id
+        )
+        SELECT ` + userColumns + `, peers.match_count, peers.last_shared_at
+        FROM peers
+        JOIN users u ON u.id = peers.user_id ` + userAvatarJoin + `
+        WHERE ` + cursorCond + `
+        ORDER BY ` + orderBy + `
+        LIMIT $4`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	peers := make([]entities.ResonantPeer, 0, criteria.Limit)
+	for rows.Next() {
+		var matchCount int
+		var lastSharedAt time.Time
+		user, err := scanUser(rows, &matchCount, &lastSharedAt)
+		if err != nil {
+			return nil, err
+		}
+		peers = append(peers, entities.ResonantPeer{
+			User:         user,
+			MatchCount:   matchCount,
+			LastSharedAt: lastSharedAt,
+			SharedSpots:  []*entities.Spot{},
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := r.attachSharedSpots(ctx, criteria.UserID, peers, criteria.SharedSpotsPerUser); err != nil {
+		return nil, err
+	}
+	return peers, nil
+}
+
+// attachSharedSpots は各共鳴者との共通スポットを、直近に共通した順に perUser 件まで読み込みます。
+func (r *resonanceRepository) attachSharedSpots(ctx context.Context, userID value_objects.ID, peers []entities.ResonantPeer, perUser int) error {
+	if len(peers) == 0 || perUser <= 0 {
+		return nil
+	}
+	byUser := make(map[int]*entities.ResonantPeer, len(peers))
+	ids := make([]int64, 0, len(peers))
+	for i := range peers {
+		byUser[peers[i].User.ID.Value()] = &peers[i]
+		ids = append(ids, int64(peers[i].User.ID.Value()))
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT `+spotColumns+`, ranked.user_id
+        FROM (
+            SELECT shared.user_id, shared.spot_id,
+                   ROW_NUMBER() OVER (PARTITION BY shared.user_id ORDER BY shared.shared_at DESC, shared.spot_id DESC) AS rn
+            FROM (`+resonanceSharedPosts+`) shared
+            WHERE shared.user_id = ANY($2)
+        ) ranked
+        JOIN spots s ON s.id = ranked.spot_id
+        WHERE ranked.rn <= $3
+        ORDER BY ranked.user_id, ranked.rn`, userID.Value(), pq.Array(ids), perUser)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var peerID int
+		spot, err := scanSpot(rows, &peerID)
+		if err != nil {
+			return err
+		}
+		if peer, ok := byUser[peerID]; ok {
+			peer.SharedSpots = append(peer.SharedSpots, spot)
+		}
+	}
+	return rows.Err()
+}
@@ -0,0 +1,274 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+
+	"github.com/lib/pq"
+)
+
+type spotEditRepository struct {
+	db *sql.DB
+}
+
+func NewSpotEditRepository(db *sql.DB) entities.SpotEditRepository {
+	return &spotEditRepository{db: db}
+}
+
+const proposalColumns = `p.id, p.spot_id, p.proposer_id, p.kind,
+       p.proposed_name, ST_Y(p.proposed_location::geometry), ST_X(p.proposed_location::geometry), p.proposed_category,
+       p.status, p.upvotes, p.downvotes, p.created_at, p.resolved_at`
+
+func scanProposal(row rowScanner) (*entities.SpotEditProposal, error) {
+	var id, spotID, proposerID, upvotes, downvotes int
+	var kind, status string
+	var name, category sql.NullString
+	var lat, lng sql.NullFloat64
+	var createdAt time.Time
+	var resolvedAt sql.NullTime
+
+	err := row.Scan(&id, &spotID, &proposerID, &kind, &name, &lat, &lng, &category,
+		&status, &upvotes, &downvotes, &createdAt, &resolvedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := entities.NewSpotEditProposal(spotID, proposerID, kind, name.String, lat.Float64, lng.Float64, category.String)
+	if err != nil {
+		return nil, err
+	}
+	p.ID, _ = value_objects.NewID(id)
+	p.Status = entities.SpotEditStatus(status)
+	p.Upvotes = upvotes
+	p.Downvotes = downvotes
+	p.CreatedAt = createdAt
+	if resolvedAt.Valid {
+		p.ResolvedAt = &resolvedAt.Time
+	}
+	return p, nil
+}
+
+func (r *spotEditRepository) CreateProposal(ctx context.Context, proposal *entities.SpotEditProposal) (*entities.SpotEditProposal, error) {
+	// 提案の種類に対応する列だけを埋め、それ以外は NULL のままにする。
+	var name, category sql.NullString
+	var lat, lng sql.NullFloat64
+	switch proposal.Kind {
+	case value_objects.SpotEditRename:
+		name = sql.NullString{String: proposal.Name.String(), Valid: true}
+	case value_objects.SpotEditRelocate:
+		lat = sql.NullFloat64{Float64: proposal.Latitude.Value(), Valid: true}
+		lng = sql.NullFloat64{Float64: proposal.Longitude.Value(), Valid: true}
+	case value_objects.SpotEditRecategory:
+		category = sql.NullString{String: proposal.Category.String(), Valid: true}
+	}
+
+	query := `INSERT INTO spot_edit_proposals (spot_id, proposer_id, kind, proposed_name, proposed_location, proposed_category)
+	          VALUES ($1, $2, $3, $4,
+	                  CASE WHEN $5::float8 IS NULL THEN NULL ELSE ST_SetSRID(ST_MakePoint($6, $5), 4326)::geography END,
+	                  $7)
+	          RETURNING id, status, created_at`
+
+	var id int
+	var status string
+	err := r.db.QueryRowContext(ctx, query,
+		proposal.SpotID.Value(), proposal.ProposerID.Value(), proposal.Kind.String(),
+		name, lat, lng, category,
+	).Scan(&id, &status, &proposal.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	proposal.ID, _ = value_objects.NewID(id)
+	proposal.Status = entities.SpotEditStatus(status)
+	return proposal, nil
+}
+
+func (r *spotEditRepository) FindProposalByID(ctx context.Context, id value_objects.ID) (*entities.SpotEditProposal, error) {
+	query := `SELECT ` + proposalColumns + ` FROM spot_edit_proposals p WHERE p.id = $1`
+	p, err := scanProposal(r.db.QueryRowContext(ctx, query, id.Value()))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r *spotEditRepository) FindOpenProposalsBySpot(ctx context.Context, spotID value_objects.ID) ([]*entities.SpotEditProposal, error) {
+	query := `SELECT ` + proposalColumns + `
+	          FROM spot_edit_proposals p
+	          WHERE p.spot_id = $1 AND p.status = 'open'
+	          ORDER BY p.created_at DESC, p.id DESC`
+	rows, err := r.db.QueryContext(ctx, query, spotID.Value())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	proposals := make([]*entities.SpotEditProposal, 0)
+	for rows.Next() {
+		p, err := scanProposal(rows)
+		if err != nil {
+			return nil, err
+		}
+		proposals = append(proposals, p)
+	}
+	return proposals, rows.Err()
+}
+
+func (r *spotEditRepository) Vote(ctx context.Context, proposalID, userID value_objects.ID, value int) (*entities.SpotEditProposal, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// 同時投票で集計がずれないよう、提案の行をロックしてから票を記録・再集計する。
+	var status string
+	err = tx.QueryRowContext(ctx, `SELECT status FROM spot_edit_proposals WHERE id = $1 FOR UPDATE`, proposalID.Value()).Scan(&status)
+	if err != nil {
+		return nil, err
+	}
+	// ロックを待つ間に別の票で適用・却下されていた場合は、票を記録しない
+	if entities.SpotEditStatus(status) != entities.SpotEditOpen {
+		return nil, entities.ErrSpotEditResolved
+	}
+
+	_, err = tx.ExecContext(ctx, `
+        INSERT INTO spot_edit_votes (proposal_id, user_id, value) VALUES ($1, $2, $3)
+        ON CONFLICT (proposal_id, user_id) DO UPDATE SET value = EXCLUDED.value, created_at = CURRENT_TIMESTAMP`,
+		proposalID.Value(), userID.Value(), value)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+        UPDATE spot_edit_proposals p
+        SET upvotes = v.up, downvotes = v.down
+        FROM (
+            SELECT count(*) FILTER (WHERE value = 1) AS up, count(*) FILTER (WHERE value = -1) AS down
+            FROM spot_edit_votes WHERE proposal_id = $1
+        ) v
+        WHERE p.id = $1`, proposalID.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := scanProposal(tx.QueryRowContext(ctx, `SELECT `+proposalColumns+` FROM spot_edit_proposals p WHERE p.id = $1`, proposalID.Value()))
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r *spotEditRepository) Apply(ctx context.Context, proposal *entities.SpotEditProposal, spot *entities.Spot, history *entities.SpotEditHistory) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// 1. 未解決のままであることを確認しつつ適用済みにする（二重適用の防止）
+	res, err := tx.ExecContext(ctx, `
+        UPDATE spot_edit_proposals SET status = 'applied', resolved_at = $2
+        WHERE id = $1 AND status = 'open'`, proposal.ID.Value(), history.AppliedAt)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return sql.ErrNoRows
+	}
+
+	// 2. スポットをロックして読み直し、提案を最新の値に当て直す。
+	// 読み込み後に他の提案が適用されていても、その変更や変更前の値を取りこぼさない。
+	locked, err := scanSpot(tx.QueryRowContext(ctx, `SELECT `+spotColumns+` FROM spots s WHERE s.id = $1 FOR UPDATE`, proposal.SpotID.Value()))
+	if err != nil {
+		return err
+	}
+	fresh, err := proposal.ApplyTo(locked, history.AppliedAt)
+	if err != nil {
+		return err
+	}
+
+	// 3. 提案の種類に対応する列だけを更新する
+	switch proposal.Kind {
+	case value_objects.SpotEditRename:
+		_, err = tx.ExecContext(ctx, `UPDATE spots SET name = $1, name_normalized = $2 WHERE id = $3`,
+			locked.Name.String(), locked.Name.Normalized(), locked.ID.Value())
+	case value_objects.SpotEditRelocate:
+		_, err = tx.ExecContext(ctx, `UPDATE spots SET location = ST_SetSRID(ST_MakePoint($1, $2), 4326), mesh_id = $3 WHERE id = $4`,
+			locked.Longitude.Value(), locked.Latitude.Value(), locked.MeshID.String(), locked.ID.Value())
+	case value_objects.SpotEditRecategory:
+		_, err = tx.ExecContext(ctx, `UPDATE spots SET category = $1 WHERE id = $2`,
+			locked.Category.String(), locked.ID.Value())
+	}
+	if err != nil {
+		// 移転先の座標にすでに別のスポットがある場合は、同一座標の一意制約で弾かれる
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" && pqErr.Constraint == "idx_spots_exact_location_unique" {
+			return entities.ErrSpotLocationTaken
+		}
+		return err
+	}
+
+	// 4. 履歴の記録
+	err = tx.QueryRowContext(ctx, `
+        INSERT INTO spot_edit_history (spot_id, proposal_id, kind, old_value, new_value, applied_at)
+        VALUES ($1, $2, $3, $4, $5, $6)
+        RETURNING id`,
+		fresh.SpotID.Value(), fresh.ProposalID.Value(), fresh.Kind.String(),
+		fresh.OldValue, fresh.NewValue, fresh.AppliedAt,
+	).Scan(&fresh.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	*spot, *history = *locked, *fresh
+	return nil
+}
+
+func (r *spotEditRepository) Reject(ctx context.Context, proposalID value_objects.ID) error {
+	_, err := r.db.ExecContext(ctx, `
+        UPDATE spot_edit_proposals SET status = 'rejected', resolved_at = CURRENT_TIMESTAMP
+        WHERE id = $1 AND status = 'open'`, proposalID.Value())
+	return err
+}
+
+func (r *spotEditRepository) FindHistoryBySpot(ctx context.Context, spotID value_objects.ID) ([]*entities.SpotEditHistory, error) {
+	query := `SELECT id, spot_id, COALESCE(proposal_id, 0), kind, old_value, new_value, applied_at
+	          FROM spot_edit_history
+	          WHERE spot_id = $1
+	          ORDER BY applied_at DESC, id DESC`
+	rows, err := r.db.QueryContext(ctx, query, spotID.Value())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := make([]*entities.SpotEditHistory, 0)
+	for rows.Next() {
+		var id, sid, pid int
+		var kind string
+		h := &entities.SpotEditHistory{}
+		if err := rows.Scan(&id, &sid, &pid, &kind, &h.OldValue, &h.NewValue, &h.AppliedAt); err != nil {
+			return nil, err
+		}
+		h.ID, _ = value_objects.NewID(id)
+		h.SpotID, _ = value_objects.NewID(sid)
+		h.ProposalID, _ = value_objects.NewID(pid)
+		h.Kind = value_objects.SpotEditKind(kind)
+		history = append(history, h)
+	}
+	return history, rows.Err()
+}
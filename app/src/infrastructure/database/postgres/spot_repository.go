@@ -5,6 +5,8 @@ import (
 	"app/src/domain/value_objects"
 	"context"
 	"database/sql"
+	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/lib/pq"
@@ -18,51 +20,136 @@ func NewSpotRepository(db *sql.DB) entities.SpotRepository {
 	return &spotRepository{db: db}
 }
 
+// spotColumns は Spot の復元に必要な列です（spots をエイリアス s で参照する前提）。scanSpot と対で使います。
+const spotColumns = `s.id, s.name, ST_X(s.location::geometry), ST_Y(s.location::geometry), s.registered_user_id,
+       s.category, s.address, s.price_range, s.opening_hours, s.status`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanSpot は spotColumns の並びで1行を読み取り、付帯情報を含む Spot を復元します。
+// extra には spotColumns に続けて SELECT した列の格納先を渡します。
+func scanSpot(row rowScanner, extra ...any) (*entities.Spot, error) {
+	var sid, uid, priceRange int
+	var name, category, address, status string
+	var lng, lat float64
+	var openingHours []byte
+
+	dest := append([]any{&sid, &name, &lng, &lat, &uid, &category, &address, &priceRange, &openingHours, &status}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	spot, err := entities.NewSpot(sid, name, lat, lng, uid)
+	if err != nil {
+		return nil, err
+	}
+	var weekly map[string][]string
+	if err := json.Unmarshal(openingHours, &weekly); err != nil {
+		return nil, err
+	}
+	if err := spot.SetAttributes(category, address, priceRange, weekly); err != nil {
+		return nil, err
+	}
+	spot.Status = entities.SpotStatus(status)
+	return spot, nil
+}
+
 // --- STEP 1: 同一座標に基づく検索 ---
 func (r *spotRepository) FindByLocation(ctx context.Context, lat, lng float64) (*entities.Spot, error) {
+	// 統合（Merge）で削除されたスポットの座標は、リダイレクト経由で統合先へ解決する。
 	query := `
-        SELECT id, name, ST_X(location::geometry), ST_Y(location::geometry), registered_user_id 
-        FROM spots 
-	WHERE ST_X(location::geometry) = $1 AND ST_Y(location::geometry) = $2
+        SELECT ` + spotColumns + `
+        FROM (
+            SELECT 0 AS priority, id AS spot_id
+            FROM spots
+            WHERE ST_X(location::geometry) = $1 AND ST_Y(location::geometry) = $2
+            UNION ALL
+            SELECT 1 AS priority, r.to_spot_id
+            FROM spot_redirects r
+            WHERE ST_X(r.from_location::geometry) = $1 AND ST_Y(r.from_location::geometry) = $2
+        ) candidates
+        JOIN spots s ON s.id = candidates.spot_id
+        ORDER BY candidates.priority
         LIMIT 1`
 
-	var sid, uid int
-	var name string
-	var rLng, rLat float64
-
-	err := r.db.QueryRowContext(ctx, query, lng, lat).Scan(&sid, &name, &rLng, &rLat, &uid)
+	spot, err := scanSpot(r.db.QueryRowContext(ctx, query, lng, lat))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
 		return nil, err
 	}
-	return entities.NewSpot(sid, name, rLat, rLng, uid)
+	return spot, nil
 }
 
 // --- STEP 2: Spot の新規作成 ---
 func (r *spotRepository) Create(spot *entities.Spot) (*entities.Spot, error) {
-	query := `INSERT INTO spots (name, mesh_id, location, registered_user_id) 
-	VALUES ($1, $2, ST_SetSRID(ST_MakePoint($3, $4), 4326), $5)
+	query := `INSERT INTO spots (name, mesh_id, location, registered_user_id, name_normalized,
+	                             category, address, price_range, opening_hours)
+	VALUES ($1, $2, ST_SetSRID(ST_MakePoint($3, $4), 4326), $5, $6, $7, $8, $9, $10)
     RETURNING id`
 
+	openingHours, err := json.Marshal(spot.OpeningHours.Weekly())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
 	var id int
-	err := r.db.QueryRow(query,
+	err = tx.QueryRow(query,
 		spot.Name.String(),
 		spot.MeshID.String(),
 		spot.Longitude.Value(),
 		spot.Latitude.Value(),
 		spot.RegisteredUserID.Value(),
+		spot.Name.Normalized(),
+		spot.Category.String(),
+		spot.Address.String(),
+		spot.PriceRange.Int(),
+		openingHours,
 	).Scan(&id)
 
 	if err != nil {
 		return nil, err
 	}
+	if err := replaceOpeningPeriods(tx, id, spot.OpeningHours); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 	spot.ID, _ = value_objects.NewID(id)
 	return spot, nil
 }
 
+// replaceOpeningPeriods は「今開いている店」の絞り込みに使う営業時間帯テーブルを、スポットの営業時間で置き換えます。
+func replaceOpeningPeriods(tx *sql.Tx, spotID int, hours value_objects.OpeningHours) error {
+	if _, err := tx.Exec(`DELETE FROM spot_opening_periods WHERE spot_id = $1`, spotID); err != nil {
+		return err
+	}
+	for _, p := range hours {
+		_, err := tx.Exec(
+			`INSERT INTO spot_opening_periods (spot_id, weekday, open_minute, close_minute) VALUES ($1, $2, $3, $4)`,
+			spotID, int(p.Weekday), p.OpenMinute, p.CloseMinute,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // --- STEP 3: 共鳴者の特定（店舗IDの完全一致による抽出） ---
+// 共鳴は「現在のベスト」同士の一致で判定するため、上書き済みの投稿は数えない。
+// ブロック・ミュートしている（または自分をブロックしている）ユーザーは共鳴者に含めない。
+// 自分の投稿は公開範囲に関わらず使うが、相手の投稿は自分に見えるもの（private は除く）だけで数える。
 func (r *spotRepository) FindResonantUsersWithMatchCount(ctx context.Context, userID value_objects.ID) ([]entities.ResonantUser, error) {
 	query := `
         SELECT p.user_id, COUNT(DISTINCT s.id) as match_count 
@@ -71,9 +158,12 @@ func (r *spotRepository) FindResonantUsersWithMatchCount(ctx context.Context, us
         WHERE s.id IN (
             SELECT p2.spot_id 
             FROM posts p2 
-            WHERE p2.user_id = $1
+            WHERE p2.user_id = $1 AND p2.superseded_at IS NULL
         )
         AND p.user_id != $1
+        AND p.superseded_at IS NULL
+        AND p.user_id NOT IN ` + hiddenUserIDs("$1") + `
+        AND ` + visiblePostCond("p", "$1") + `
         GROUP BY p.user_id`
 
 	rows, err := r.db.QueryContext(ctx, query, userID.Value())
@@ -95,16 +185,19 @@ func (r *spotRepository) FindResonantUsersWithMatchCount(ctx context.Context, us
 }
 
 // --- STEP 3: 激戦区度の算定（延べ投稿数による熱量の可視化） ---
-func (r *spotRepository) GetDensityScoreByMesh(ctx context.Context, meshID value_objects.MeshID) (value_objects.DensityScore, error) {
+func (r *spotRepository) GetDensityScoreByMesh(ctx context.Context, meshID value_objects.MeshID, viewerID value_objects.ID) (value_objects.DensityScore, error) {
 	// 現在の王座だけでなく、過去の上書きを含めた全投稿数をカウント
+	// （上書き済みの投稿も superseded_at 付きで残っているため、絞り込まずに数える）
+	// ただし閲覧者に見えない投稿（他人の private など）は数えない。
 	query := `
         SELECT count(*) 
         FROM posts p
         JOIN spots s ON p.spot_id = s.id
-        WHERE s.mesh_id = $1`
+        WHERE s.mesh_id = $1
+          AND ` + visiblePostCond("p", "$2")
 	
 	var count int
-	err := r.db.QueryRowContext(ctx, query, meshID.String()).Scan(&count)
+	err := r.db.QueryRowContext(ctx, query, meshID.String(), viewerID.Value()).Scan(&count)
 	if err != nil {
 		score, _ := value_objects.NewDensityScore(0)
 		return score, err
@@ -113,22 +206,65 @@ func (r *spotRepository) GetDensityScoreByMesh(ctx context.Context, meshID value
 	return score, nil
 }
 
+func (r *spotRepository) IsThroneVisible(ctx context.Context, spotID, viewerID value_objects.ID) (bool, error) {
+	query := `
+        SELECT EXISTS (
+            SELECT 1 FROM spots s
+            JOIN posts p ON p.spot_id = s.id AND p.user_id = s.registered_user_id AND p.superseded_at IS NULL
+            WHERE s.id = $1
+              AND ` + visiblePostCond("p", "$2") + `
+        )`
+
+	var visible bool
+	err := r.db.QueryRowContext(ctx, query, spotID.Value(), viewerID.Value()).Scan(&visible)
+	return visible, err
+}
+
 // --- 以下、ユーティリティメソッド群 ---
 
 func (r *spotRepository) FindByID(ctx context.Context, id value_objects.ID) (*entities.Spot, error) {
-	query := `SELECT id, name, ST_X(location::geometry), ST_Y(location::geometry), registered_user_id FROM spots WHERE id = $1`
-	var sid, uid int
-	var name string
-	var lng, lat float64
-	err := r.db.QueryRowContext(ctx, query, id.Value()).Scan(&sid, &name, &lng, &lat, &uid)
+	// 統合済みの旧IDはリダイレクト先のスポットを返す。
+	query := `SELECT ` + spotColumns + `
+	          FROM spots s
+	          WHERE s.id = COALESCE((SELECT to_spot_id FROM spot_redirects WHERE from_spot_id = $1), $1)`
+	spot, err := scanSpot(r.db.QueryRowContext(ctx, query, id.Value()))
 	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
 		return nil, err
 	}
-	return entities.NewSpot(sid, name, lat, lng, uid)
+	return spot, nil
+}
+
+func (r *spotRepository) FindByIDs(ctx context.Context, ids []value_objects.ID) (map[int]*entities.Spot, error) {
+	spots := make(map[int]*entities.Spot, len(ids))
+	if len(ids) == 0 {
+		return spots, nil
+	}
+	idInts := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		idInts = append(idInts, int64(id.Value()))
+	}
+
+	rows, err := r.db.QueryContext(ctx, `SELECT `+spotColumns+` FROM spots s WHERE s.id = ANY($1)`, pq.Array(idInts))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		s, err := scanSpot(rows)
+		if err != nil {
+			return nil, err
+		}
+		spots[s.ID.Value()] = s
+	}
+	return spots, rows.Err()
 }
 
 func (r *spotRepository) FindByMeshID(meshID value_objects.MeshID) ([]*entities.Spot, error) {
-	query := `SELECT id, name, ST_X(location::geometry), ST_Y(location::geometry), registered_user_id FROM spots WHERE mesh_id = $1`
+	query := `SELECT ` + spotColumns + ` FROM spots s WHERE s.mesh_id = $1`
 	rows, err := r.db.Query(query, meshID.String())
 	if err != nil {
 		return nil, err
@@ -137,23 +273,20 @@ func (r *spotRepository) FindByMeshID(meshID value_objects.MeshID) ([]*entities.
 
 	var spots []*entities.Spot
 	for rows.Next() {
-		var sid, uid int
-		var name string
-		var lng, lat float64
-		if err := rows.Scan(&sid, &name, &lng, &lat, &uid); err != nil {
+		s, err := scanSpot(rows)
+		if err != nil {
 			return nil, err
 		}
-		s, _ := entities.NewSpot(sid, name, lat, lng, uid)
 		spots = append(spots, s)
 	}
 	return spots, nil
 }
 
 func (r *spotRepository) FindByRegisteredUser(ctx context.Context, userID value_objects.ID) ([]*entities.Spot, error) {
-	query := `SELECT id, name, ST_X(location::geometry), ST_Y(location::geometry), registered_user_id
-	          FROM spots
-	          WHERE registered_user_id = $1
-	          ORDER BY created_at DESC, id DESC`
+	query := `SELECT ` + spotColumns + `
+	          FROM spots s
+	          WHERE s.registered_user_id = $1
+	          ORDER BY s.created_at DESC, s.id DESC`
 	rows, err := r.db.QueryContext(ctx, query, userID.Value())
 	if err != nil {
 		return nil, err
@@ -162,13 +295,7 @@ func (r *spotRepository) FindByRegisteredUser(ctx context.Context, userID value_
 
 	spots := make([]*entities.Spot, 0)
 	for rows.Next() {
-		var sid, uid int
-		var name string
-		var lng, lat float64
-		if err := rows.Scan(&sid, &name, &lng, &lat, &uid); err != nil {
-			return nil, err
-		}
-		s, err := entities.NewSpot(sid, name, lat, lng, uid)
+		s, err := scanSpot(rows)
 		if err != nil {
 			return nil, err
 		}
@@ -178,16 +305,12 @@ func (r *spotRepository) FindByRegisteredUser(ctx context.Context, userID value_
 }
 
 func (r *spotRepository) FindSpotByMeshAndUser(ctx context.Context, meshID value_objects.MeshID, userID value_objects.ID) (*entities.Spot, error) {
-	query := `SELECT id, name, mesh_id, ST_X(location::geometry), ST_Y(location::geometry), registered_user_id
-	          FROM spots WHERE mesh_id = $1 AND registered_user_id = $2
-	          ORDER BY created_at DESC, id DESC
+	query := `SELECT ` + spotColumns + `
+	          FROM spots s WHERE s.mesh_id = $1 AND s.registered_user_id = $2
+	          ORDER BY s.created_at DESC, s.id DESC
 	          LIMIT 1`
 
-	var sid, uid int
-	var name, mID string
-	var lng, lat float64
-
-	err := r.db.QueryRowContext(ctx, query, meshID.String(), userID.Value()).Scan(&sid, &name, &mID, &lng, &lat, &uid)
+	spot, err := scanSpot(r.db.QueryRowContext(ctx, query, meshID.String(), userID.Value()))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -195,21 +318,29 @@ func (r *spotRepository) FindSpotByMeshAndUser(ctx context.Context, meshID value
 		return nil, err
 	}
 
-	return entities.NewSpot(sid, name, lat, lng, uid)
+	return spot, nil
 }
 
-func (r *spotRepository) FindSpotsByMeshAndUsers(ctx context.Context, meshIDs []value_objects.MeshID, userIDs []value_objects.ID) ([]*entities.Spot, error) {
-	query := `SELECT id, name, mesh_id, ST_X(location::geometry), ST_Y(location::geometry), registered_user_id
+// FindSpotsByMeshAndUsers は、登録者の現行の投稿が閲覧者に見えるスポットだけを候補にします。
+func (r *spotRepository) FindSpotsByMeshAndUsers(ctx context.Context, meshIDs []value_objects.MeshID, userIDs []value_objects.ID, viewerID value_objects.ID) ([]*entities.Spot, error) {
+	query := `SELECT ` + spotColumns + `
 	          FROM (
-	              SELECT id, name, mesh_id, location, registered_user_id,
+	              SELECT id,
 	                     ROW_NUMBER() OVER (
 	                         PARTITION BY mesh_id, registered_user_id
 	                         ORDER BY created_at DESC, id DESC
 	                     ) AS rn
 	              FROM spots
-	              WHERE mesh_id = ANY($1) AND registered_user_id = ANY($2)
+	              -- 閉店済みのスポットは推薦候補にしない（ユーザーの1つ前の登録が代表になる）
+	              WHERE mesh_id = ANY($1) AND registered_user_id = ANY($2) AND status <> 'closed'
+	                AND EXISTS (
+	                    SELECT 1 FROM posts p
+	                    WHERE p.spot_id = spots.id AND p.user_id = spots.registered_user_id AND p.superseded_at IS NULL
+	                      AND ` + visiblePostCond("p", "$3") + `
+	                )
 	          ) latest
-	          WHERE rn = 1`
+	          JOIN spots s ON s.id = latest.id
+	          WHERE latest.rn = 1`
 
 	mStrs := make([]string, len(meshIDs))
 	for i, m := range meshIDs {
@@ -220,7 +351,7 @@ func (r *spotRepository) FindSpotsByMeshAndUsers(ctx context.Context, meshIDs []
 		uInts[i] = u.Value()
 	}
 
-	rows, err := r.db.QueryContext(ctx, query, pq.Array(mStrs), pq.Array(uInts))
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(mStrs), pq.Array(uInts), viewerID.Value())
 	if err != nil {
 		return nil, err
 	}
@@ -228,24 +359,22 @@ func (r *spotRepository) FindSpotsByMeshAndUsers(ctx context.Context, meshIDs []
 
 	var spots []*entities.Spot
 	for rows.Next() {
-		var sid, uid int
-		var name, mID string
-		var lng, lat float64
-		if err := rows.Scan(&sid, &name, &mID, &lng, &lat, &uid); err != nil {
+		s, err := scanSpot(rows)
+		if err != nil {
 			return nil, err
 		}
-		s, _ := entities.NewSpot(sid, name, lat, lng, uid)
 		spots = append(spots, s)
 	}
 	return spots, nil
 }
 
-func (r *spotRepository) FindPostsBySpot(ctx context.Context, spotID value_objects.ID) ([]*entities.Post, error) {
-	query := `SELECT p.id, p.user_id, p.spot_id, p.username, p.image_url, p.caption, p.posted_at 
-              FROM posts p 
-              WHERE p.spot_id = $1`
+func (r *spotRepository) FindPostsBySpot(ctx context.Context, spotID, viewerID value_objects.ID) ([]*entities.Post, error) {
+	query := `SELECT p.id, p.user_id, p.spot_id, p.username, p.image_url, p.caption, p.posted_at, ` + postImageVariantColumns + `, ` + postRatingColumns + `, p.visibility
+              FROM posts p ` + postImageJoin + `
+              WHERE p.spot_id = $1 AND p.superseded_at IS NULL
+                AND ` + visiblePostCond("p", "$2")
 
-	rows, err := r.db.QueryContext(ctx, query, spotID.Value())
+	rows, err := r.db.QueryContext(ctx, query, spotID.Value(), viewerID.Value())
 	if err != nil {
 		return nil, err
 	}
@@ -254,35 +383,384 @@ func (r *spotRepository) FindPostsBySpot(ctx context.Context, spotID value_objec
 	var posts []*entities.Post
 	for rows.Next() {
 		var pid, uid, sid int
-		var uname, capStr string
+		var uname, capStr, thumbURL, mediumURL string
+		var ratings [4]int
+		var visibility string
 		var img sql.NullString
 		var createdAt time.Time
-		if err := rows.Scan(&pid, &uid, &sid, &uname, &img, &capStr, &createdAt); err != nil {
+		if err := rows.Scan(&pid, &uid, &sid, &uname, &img, &capStr, &createdAt, &thumbURL, &mediumURL, &ratings[0], &ratings[1], &ratings[2], &ratings[3], &visibility); err != nil {
 			return nil, err
 		}
 		p, _ := entities.NewPost(pid, uid, sid, uname, img.String, capStr, createdAt)
+		p.ImageVariants = variantsOf(thumbURL, mediumURL)
+		p.Rating = ratingOf(ratings)
+		p.Visibility = visibilityOf(visibility)
+		posts = append(posts, p)
+	}
+	if err := attachPostDetails(ctx, r.db, posts); err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+func (r *spotRepository) FindPostsBySpotPage(ctx context.Context, spotID, viewerID value_objects.ID, before *entities.PostCursor, limit int) ([]*entities.Post, error) {
+	// (posted_at, id) の行比較でカーソル以降を絞り込み、新しい順に limit 件を返す。
+	query := `SELECT p.id, p.user_id, p.spot_id, p.username, p.image_url, p.caption, p.posted_at, ` + postImageVariantColumns + `, ` + postRatingColumns + `, p.visibility
+              FROM posts p ` + postImageJoin + `
+              WHERE p.spot_id = $1 AND p.superseded_at IS NULL
+                AND ($2::timestamptz IS NULL OR (p.posted_at, p.id) < ($2::timestamptz, $3))
+                AND ` + visiblePostCond("p", "$5") + `
+              ORDER BY p.posted_at DESC, p.id DESC
+              LIMIT $4`
+
+	var beforeAt sql.NullTime
+	var beforeID int
+	if before != nil {
+		beforeAt = sql.NullTime{Time: before.PostedAt, Valid: true}
+		beforeID = before.ID.Value()
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, spotID.Value(), beforeAt, beforeID, limit, viewerID.Value())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	posts := make([]*entities.Post, 0, limit)
+	for rows.Next() {
+		var pid, uid, sid int
+		var uname, capStr, thumbURL, mediumURL string
+		var ratings [4]int
+		var visibility string
+		var img sql.NullString
+		var postedAt time.Time
+		if err := rows.Scan(&pid, &uid, &sid, &uname, &img, &capStr, &postedAt, &thumbURL, &mediumURL, &ratings[0], &ratings[1], &ratings[2], &ratings[3], &visibility); err != nil {
+			return nil, err
+		}
+		p, err := entities.NewPost(pid, uid, sid, uname, img.String, capStr, postedAt)
+		if err != nil {
+			return nil, err
+		}
+		p.ImageVariants = variantsOf(thumbURL, mediumURL)
+		p.Rating = ratingOf(ratings)
+		p.Visibility = visibilityOf(visibility)
 		posts = append(posts, p)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := attachPostDetails(ctx, r.db, posts); err != nil {
+		return nil, err
+	}
 	return posts, nil
 }
 
+// --- 店名検索 ---
+// 正規化済み店名（name_normalized）に対し、トライグラム類似度と部分一致の両方で候補を拾う。
+// 部分一致は短いキーワード（「うどん」など2〜3文字）でもトライグラムより確実に拾えるため、一致度に加点する。
+// 検索地点がある場合は Distill と同じ対数距離減衰 1 / (1 + ln(1 + km)) を掛けて近い店舗を優先する。
+// カテゴリ・営業中の絞り込み（criteria.Filter）は SQL 側で適用する。
+func (r *spotRepository) SearchByName(ctx context.Context, criteria entities.SpotSearchCriteria) ([]entities.SpotSearchHit, error) {
+	query := `
+        SELECT ` + spotColumns + `, hits.distance_m,
+               hits.text_score / (1 + COALESCE(ln(1 + hits.distance_m / 1000.0), 0)) AS score
+        FROM (
+            SELECT s.id,
+                   GREATEST(similarity(s.name_normalized, $1), word_similarity($1, s.name_normalized))
+                     + CASE WHEN s.name_normalized LIKE $2 ESCAPE '\' THEN 1.0 ELSE 0.0 END AS text_score,
+                   CASE WHEN $3::float8 IS NULL OR $4::float8 IS NULL THEN NULL
+                        ELSE ST_Distance(s.location, ST_SetSRID(ST_MakePoint($4, $3), 4326)::geography)
+                   END AS distance_m
+            FROM spots s
+            WHERE (s.name_normalized % $1 OR s.name_normalized LIKE $2 ESCAPE '\')
+              AND s.status <> 'closed'
+              AND ($6 = '' OR s.category = $6)
+              AND ($7::int IS NULL OR EXISTS (
+                  -- 当日の営業時間帯、または前日から日付をまたいで続いている深夜営業
+                  SELECT 1 FROM spot_opening_periods op
+                  WHERE op.spot_id = s.id
+                    AND ((op.weekday = $7 AND op.open_minute <= $8 AND $8 < op.close_minute)
+                      OR (op.weekday = ($7 + 6) % 7 AND $8 + 1440 < op.close_minute))
+              ))
+        ) hits
+        JOIN spots s ON s.id = hits.id
+        ORDER BY score DESC, s.id DESC
+        LIMIT $5`
+
+	var lat, lng sql.NullFloat64
+	if criteria.Latitude != nil && criteria.Longitude != nil {
+		lat = sql.NullFloat64{Float64: criteria.Latitude.Value(), Valid: true}
+		lng = sql.NullFloat64{Float64: criteria.Longitude.Value(), Valid: true}
+	}
+	pattern := "%" + likeEscaper.Replace(criteria.Query.String()) + "%"
+
+	var weekday sql.NullInt64
+	var minute int
+	if criteria.Filter.OpenAt != nil {
+		wd, m := value_objects.OpeningHoursMinuteOf(*criteria.Filter.OpenAt)
+		weekday = sql.NullInt64{Int64: int64(wd), Valid: true}
+		minute = m
+	}
+
+	rows, err := r.db.QueryContext(ctx, query,
+		criteria.Query.String(), pattern, lat, lng, criteria.Limit,
+		criteria.Filter.Category.String(), weekday, minute,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hits := make([]entities.SpotSearchHit, 0, criteria.Limit)
+	for rows.Next() {
+		var score float64
+		var distance sql.NullFloat64
+		spot, err := scanSpot(rows, &distance, &score)
+		if err != nil {
+			return nil, err
+		}
+		hit := entities.SpotSearchHit{Spot: spot, Score: score}
+		if distance.Valid {
+			km := distance.Float64 / 1000.0
+			hit.DistanceKm = &km
+		}
+		hits = append(hits, hit)
+	}
+	return hits, rows.Err()
+}
+
+func (r *spotRepository) ReportClosure(ctx context.Context, spotID, userID value_objects.ID, threshold int) (int, entities.SpotStatus, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, "", err
+	}
+	defer tx.Rollback()
+
+	// 管理者による復元（UpdateStatus）と交錯しないよう、スポットの行をロックしてから報告・判定する。
+	var locked int
+	err = tx.QueryRowContext(ctx, `SELECT id FROM spots WHERE id = $1 FOR UPDATE`, spotID.Value()).Scan(&locked)
+	if err != nil {
+		return 0, "", err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+        INSERT INTO spot_closure_reports (spot_id, user_id) VALUES ($1, $2)
+        ON CONFLICT (spot_id, user_id) DO NOTHING`, spotID.Value(), userID.Value())
+	if err != nil {
+		return 0, "", err
+	}
+
+	var reports int
+	err = tx.QueryRowContext(ctx, `SELECT count(*) FROM spot_closure_reports WHERE spot_id = $1`, spotID.Value()).Scan(&reports)
+	if err != nil {
+		return 0, "", err
+	}
+
+	// 報告者数が閾値に達したら閉店扱い（論理削除）、それ以外は営業中のスポットを reported にする。
+	var status string
+	err = tx.QueryRowContext(ctx, `
+        UPDATE spots
+        SET status = CASE WHEN $2 THEN 'closed' WHEN status = 'active' THEN 'reported' ELSE status END,
+            closed_at = CASE WHEN $2 THEN COALESCE(closed_at, CURRENT_TIMESTAMP) ELSE closed_at END
+        WHERE id = $1
+        RETURNING status`, spotID.Value(), reports >= threshold).Scan(&status)
+	if err != nil {
+		return 0, "", err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, "", err
+	}
+	return reports, entities.SpotStatus(status), nil
+}
+
+func (r *spotRepository) UpdateStatus(ctx context.Context, spotID value_objects.ID, status entities.SpotStatus) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+        UPDATE spots
+        SET status = $2::varchar,
+            closed_at = CASE WHEN $2::varchar = 'closed' THEN COALESCE(closed_at, CURRENT_TIMESTAMP) END
+        WHERE id = $1`, spotID.Value(), string(status))
+	if err != nil {
+		return err
+	}
+	// 復元時は過去の閉店報告を取り消し、再び報告を受け付けられるようにする。
+	if status == entities.SpotActive {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM spot_closure_reports WHERE spot_id = $1`, spotID.Value()); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// likeEscaper は LIKE パターン中のメタ文字をエスケープします（ESCAPE '\' と対で使う）。
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 func (r *spotRepository) Update(spot *entities.Spot) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := updateSpot(tx, spot); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// updateSpot はスポット本体と営業時間帯をトランザクション内で更新します（修正提案の適用からも使う）。
+func updateSpot(tx *sql.Tx, spot *entities.Spot) error {
 	query := `UPDATE spots
 	          SET name = $1,
 	              mesh_id = $2,
 	              location = ST_SetSRID(ST_MakePoint($3, $4), 4326),
-	              registered_user_id = $5
-	          WHERE id = $6`
-	_, err := r.db.Exec(
+	              registered_user_id = $5,
+	              name_normalized = $6,
+	              category = $7,
+	              address = $8,
+	              price_range = $9,
+	              opening_hours = $10
+	          WHERE id = $11`
+
+	openingHours, err := json.Marshal(spot.OpeningHours.Weekly())
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
 		query,
 		spot.Name.String(),
 		spot.MeshID.String(),
 		spot.Longitude.Value(),
 		spot.Latitude.Value(),
 		spot.RegisteredUserID.Value(),
+		spot.Name.Normalized(),
+		spot.Category.String(),
+		spot.Address.String(),
+		spot.PriceRange.Int(),
+		openingHours,
 		spot.ID.Value(),
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	return replaceOpeningPeriods(tx, spot.ID.Value(), spot.OpeningHours)
+}
+
+// --- 重複スポットの統合 ---
+// 投稿・王座・リダイレクトを1トランザクションで付け替える。
+// 共鳴数（店舗IDのDISTINCT数）と激戦区度（メッシュ内の延べ投稿数）は posts から都度算出されるため、
+// 投稿の spot_id を付け替えた時点で統合先の値として再計算される。
+func (r *spotRepository) Merge(ctx context.Context, sourceID, targetID, mergedBy value_objects.ID) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// 1. 統合元・統合先の両方をロックし、存在を確認する。
+	var locked int
+	err = tx.QueryRowContext(ctx,
+		`SELECT count(*) FROM (SELECT id FROM spots WHERE id IN ($1, $2) FOR UPDATE) target`,
+		sourceID.Value(), targetID.Value(),
+	).Scan(&locked)
+	if err != nil {
+		return 0, err
+	}
+	if locked != 2 {
+		return 0, sql.ErrNoRows
+	}
+
+	// 2. 統合元の投稿をすべて統合先へ付け替える。
+	res, err := tx.ExecContext(ctx, `UPDATE posts SET spot_id = $1 WHERE spot_id = $2`, targetID.Value(), sourceID.Value())
+	if err != nil {
+		return 0, err
+	}
+	moved, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	// 両方のスポットに現行の投稿を持っていたユーザーは、最新の1件以外を上書き済みにする。
+	_, err = tx.ExecContext(ctx, `
+        UPDATE posts p SET superseded_at = CURRENT_TIMESTAMP
+        WHERE p.spot_id = $1 AND p.superseded_at IS NULL
+          AND EXISTS (
+              SELECT 1 FROM posts q
+              WHERE q.spot_id = $1 AND q.user_id = p.user_id AND q.superseded_at IS NULL
+                AND (q.posted_at, q.id) > (p.posted_at, p.id)
+          )`, targetID.Value())
+	if err != nil {
+		return 0, err
+	}
+
+	// 3. 王座（最新の投稿者）を統合後の投稿群から再判定する。
+	_, err = tx.ExecContext(ctx, `
+        UPDATE spots
+        SET registered_user_id = latest.user_id
+        FROM (
+            SELECT user_id FROM posts
+            WHERE spot_id = $1 AND superseded_at IS NULL
+            ORDER BY posted_at DESC, id DESC
+            LIMIT 1
+        ) latest
+        WHERE spots.id = $1`, targetID.Value())
+	if err != nil {
+		return 0, err
+	}
+
+	// 4. 統合元を指していた既存のリダイレクトを統合先へ張り替え、統合元自身のリダイレクトを記録する。
+	_, err = tx.ExecContext(ctx, `UPDATE spot_redirects SET to_spot_id = $1 WHERE to_spot_id = $2`, targetID.Value(), sourceID.Value())
+	if err != nil {
+		return 0, err
+	}
+	_, err = tx.ExecContext(ctx, `
+        INSERT INTO spot_redirects (from_spot_id, to_spot_id, from_location, merged_by)
+        SELECT id, $2, location, $3 FROM spots WHERE id = $1`,
+		sourceID.Value(), targetID.Value(), mergedBy.Value())
+	if err != nil {
+		return 0, err
+	}
+
+	// 修正履歴・修正提案（票は提案に紐づくためそのまま）は統合先のものとして引き継ぐ。
+	if _, err := tx.ExecContext(ctx, `UPDATE spot_edit_history SET spot_id = $1 WHERE spot_id = $2`, targetID.Value(), sourceID.Value()); err != nil {
+		return 0, err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE spot_edit_proposals SET spot_id = $1 WHERE spot_id = $2`, targetID.Value(), sourceID.Value()); err != nil {
+		return 0, err
+	}
+
+	// 閉店報告も統合先へ引き継ぐ（両方に報告していたユーザーは1件として数える）。
+	// 営業中の統合先に報告が移った場合は、ReportClosure と同じく reported にする。
+	_, err = tx.ExecContext(ctx, `
+        INSERT INTO spot_closure_reports (spot_id, user_id, created_at)
+        SELECT $1, user_id, created_at FROM spot_closure_reports WHERE spot_id = $2
+        ON CONFLICT (spot_id, user_id) DO NOTHING`, targetID.Value(), sourceID.Value())
+	if err != nil {
+		return 0, err
+	}
+	_, err = tx.ExecContext(ctx, `
+        UPDATE spots SET status = 'reported'
+        WHERE id = $1 AND status = 'active'
+          AND EXISTS (SELECT 1 FROM spot_closure_reports WHERE spot_id = $1)`, targetID.Value())
+	if err != nil {
+		return 0, err
+	}
+
+	// 5. 統合元を削除する（投稿・提案・閉店報告は付け替え済みのため、CASCADEで消えるのは統合元の重複した閉店報告のみ）。
+	if _, err := tx.ExecContext(ctx, `DELETE FROM spots WHERE id = $1`, sourceID.Value()); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return int(moved), nil
 }
 
 func (r *spotRepository) Delete(id value_objects.ID) error {
@@ -3,8 +3,11 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"time"
 	"app/src/domain/entities"
 	"app/src/domain/value_objects"
+
+	"github.com/lib/pq"
 )
 
 type UserRepository struct {
@@ -17,6 +20,53 @@ func NewUserRepository(db *sql.DB) *UserRepository {
 	return &UserRepository{db: db}
 }
 
+const userColumns = `u.id, u.username, u.email, u.hashed_password, u.is_admin,
+	u.display_name, u.bio, u.home_area, COALESCE(u.avatar_image_id, 0), COALESCE(av.url, ''), COALESCE(av.thumb_url, ''), u.created_at, u.deletion_requested_at`
+
+// userAvatarJoin はユーザー（別名 u）のアイコン画像の URL を引き当てます。
+const userAvatarJoin = `LEFT JOIN images av ON av.id = u.avatar_image_id`
+
+// scanUser は userColumns の並びで1行を読み取ります。
+// extra には userColumns に続けて SELECT した列の格納先を渡します。
+func scanUser(row rowScanner, extra ...any) (*entities.User, error) {
+	var uid, avatarID int
+	var username, email, hashedPassword, displayName, bio, homeArea, avatarURL, avatarThumb string
+	var isAdmin bool
+	var createdAt time.Time
+	var deletionRequestedAt sql.NullTime
+	dest := append([]any{&uid, &username, &email, &hashedPassword, &isAdmin,
+		&displayName, &bio, &homeArea, &avatarID, &avatarURL, &avatarThumb, &createdAt, &deletionRequestedAt}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	userID, _ := value_objects.NewID(uid)
+	emailVO, _ := value_objects.NewEmail(email)
+	uname, _ := value_objects.NewUsername(username)
+	hashVO, _ := value_objects.NewHashedPassword(hashedPassword)
+	displayNameVO, _ := value_objects.NewDisplayName(displayName)
+	bioVO, _ := value_objects.NewBio(bio)
+	homeAreaVO, _ := value_objects.NewHomeArea(homeArea)
+	avatarImageID, _ := value_objects.NewID(avatarID)
+	url, _ := value_objects.NewImageURL(avatarURL)
+	thumb, _ := value_objects.NewImageURL(avatarThumb)
+	user := &entities.User{
+		ID:             userID,
+		Username:       uname,
+		Email:          emailVO,
+		HashedPassword: hashVO,
+		IsAdmin:        isAdmin,
+		DisplayName:    displayNameVO,
+		Bio:            bioVO,
+		HomeArea:       homeAreaVO,
+		Avatar:         entities.UserAvatar{ImageID: avatarImageID, URL: url, Thumb: thumb},
+		CreatedAt:      createdAt,
+	}
+	if deletionRequestedAt.Valid {
+		user.DeletionRequestedAt = &deletionRequestedAt.Time
+	}
+	return user, nil
+}
+
 func (r *UserRepository) Create(user *entities.User) (*entities.User, error) {
 	query := `INSERT INTO users (username, email, hashed_password) VALUES ($1, $2, $3) RETURNING id`
 	var id int
@@ -35,79 +85,65 @@ func (r *UserRepository) Create(user *entities.User) (*entities.User, error) {
 }
 
 func (r *UserRepository) FindByID(id value_objects.ID) (*entities.User, error) {
-	query := `SELECT id, username, email, hashed_password FROM users WHERE id = $1`
-	row := r.db.QueryRow(query, id.Value())
-	var uid int
-	var username, email, hashedPassword string
-	if err := row.Scan(&uid, &username, &email, &hashedPassword); err != nil {
-		return nil, err
-	}
-	userID, _ := value_objects.NewID(uid)
-	emailVO, _ := value_objects.NewEmail(email)
-	uname, _ := value_objects.NewUsername(username)
-	hashVO, _ := value_objects.NewHashedPassword(hashedPassword)
-	return &entities.User{
-		ID:             userID,
-		Username:       uname,
-		Email:          emailVO,
-		HashedPassword: hashVO,
-	}, nil
+	query := `SELECT ` + userColumns + ` FROM users u ` + userAvatarJoin + ` WHERE u.id = $1`
+	return scanUser(r.db.QueryRow(query, id.Value()))
 }
 
 func (r *UserRepository) FindByEmail(email value_objects.Email) (*entities.User, error) {
-	query := `SELECT id, username, email, hashed_password FROM users WHERE email = $1`
+	query := `SELECT ` + userColumns + ` FROM users u ` + userAvatarJoin + ` WHERE u.email = $1`
 	// email.String() は OK
-	row := r.db.QueryRow(query, email.String())
-	var uid int
-	var username, emailStr, hashedPassword string
-	if err := row.Scan(&uid, &username, &emailStr, &hashedPassword); err != nil {
-		return nil, err
-	}
-	userID, _ := value_objects.NewID(uid)
-	emailVO, _ := value_objects.NewEmail(emailStr)
-	uname, _ := value_objects.NewUsername(username)
-	hashVO, _ := value_objects.NewHashedPassword(hashedPassword)
-	return &entities.User{
-		ID:             userID,
-		Username:       uname,
-		Email:          emailVO,
-		HashedPassword: hashVO,
-	}, nil
+	return scanUser(r.db.QueryRow(query, email.String()))
 }
 
 func (r *UserRepository) FindByUsername(ctx context.Context, username string) (*entities.User, error) {
-	query := `SELECT id, username, email, hashed_password FROM users WHERE username = $1`
-	row := r.db.QueryRowContext(ctx, query, username)
-	
-	var uid int
-	var unameStr, emailStr, hashedPassword string
-	if err := row.Scan(&uid, &unameStr, &emailStr, &hashedPassword); err != nil {
-		return nil, err
+	query := `SELECT ` + userColumns + ` FROM users u ` + userAvatarJoin + ` WHERE u.username = $1`
+	user, err := scanUser(r.db.QueryRowContext(ctx, query, username))
+	if err == sql.ErrNoRows {
+		return nil, nil
 	}
-
-	userID, _ := value_objects.NewID(uid)
-	emailVO, _ := value_objects.NewEmail(emailStr)
-	uname, _ := value_objects.NewUsername(unameStr)
-	hashVO, _ := value_objects.NewHashedPassword(hashedPassword)
-	
-	return &entities.User{
-		ID:             userID,
-		Username:       uname,
-		Email:          emailVO,
-		HashedPassword: hashVO,
-	}, nil
+	return user, err
 }
 
 func (r *UserRepository) Update(user *entities.User) error {
-	query := `UPDATE users SET username = $1, email = $2, hashed_password = $3 WHERE id = $4`
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var avatarID sql.NullInt64
+	if user.Avatar.IsSet() {
+		avatarID = sql.NullInt64{Int64: int64(user.Avatar.ImageID.Value()), Valid: true}
+	}
+	query := `UPDATE users
+	          SET username = $1, email = $2, hashed_password = $3,
+	              display_name = $4, bio = $5, home_area = $6, avatar_image_id = $7, updated_at = CURRENT_TIMESTAMP
+	          WHERE id = $8`
 	// 修正: 全て String() / Value() を介して渡す
-	_, err := r.db.Exec(query, 
-		user.Username.String(), 
-		user.Email.String(), 
-		user.HashedPassword.String(), 
+	if _, err := tx.Exec(query,
+		user.Username.String(),
+		user.Email.String(),
+		user.HashedPassword.String(),
+		user.DisplayName.String(),
+		user.Bio.String(),
+		user.HomeArea.String(),
+		avatarID,
 		user.ID.Value(),
-	)
-	return err
+	); err != nil {
+		// ユースケース側の重複チェックとの間に同じ名前を取られた場合は一意制約で弾かれる
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" && pqErr.Constraint == "users_username_key" {
+			return entities.ErrUsernameTaken
+		}
+		return err
+	}
+
+	// 投稿に複製しているユーザー名を揃える（上書き済みの過去の投稿も含む）
+	if _, err := tx.Exec(`UPDATE posts SET username = $1 WHERE user_id = $2 AND username <> $1`,
+		user.Username.String(), user.ID.Value(),
+	); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 func (r *UserRepository) Delete(id value_objects.ID) error {
@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+)
+
+// hiddenUserIDs は、param のユーザーから見て共鳴・推薦・フィードに含めないユーザーのIDを列挙する副問い合わせです。
+// 自分がブロック・ミュートした相手と、自分をブロックしている相手が対象です（ミュートは相手側には効かない）。
+func hiddenUserIDs(param string) string {
+	return `(SELECT target_id FROM user_restrictions WHERE user_id = ` + param + `
+	         UNION SELECT user_id FROM user_restrictions WHERE target_id = ` + param + ` AND kind = 'block')`
+}
+
+type userRestrictionRepository struct {
+	db *sql.DB
+}
+
+func NewUserRestrictionRepository(db *sql.DB) entities.UserRestrictionRepository {
+	return &userRestrictionRepository{db: db}
+}
+
+func (r *userRestrictionRepository) Restrict(ctx context.Context, kind entities.UserRestrictionKind, userID, targetID value_objects.ID) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO user_restrictions (user_id, target_id, kind) VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, kind, target_id) DO NOTHING`,
+		userID.Value(), targetID.Value(), string(kind))
+	if err != nil {
+		return err
+	}
+
+	// ブロックした相手とは、どちら向きのフォローも残さない
+	if kind == entities.UserRestrictionBlock {
+		_, err = tx.ExecContext(ctx, `
+			DELETE FROM follows
+			WHERE (follower_id = $1 AND followee_id = $2) OR (follower_id = $2 AND followee_id = $1)`,
+			userID.Value(), targetID.Value())
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *userRestrictionRepository) Unrestrict(ctx context.Context, kind entities.UserRestrictionKind, userID, targetID value_objects.ID) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM user_restrictions WHERE user_id = $1 AND kind = $2 AND target_id = $3`,
+		userID.Value(), string(kind), targetID.Value())
+	return err
+}
+
+func (r *userRestrictionRepository) FindByUser(ctx context.Context, kind entities.UserRestrictionKind, userID value_objects.ID) ([]entities.RestrictedUser, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+userColumns+`, ur.created_at
+		FROM user_restrictions ur
+		JOIN users u ON u.id = ur.target_id `+userAvatarJoin+`
+		WHERE ur.user_id = $1 AND ur.kind = $2
+		ORDER BY ur.created_at DESC, ur.target_id DESC`,
+		userID.Value(), string(kind))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]entities.RestrictedUser, 0)
+	for rows.Next() {
+		var restrictedAt time.Time
+		user, err := scanUser(rows, &restrictedAt)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, entities.RestrictedUser{User: user, RestrictedAt: restrictedAt})
+	}
+	return users, rows.Err()
+}
+
+func (r *userRestrictionRepository) IsBlockedBetween(ctx context.Context, a, b value_objects.ID) (bool, error) {
+	var blocked bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM user_restrictions
+			WHERE kind = 'block'
+			  AND ((user_id = $1 AND target_id = $2) OR (user_id = $2 AND target_id = $1))
+		)`, a.Value(), b.Value()).Scan(&blocked)
+	return blocked, err
+}
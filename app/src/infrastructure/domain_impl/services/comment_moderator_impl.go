@@ -0,0 +1,35 @@
+package domain_impl_services
+
+import (
+	"context"
+	"strings"
+
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+// KeywordCommentModeratorImpl は、禁止語を含むコメントを自動で非表示にする CommentModerator です。
+// 照合は店名検索と同じ正規化（全角/半角・カタカナ/ひらがな・大文字/小文字の揺れを吸収）をかけた部分一致で行います。
+type KeywordCommentModeratorImpl struct {
+	blockedWords []string
+}
+
+func NewKeywordCommentModeratorImpl(blockedWords []string) services.CommentModerator {
+	words := make([]string, 0, len(blockedWords))
+	for _, w := range blockedWords {
+		if w = strings.TrimSpace(value_objects.NormalizeSearchText(w)); w != "" {
+			words = append(words, w)
+		}
+	}
+	return &KeywordCommentModeratorImpl{blockedWords: words}
+}
+
+func (m *KeywordCommentModeratorImpl) Review(ctx context.Context, body value_objects.CommentBody) (services.CommentModeration, error) {
+	normalized := value_objects.NormalizeSearchText(body.String())
+	for _, w := range m.blockedWords {
+		if strings.Contains(normalized, w) {
+			return services.CommentModeration{Hide: true, Reason: "blocked word"}, nil
+		}
+	}
+	return services.CommentModeration{}, nil
+}
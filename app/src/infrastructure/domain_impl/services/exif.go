@@ -0,0 +1,261 @@
+package domain_impl_services
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"strings"
+	"time"
+
+	"app/src/domain/services"
+)
+
+// exifData は JPEG の EXIF から読み取った値です。
+type exifData struct {
+	// Orientation は画素の向き（1〜8、0 は情報なし）です。
+	Orientation int
+
+	// Latitude / Longitude は GPS 情報から求めた撮影地点（十進度）で、情報がなければ nil です。
+	Latitude  *float64
+	Longitude *float64
+	// CapturedAt は撮影日時です。OffsetTimeOriginal がなければ UTC とみなします。
+	CapturedAt *time.Time
+}
+
+const (
+	exifTagOrientation        = 0x0112
+	exifTagDateTime           = 0x0132
+	exifTagExifIFD            = 0x8769
+	exifTagGPSIFD             = 0x8825
+	exifTagDateTimeOriginal   = 0x9003
+	exifTagOffsetTimeOriginal = 0x9011
+
+	gpsTagLatitudeRef  = 0x0001
+	gpsTagLatitude     = 0x0002
+	gpsTagLongitudeRef = 0x0003
+	gpsTagLongitude    = 0x0004
+)
+
+func (e exifData) metadata() services.ImageMetadata {
+	return services.ImageMetadata{Latitude: e.Latitude, Longitude: e.Longitude, CapturedAt: e.CapturedAt}
+}
+
+// readExif は JPEG の APP1（Exif）セグメントから必要なタグだけを読み取ります。
+// 壊れた・存在しない EXIF は空の値として扱います（画像自体の検証はデコーダーが行う）。
+func readExif(data []byte) exifData {
+	return readTIFFExif(findExifSegment(data))
+}
+
+// readTIFFExif は EXIF の TIFF 部分（JPEG の APP1 や WebP の EXIF チャンクの中身）を読み取ります。
+func readTIFFExif(tiff []byte) exifData {
+	r, ok := newTIFFReader(tiff)
+	if !ok {
+		return exifData{}
+	}
+
+	var out exifData
+	var exifIFD, gpsIFD int
+	var dateTime, dateTimeOriginal, offsetTime string
+	r.walkIFD(r.u32(4), func(tag, typ uint16, count uint32, valueOffset int) {
+		switch tag {
+		case exifTagOrientation:
+			if typ == 3 && count >= 1 {
+				out.Orientation = int(r.u16(valueOffset))
+			}
+		case exifTagDateTime:
+			dateTime = r.ascii(valueOffset, count)
+		case exifTagExifIFD:
+			exifIFD = r.u32(valueOffset)
+		case exifTagGPSIFD:
+			gpsIFD = r.u32(valueOffset)
+		}
+	})
+	r.walkIFD(exifIFD, func(tag, typ uint16, count uint32, valueOffset int) {
+		switch tag {
+		case exifTagDateTimeOriginal:
+			dateTimeOriginal = r.ascii(valueOffset, count)
+		case exifTagOffsetTimeOriginal:
+			offsetTime = r.ascii(valueOffset, count)
+		}
+	})
+
+	var latRef, lngRef string
+	var lat, lng []float64
+	r.walkIFD(gpsIFD, func(tag, typ uint16, count uint32, valueOffset int) {
+		switch tag {
+		case gpsTagLatitudeRef:
+			latRef = r.ascii(valueOffset, count)
+		case gpsTagLongitudeRef:
+			lngRef = r.ascii(valueOffset, count)
+		case gpsTagLatitude:
+			lat = r.rationals(typ, valueOffset, count)
+		case gpsTagLongitude:
+			lng = r.rationals(typ, valueOffset, count)
+		}
+	})
+	latitude, latOK := dmsToDegrees(lat, latRef, "S", 90)
+	longitude, lngOK := dmsToDegrees(lng, lngRef, "W", 180)
+	// 0,0 は測位できていない端末が書き込むことがあるため、位置情報なしとして扱う。
+	if latOK && lngOK && (latitude != 0 || longitude != 0) {
+		out.Latitude, out.Longitude = &latitude, &longitude
+	}
+
+	if dateTimeOriginal == "" {
+		dateTimeOriginal, offsetTime = dateTime, ""
+	}
+	out.CapturedAt = parseExifTime(dateTimeOriginal, offsetTime)
+	return out
+}
+
+// dmsToDegrees は度・分・秒の3値を十進度に変換します（南緯・西経は負）。
+func dmsToDegrees(dms []float64, ref, negativeRef string, limit float64) (float64, bool) {
+	if len(dms) != 3 || ref == "" {
+		return 0, false
+	}
+	deg := dms[0] + dms[1]/60 + dms[2]/3600
+	if math.IsNaN(deg) || deg > limit {
+		return 0, false
+	}
+	if strings.EqualFold(ref, negativeRef) {
+		deg = -deg
+	}
+	return deg, true
+}
+
+// parseExifTime は "2006:01:02 15:04:05" 形式の日時を解釈します。
+func parseExifTime(value, offset string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	if offset != "" {
+		if t, err := time.Parse("2006:01:02 15:04:05-07:00", value+offset); err == nil {
+			return &t
+		}
+	}
+	t, err := time.Parse("2006:01:02 15:04:05", value)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// findExifSegment は JPEG のマーカーをたどり、Exif 識別子に続く TIFF 部分を返します。
+func findExifSegment(data []byte) []byte {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil
+	}
+	for pos := 2; pos+4 <= len(data); {
+		if data[pos] != 0xFF {
+			return nil
+		}
+		marker := data[pos+1]
+		if marker == 0xDA || marker == 0xD9 { // SOS 以降は画像データ
+			return nil
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2 : pos+4]))
+		end := pos + 2 + length
+		if length < 2 || end > len(data) {
+			return nil
+		}
+		segment := data[pos+4 : end]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return segment[6:]
+		}
+		pos = end
+	}
+	return nil
+}
+
+// tiffReader は EXIF の TIFF 構造（バイトオーダー・IFD）を読むための最小限のリーダーです。
+type tiffReader struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+func newTIFFReader(data []byte) (*tiffReader, bool) {
+	if len(data) < 8 {
+		return nil, false
+	}
+	switch string(data[:2]) {
+	case "II":
+		return &tiffReader{data: data, order: binary.LittleEndian}, true
+	case "MM":
+		return &tiffReader{data: data, order: binary.BigEndian}, true
+	}
+	return nil, false
+}
+
+func (r *tiffReader) u16(off int) uint16 {
+	if off < 0 || off+2 > len(r.data) {
+		return 0
+	}
+	return r.order.Uint16(r.data[off:])
+}
+
+func (r *tiffReader) u32(off int) int {
+	if off < 0 || off+4 > len(r.data) {
+		return 0
+	}
+	return int(r.order.Uint32(r.data[off:]))
+}
+
+// ascii は NUL 終端の ASCII 値を読み取ります。
+func (r *tiffReader) ascii(off int, count uint32) string {
+	if off < 0 || int64(off)+int64(count) > int64(len(r.data)) {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimRight(string(r.data[off:off+int(count)]), "\x00"))
+}
+
+// rationals は RATIONAL（符号なし分数）の配列を読み取ります。
+func (r *tiffReader) rationals(typ uint16, off int, count uint32) []float64 {
+	if typ != 5 || count > 16 {
+		return nil
+	}
+	out := make([]float64, 0, count)
+	for i := 0; i < int(count); i++ {
+		num, den := r.u32(off+i*8), r.u32(off+i*8+4)
+		if off+i*8+8 > len(r.data) || den == 0 {
+			return nil
+		}
+		out = append(out, float64(num)/float64(den))
+	}
+	return out
+}
+
+// walkIFD は IFD のエントリーを順に fn へ渡します。
+// valueOffset は値そのもの（4バイト以内の場合）または値への参照先を指す、TIFF 先頭からの位置です。
+func (r *tiffReader) walkIFD(offset int, fn func(tag, typ uint16, count uint32, valueOffset int)) {
+	if offset <= 0 || offset+2 > len(r.data) {
+		return
+	}
+	n := int(r.u16(offset))
+	for i := 0; i < n; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(r.data) {
+			return
+		}
+		tag, typ := r.u16(entry), r.u16(entry+2)
+		count := uint32(r.u32(entry + 4))
+		valueOffset := entry + 8
+		if exifTypeSize(typ)*int(count) > 4 {
+			valueOffset = r.u32(entry + 8)
+		}
+		fn(tag, typ, count, valueOffset)
+	}
+}
+
+// exifTypeSize は TIFF のデータ型1要素あたりのバイト数です。
+func exifTypeSize(typ uint16) int {
+	switch typ {
+	case 1, 2, 6, 7: // BYTE, ASCII, SBYTE, UNDEFINED
+		return 1
+	case 3, 8: // SHORT, SSHORT
+		return 2
+	case 4, 9, 11: // LONG, SLONG, FLOAT
+		return 4
+	case 5, 10, 12: // RATIONAL, SRATIONAL, DOUBLE
+		return 8
+	}
+	return 0
+}
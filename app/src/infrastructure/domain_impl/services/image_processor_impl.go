@@ -0,0 +1,302 @@
+package domain_impl_services
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+
+	"app/src/domain/services"
+)
+
+const (
+	// thumbSize は一覧用サムネイル（正方形）の一辺です。
+	thumbSize = 320
+	// mediumSize は推薦カード用画像の長辺です。
+	mediumSize = 1080
+
+	originalJPEGQuality = 90
+	mediumJPEGQuality   = 85
+	thumbJPEGQuality    = 80
+)
+
+// ImageProcessorImpl は標準ライブラリのみで画像を変換する ImageProcessor です。
+// JPEG / PNG はデコードして再エンコードすることでメタデータを取り除き（JPEG は EXIF の回転情報を画素に反映してから）、
+// 派生サイズを JPEG で生成します。WebP はデコーダーがないため、メタデータのチャンクを取り除くだけで派生サイズは生成しません。
+type ImageProcessorImpl struct{}
+
+func NewImageProcessorImpl() services.ImageProcessor {
+	return &ImageProcessorImpl{}
+}
+
+func (p *ImageProcessorImpl) Process(data []byte, contentType string) (*services.ProcessedImage, error) {
+	switch contentType {
+	case "image/webp":
+		stripped, width, height, exif, err := stripWebPMetadata(data)
+		if err != nil {
+			return nil, err
+		}
+		return &services.ProcessedImage{
+			Original: services.ImageRendition{Data: stripped, ContentType: contentType, Width: width, Height: height},
+			Metadata: exif.metadata(),
+		}, nil
+	case "image/jpeg", "image/png":
+	default:
+		return nil, fmt.Errorf("unsupported image type: %s", contentType)
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	img := toRGBA(decoded)
+	var exif exifData
+	if contentType == "image/jpeg" {
+		exif = readExif(data)
+		img = applyOrientation(img, exif.Orientation)
+	}
+
+	// 1. 元画像（メタデータを含まない形で再エンコード）
+	var original []byte
+	if contentType == "image/png" {
+		original, err = encodePNG(img)
+	} else {
+		original, err = encodeJPEG(img, originalJPEGQuality)
+	}
+	if err != nil {
+		return nil, err
+	}
+	bounds := img.Bounds()
+	result := &services.ProcessedImage{
+		Original: services.ImageRendition{Data: original, ContentType: contentType, Width: bounds.Dx(), Height: bounds.Dy()},
+		Metadata: exif.metadata(),
+	}
+
+	// 2. 推薦カード用（長辺 mediumSize に収める。小さい画像は拡大しない）
+	medium := fitWithin(img, mediumSize)
+	if result.Medium, err = jpegRendition(medium, mediumJPEGQuality); err != nil {
+		return nil, err
+	}
+
+	// 3. 一覧用サムネイル（中央を正方形に切り抜いてから縮小する）
+	thumb := squareCrop(img)
+	thumb = fitWithin(thumb, thumbSize)
+	if result.Thumb, err = jpegRendition(thumb, thumbJPEGQuality); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func jpegRendition(img *image.RGBA, quality int) (*services.ImageRendition, error) {
+	data, err := encodeJPEG(img, quality)
+	if err != nil {
+		return nil, err
+	}
+	b := img.Bounds()
+	return &services.ImageRendition{Data: data, ContentType: "image/jpeg", Width: b.Dx(), Height: b.Dy()}, nil
+}
+
+// encodeJPEG は透過部分を白で塗りつぶしてから JPEG にエンコードします。
+func encodeJPEG(img *image.RGBA, quality int) ([]byte, error) {
+	flat := image.NewRGBA(img.Bounds())
+	draw.Draw(flat, flat.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(flat, flat.Bounds(), img, img.Bounds().Min, draw.Over)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, flat, &jpeg.Options{Quality: quality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func encodePNG(img *image.RGBA) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func toRGBA(src image.Image) *image.RGBA {
+	b := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Bounds(), src, b.Min, draw.Src)
+	return dst
+}
+
+// applyOrientation は EXIF の Orientation（1〜8）に従って画素を回転・反転します。
+func applyOrientation(src *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = w-1-x, y
+			case 3:
+				sx, sy = w-1-x, h-1-y
+			case 4:
+				sx, sy = x, h-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, h-1-x
+			case 7:
+				sx, sy = w-1-y, h-1-x
+			case 8:
+				sx, sy = w-1-y, x
+			}
+			si := src.PixOffset(sx, sy)
+			di := dst.PixOffset(x, y)
+			copy(dst.Pix[di:di+4], src.Pix[si:si+4])
+		}
+	}
+	return dst
+}
+
+// squareCrop は画像の中央を短辺に合わせた正方形で切り抜きます。
+func squareCrop(src *image.RGBA) *image.RGBA {
+	b := src.Bounds()
+	side := b.Dx()
+	if b.Dy() < side {
+		side = b.Dy()
+	}
+	x0 := b.Min.X + (b.Dx()-side)/2
+	y0 := b.Min.Y + (b.Dy()-side)/2
+	return toRGBA(src.SubImage(image.Rect(x0, y0, x0+side, y0+side)))
+}
+
+// fitWithin は長辺が maxSide を超える場合に、縦横比を保って縮小します。
+func fitWithin(src *image.RGBA, maxSide int) *image.RGBA {
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	if w <= maxSide && h <= maxSide {
+		return src
+	}
+	dw, dh := maxSide, h*maxSide/w
+	if h > w {
+		dw, dh = w*maxSide/h, maxSide
+	}
+	if dw < 1 {
+		dw = 1
+	}
+	if dh < 1 {
+		dh = 1
+	}
+	return downscale(src, dw, dh)
+}
+
+// downscale は面積平均（ボックスフィルター）で縮小します。
+func downscale(src *image.RGBA, dw, dh int) *image.RGBA {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		sy0, sy1 := y*h/dh, (y+1)*h/dh
+		if sy1 == sy0 {
+			sy1 = sy0 + 1
+		}
+		for x := 0; x < dw; x++ {
+			sx0, sx1 := x*w/dw, (x+1)*w/dw
+			if sx1 == sx0 {
+				sx1 = sx0 + 1
+			}
+			var r, g, bl, a, n uint32
+			for sy := sy0; sy < sy1; sy++ {
+				i := src.PixOffset(b.Min.X+sx0, b.Min.Y+sy)
+				for sx := sx0; sx < sx1; sx++ {
+					r += uint32(src.Pix[i])
+					g += uint32(src.Pix[i+1])
+					bl += uint32(src.Pix[i+2])
+					a += uint32(src.Pix[i+3])
+					n++
+					i += 4
+				}
+			}
+			di := dst.PixOffset(x, y)
+			dst.Pix[di] = uint8(r / n)
+			dst.Pix[di+1] = uint8(g / n)
+			dst.Pix[di+2] = uint8(bl / n)
+			dst.Pix[di+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
+
+// stripWebPMetadata は WebP（RIFF コンテナ）から EXIF / XMP チャンクを取り除き、画像の幅と高さと、取り除いた EXIF の内容を返します。
+func stripWebPMetadata(data []byte) ([]byte, int, int, exifData, error) {
+	var exif exifData
+	if len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return nil, 0, 0, exif, errors.New("invalid webp container")
+	}
+
+	out := make([]byte, 12, len(data))
+	copy(out, data[:12])
+	width, height := 0, 0
+	for pos := 12; pos < len(data); {
+		if pos+8 > len(data) {
+			return nil, 0, 0, exif, errors.New("truncated webp chunk header")
+		}
+		fourCC := string(data[pos : pos+4])
+		size := int(le32(data[pos+4 : pos+8]))
+		end := pos + 8 + size + size%2 // チャンクは偶数バイトに揃えられている
+		if size < 0 || end > len(data) {
+			return nil, 0, 0, exif, errors.New("truncated webp chunk")
+		}
+		body := data[pos+8 : pos+8+size]
+
+		switch fourCC {
+		case "EXIF":
+			// 書き出すツールによっては JPEG と同じ "Exif\0\0" が前置される
+			exif = readTIFFExif(bytes.TrimPrefix(body, []byte("Exif\x00\x00")))
+			pos = end
+			continue
+		case "XMP ":
+			pos = end
+			continue
+		case "VP8X":
+			if size >= 10 {
+				width, height = int(le24(body[4:7]))+1, int(le24(body[7:10]))+1
+			}
+		case "VP8L":
+			if width == 0 && size >= 5 && body[0] == 0x2f {
+				bits := le32(body[1:5])
+				width, height = int(bits&0x3fff)+1, int(bits>>14&0x3fff)+1
+			}
+		case "VP8 ":
+			if width == 0 && size >= 10 {
+				width, height = int(le16(body[6:8])&0x3fff), int(le16(body[8:10])&0x3fff)
+			}
+		}
+
+		chunk := append([]byte(nil), data[pos:end]...)
+		if fourCC == "VP8X" && size >= 1 {
+			chunk[8] &^= 0x08 | 0x04 // EXIF / XMP の有無フラグを下ろす
+		}
+		out = append(out, chunk...)
+		pos = end
+	}
+
+	riffSize := uint32(len(out) - 8)
+	out[4], out[5], out[6], out[7] = byte(riffSize), byte(riffSize>>8), byte(riffSize>>16), byte(riffSize>>24)
+	return out, width, height, exif, nil
+}
+
+func le16(b []byte) uint32 { return uint32(b[0]) | uint32(b[1])<<8 }
+func le24(b []byte) uint32 { return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 }
+func le32(b []byte) uint32 {
+	return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24
+}
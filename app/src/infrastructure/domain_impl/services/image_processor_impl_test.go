@@ -0,0 +1,201 @@
+package domain_impl_services
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// withOrientation は JPEG の SOI 直後に Orientation だけを持つ EXIF（APP1）を差し込みます。
+func withOrientation(jpg []byte, orientation uint16) []byte {
+	var tiff bytes.Buffer
+	tiff.WriteString("MM\x00\x2a")
+	binary.Write(&tiff, binary.BigEndian, uint32(8))
+	binary.Write(&tiff, binary.BigEndian, uint16(1))
+	binary.Write(&tiff, binary.BigEndian, []uint16{exifTagOrientation, 3})
+	binary.Write(&tiff, binary.BigEndian, uint32(1))
+	binary.Write(&tiff, binary.BigEndian, []uint16{orientation, 0})
+	binary.Write(&tiff, binary.BigEndian, uint32(0))
+
+	return withExif(jpg, tiff.Bytes())
+}
+
+// withExif は JPEG の SOI 直後に、TIFF 部分が tiff の EXIF（APP1）を差し込みます。
+func withExif(jpg, tiff []byte) []byte {
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	var out bytes.Buffer
+	out.Write(jpg[:2])
+	out.Write([]byte{0xFF, 0xE1})
+	binary.Write(&out, binary.BigEndian, uint16(len(segment)+2))
+	out.Write(segment)
+	out.Write(jpg[2:])
+	return out.Bytes()
+}
+
+// geotaggedTIFF は恵比寿（35.6467, 139.7101）で 2026-10-18 19:30 JST に撮影された EXIF の TIFF 部分を組み立てます。
+func geotaggedTIFF() []byte {
+	var b bytes.Buffer
+	w := func(v ...any) {
+		for _, x := range v {
+			binary.Write(&b, binary.BigEndian, x)
+		}
+	}
+	entry := func(tag, typ uint16, count, value uint32) { w(tag, typ, count, value) }
+
+	w([]byte("MM\x00\x2a"), uint32(8))
+	// IFD0（8〜38）: Exif IFD と GPS IFD への参照
+	w(uint16(2))
+	entry(exifTagExifIFD, 4, 1, 38)
+	entry(exifTagGPSIFD, 4, 1, 68)
+	w(uint32(0))
+	// Exif IFD（38〜68）: 撮影日時とタイムゾーン
+	w(uint16(2))
+	entry(exifTagDateTimeOriginal, 2, 20, 122)
+	entry(exifTagOffsetTimeOriginal, 2, 7, 142)
+	w(uint32(0))
+	// GPS IFD（68〜122）: 北緯 35°38'48.12" / 東経 139°42'36.36"
+	w(uint16(4))
+	entry(gpsTagLatitudeRef, 2, 2, uint32('N')<<24)
+	entry(gpsTagLatitude, 5, 3, 150)
+	entry(gpsTagLongitudeRef, 2, 2, uint32('E')<<24)
+	entry(gpsTagLongitude, 5, 3, 174)
+	w(uint32(0))
+	// 値の領域（122〜198）
+	w([]byte("2026:10:18 19:30:00\x00"), []byte("+09:00\x00\x00"))
+	w(uint32(35), uint32(1), uint32(38), uint32(1), uint32(4812), uint32(100))
+	w(uint32(139), uint32(1), uint32(42), uint32(1), uint32(3636), uint32(100))
+	return b.Bytes()
+}
+
+func TestReadExif_GPSAndCaptureTime(t *testing.T) {
+	var buf bytes.Buffer
+	jpeg.Encode(&buf, halfAndHalf(8, 8), nil)
+
+	out, err := NewImageProcessorImpl().Process(withExif(buf.Bytes(), geotaggedTIFF()), "image/jpeg")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	meta := out.Metadata
+	if assert.NotNil(t, meta.Latitude) && assert.NotNil(t, meta.Longitude) {
+		assert.InDelta(t, 35.6467, *meta.Latitude, 1e-6)
+		assert.InDelta(t, 139.7101, *meta.Longitude, 1e-6)
+	}
+	if assert.NotNil(t, meta.CapturedAt) {
+		assert.True(t, meta.CapturedAt.Equal(time.Date(2026, 10, 18, 10, 30, 0, 0, time.UTC)))
+	}
+	// 撮影地点は保存する画像からは取り除かれている
+	assert.Nil(t, findExifSegment(out.Original.Data))
+}
+
+func TestReadExif_MissingOrBroken(t *testing.T) {
+	var buf bytes.Buffer
+	jpeg.Encode(&buf, halfAndHalf(8, 8), nil)
+	assert.Equal(t, exifData{}, readExif(buf.Bytes()))
+
+	broken := geotaggedTIFF()[:100] // GPS IFD の途中で切れている
+	out := readExif(withExif(buf.Bytes(), broken))
+	assert.Nil(t, out.Latitude)
+	assert.Nil(t, out.CapturedAt)
+}
+
+func halfAndHalf(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= w/2 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestImageProcessor_JPEGAppliesOrientationAndStripsExif(t *testing.T) {
+	var buf bytes.Buffer
+	jpeg.Encode(&buf, halfAndHalf(40, 20), &jpeg.Options{Quality: 95})
+	src := withOrientation(buf.Bytes(), 6)
+	assert.Equal(t, 6, readExif(src).Orientation)
+
+	out, err := NewImageProcessorImpl().Process(src, "image/jpeg")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// 90度回転して縦長になり、左半分（赤）が上に来る
+	assert.Equal(t, 20, out.Original.Width)
+	assert.Equal(t, 40, out.Original.Height)
+	assert.Nil(t, findExifSegment(out.Original.Data))
+	decoded, err := jpeg.Decode(bytes.NewReader(out.Original.Data))
+	if !assert.NoError(t, err) {
+		return
+	}
+	r, _, b, _ := decoded.At(10, 5).RGBA()
+	assert.Greater(t, r, b)
+
+	// 小さい画像は拡大せず、サムネイルは正方形に切り抜く
+	assert.Equal(t, 20, out.Medium.Width)
+	assert.Equal(t, 40, out.Medium.Height)
+	assert.Equal(t, 20, out.Thumb.Width)
+	assert.Equal(t, 20, out.Thumb.Height)
+}
+
+func TestImageProcessor_PNGVariantSizes(t *testing.T) {
+	var buf bytes.Buffer
+	png.Encode(&buf, halfAndHalf(2000, 1000))
+
+	out, err := NewImageProcessorImpl().Process(buf.Bytes(), "image/png")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "image/png", out.Original.ContentType)
+	assert.Equal(t, 2000, out.Original.Width)
+	assert.Equal(t, "image/jpeg", out.Medium.ContentType)
+	assert.Equal(t, [2]int{1080, 540}, [2]int{out.Medium.Width, out.Medium.Height})
+	assert.Equal(t, [2]int{thumbSize, thumbSize}, [2]int{out.Thumb.Width, out.Thumb.Height})
+}
+
+func TestImageProcessor_WebPStripsMetadataChunks(t *testing.T) {
+	chunk := func(fourCC string, body []byte) []byte {
+		out := append([]byte(fourCC), 0, 0, 0, 0)
+		binary.LittleEndian.PutUint32(out[4:], uint32(len(body)))
+		out = append(out, body...)
+		if len(body)%2 == 1 {
+			out = append(out, 0)
+		}
+		return out
+	}
+	vp8x := []byte{0x08 | 0x04, 0, 0, 0, 99, 0, 0, 49, 0, 0} // EXIF / XMP あり、100x50
+	var body []byte
+	body = append(body, "WEBP"...)
+	body = append(body, chunk("VP8X", vp8x)...)
+	body = append(body, chunk("VP8L", []byte{0x2f, 0, 0, 0, 0})...)
+	body = append(body, chunk("EXIF", []byte("GPS secret"))...)
+	body = append(body, chunk("XMP ", []byte("<x/>"))...)
+	src := append([]byte("RIFF\x00\x00\x00\x00"), body...)
+	binary.LittleEndian.PutUint32(src[4:], uint32(len(body)))
+
+	out, err := NewImageProcessorImpl().Process(src, "image/webp")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.NotContains(t, string(out.Original.Data), "GPS secret")
+	assert.NotContains(t, string(out.Original.Data), "XMP ")
+	assert.Equal(t, uint32(len(out.Original.Data)-8), binary.LittleEndian.Uint32(out.Original.Data[4:]))
+	assert.Equal(t, byte(0), out.Original.Data[20]&(0x08|0x04))
+	assert.Equal(t, 100, out.Original.Width)
+	assert.Equal(t, 50, out.Original.Height)
+	assert.Nil(t, out.Medium)
+	assert.Nil(t, out.Thumb)
+}
@@ -10,12 +10,25 @@ import (
 )
 
 type RecommendationServiceImpl struct {
-	spotRepo entities.SpotRepository
+	spotRepo     entities.SpotRepository
+	reactionRepo entities.ReactionRepository
+	followRepo   entities.FollowRepository
+	// reactionWeight は投稿へのリアクション件数をスコアに反映する強さです（0 なら反映しない）。
+	reactionWeight float64
+	// ratingWeight は共鳴者が自分の選んだ店に付けた評価を反映する強さです（0〜1、0 なら反映しない）。
+	ratingWeight float64
+	// followWeight はフォロー中のユーザーの信頼度（MatchCount）に上乗せする値です（0 ならフォローを反映しない）。
+	followWeight float64
 }
 
-func NewRecommendationServiceImpl(spotRepo entities.SpotRepository) services.RecommendationService {
+func NewRecommendationServiceImpl(spotRepo entities.SpotRepository, reactionRepo entities.ReactionRepository, followRepo entities.FollowRepository, reactionWeight, ratingWeight, followWeight float64) services.RecommendationService {
 	return &RecommendationServiceImpl{
-		spotRepo: spotRepo,
+		spotRepo:       spotRepo,
+		reactionRepo:   reactionRepo,
+		followRepo:     followRepo,
+		reactionWeight: reactionWeight,
+		ratingWeight:   math.Min(math.Max(ratingWeight, 0), 1),
+		followWeight:   math.Max(followWeight, 0),
 	}
 }
 
@@ -25,6 +38,7 @@ func (s *RecommendationServiceImpl) Distill(
 	user *entities.User,
 	lat value_objects.Latitude,
 	lng value_objects.Longitude,
+	filter entities.SpotFilter,
 ) (
 	*entities.Spot,
 	value_objects.TotalScore,
@@ -63,7 +77,20 @@ func (s *RecommendationServiceImpl) Distill(
 	if err != nil {
 		return emptySpot, emptyScore, emptyRes, emptyDen, emptyReason, emptyPosts, err
 	}
-	if len(resonantUsers) == 0 {
+
+	// 明示的にフォローしているユーザーは、まだ同じ店を選んでいなくても共鳴者として扱い、
+	// followWeight の分だけ信頼度を上乗せする（共通スポットがあれば MatchCount に加算）。
+	followed := make(map[int]bool)
+	if s.followWeight > 0 && s.followRepo != nil {
+		followeeIDs, err := s.followRepo.FindFolloweeIDs(ctx, user.ID)
+		if err != nil {
+			return emptySpot, emptyScore, emptyRes, emptyDen, emptyReason, emptyPosts, err
+		}
+		for _, id := range followeeIDs {
+			followed[id.Value()] = true
+		}
+	}
+	if len(resonantUsers) == 0 && len(followed) == 0 {
 		return emptySpot, emptyScore, emptyRes, emptyDen, emptyReason, emptyPosts, fmt.Errorf("no resonant users found")
 	}
 
@@ -72,31 +99,62 @@ func (s *RecommendationServiceImpl) Distill(
 	// データを残しているユーザーを絞り込み、彼らがそこで選んでいる「正解」をすべてかき集める。
 	targetMeshes := append([]value_objects.MeshID{currentMesh}, currentMesh.GetSurroundingMeshIDs()...)
 
-	// 共鳴者リストをマップ化し、MatchCount（信頼度）を即座に参照できるようにする。
+	// 共鳴者リストをマップ化し、MatchCount と信頼度（フォローの上乗せ込み）を即座に参照できるようにする。
 	resonanceMap := make(map[int]int)
-	resonantIDs := make([]value_objects.ID, 0, len(resonantUsers))
+	trustMap := make(map[int]float64)
+	resonantIDs := make([]value_objects.ID, 0, len(resonantUsers)+len(followed))
 	for _, ru := range resonantUsers {
 		resonantIDs = append(resonantIDs, ru.ID)
 		resonanceMap[ru.ID.Value()] = ru.MatchCount
+		trustMap[ru.ID.Value()] = float64(ru.MatchCount)
+	}
+	for id := range followed {
+		if _, ok := trustMap[id]; !ok {
+			idVO, _ := value_objects.NewID(id)
+			resonantIDs = append(resonantIDs, idVO)
+		}
+		trustMap[id] += s.followWeight
 	}
 
 	// 9つのメッシュ内で共鳴者たちが選んだ店舗候補をDBから取得。
-	allCandidateSpots, err := s.spotRepo.FindSpotsByMeshAndUsers(ctx, targetMeshes, resonantIDs)
+	allCandidateSpots, err := s.spotRepo.FindSpotsByMeshAndUsers(ctx, targetMeshes, resonantIDs, user.ID)
 	if err != nil {
 		return emptySpot, emptyScore, emptyRes, emptyDen, emptyReason, emptyPosts, err
 	}
 
-	// メッシュごとに「最も共鳴度（MatchCount）が高い共鳴者」の選択を採用する。
+	// 候補ごとの評価の集計（推薦結果に添えるほか、ratingWeight があれば共鳴者自身の評価で選択を重み付けする）
+	candidateIDs := make([]value_objects.ID, 0, len(allCandidateSpots))
+	for _, spot := range allCandidateSpots {
+		candidateIDs = append(candidateIDs, spot.ID)
+	}
+	ratings, err := s.spotRepo.FindRatingsBySpots(ctx, candidateIDs, user.ID)
+	if err != nil {
+		return emptySpot, emptyScore, emptyRes, emptyDen, emptyReason, emptyPosts, err
+	}
+
+	// メッシュごとに「最も信頼度（MatchCount + フォローの上乗せ）が高い共鳴者」の選択を採用する。
 	// つまり、1つのメッシュ内で共鳴者同士の意見が割れた場合、より自分と感性が近い人の意見を蒸留する。
+	// 共鳴者が評価を付けている場合は、その強さ（★3 を基準）で信頼度を増減させる。
 	meshRepresentatives := make(map[string]*entities.Spot)
 	meshTopResonance := make(map[string]int)
+	meshTopTrust := make(map[string]float64)
+	meshTopWeight := make(map[string]float64)
 
 	for _, spot := range allCandidateSpots {
+		// カテゴリ・営業中の条件に合わない店は、代表選定の前に候補から外す。
+		if !filter.Matches(spot) {
+			continue
+		}
 		mID := spot.MeshID.String()
 		rCount := resonanceMap[spot.RegisteredUserID.Value()]
+		trust := trustMap[spot.RegisteredUserID.Value()]
+		spot.Rating = ratings[spot.ID.Value()]
+		weight := trust * s.ratingFactor(spot.Rating.RegisteredUserRating)
 
-		if rCount > meshTopResonance[mID] {
+		if weight > meshTopWeight[mID] {
+			meshTopWeight[mID] = weight
 			meshTopResonance[mID] = rCount
+			meshTopTrust[mID] = trust
 			meshRepresentatives[mID] = spot
 		}
 	}
@@ -108,12 +166,26 @@ func (s *RecommendationServiceImpl) Distill(
 	var maxScore float64
 	var bestResonance int
 	var bestDensity int
+	var bestFollowed bool
+
+	// 補助指標：各代表店の現行の投稿に付いたリアクション件数（reactionWeight が 0 なら取得しない）
+	reactionCounts := map[int]int{}
+	if s.reactionWeight > 0 && s.reactionRepo != nil && len(meshRepresentatives) > 0 {
+		spotIDs := make([]value_objects.ID, 0, len(meshRepresentatives))
+		for _, spot := range meshRepresentatives {
+			spotIDs = append(spotIDs, spot.ID)
+		}
+		if reactionCounts, err = s.reactionRepo.CountBySpots(ctx, spotIDs, user.ID); err != nil {
+			return emptySpot, emptyScore, emptyRes, emptyDen, emptyReason, emptyPosts, err
+		}
+	}
 
 	for mID, spot := range meshRepresentatives {
-		// resCount: その店を支持する共鳴者の信頼度
+		// resCount: その店を支持する共鳴者の共通スポット数 / trust: フォローの上乗せを含めた信頼度
 		resCount := meshTopResonance[mID]
+		trust := meshTopTrust[mID]
 		// density: その地点で発生した全ユーザーの「葛藤（登録・上書き）」の総数
-		density, _ := s.spotRepo.GetDensityScoreByMesh(ctx, spot.MeshID)
+		density, _ := s.spotRepo.GetDensityScoreByMesh(ctx, spot.MeshID, user.ID)
 
 		// 距離計算：現在地からの物理的な距離(km)
 		dist := s.calculateDistance(lat.Value(), lng.Value(), spot.Latitude.Value(), spot.Longitude.Value())
@@ -122,17 +194,26 @@ func (s *RecommendationServiceImpl) Distill(
 		distanceWeight := 1.0 / (1.0 + math.Log1p(dist))
 		
 		// 2. 共鳴重み: MatchCountが多いほど指数関数的に評価を高め、他人の平均点（ランキング）を圧倒させる。
-		resonanceWeight := (math.Log1p(float64(resCount)) * 3.0) + 1.0
+		resonanceWeight := (math.Log1p(trust) * 3.0) + 1.0
 		
 		// 3. 統合計算: スコア = (共鳴の深さ × 現場の熱量) × 距離の近さ
 		scoreValue := (resonanceWeight * float64(density.Int())) * distanceWeight
 
+		// 4. リアクション補正（任意）: 対数で効かせ、共鳴・熱量による序列を大きく覆さない程度に留める。
+		if s.reactionWeight > 0 {
+			scoreValue *= 1.0 + s.reactionWeight*math.Log1p(float64(reactionCounts[spot.ID.Value()]))
+		}
+
+		// 5. 評価補正（任意）: 王座に据えた共鳴者自身が強く推している店ほど高く、渋い評価の店ほど低く見積もる。
+		scoreValue *= s.ratingFactor(spot.Rating.RegisteredUserRating)
+
 		// 全候補の中から、この統合スコアが最大となる1軒のみを「最適解」として選び出す。
 		if scoreValue > maxScore {
 			maxScore = scoreValue
 			bestSpot = spot
 			bestResonance = resCount
 			bestDensity = density.Int()
+			bestFollowed = followed[spot.RegisteredUserID.Value()]
 		}
 	}
 
@@ -147,17 +228,23 @@ func (s *RecommendationServiceImpl) Distill(
 	denScoreVO, _ := value_objects.NewDensityScore(bestDensity)
 	
 	// ユーザーに対し、なぜこの1軒なのかを「共鳴」と「熱量」の具体的な数値で証明する。
-	reasonVO, _ := value_objects.NewReason(fmt.Sprintf(
+	reason := fmt.Sprintf(
 		"あなたと %d 箇所で『全く同じ一軒』を選び抜いた共鳴者が、激戦区（熱量:%d）で王座に据えた至高の1軒です。",
 		bestResonance,
 		bestDensity,
-	))
+	)
+	// まだ同じ店を選んでいないフォロー中のユーザーの選択であれば、フォローを根拠として示す。
+	if bestFollowed && bestResonance == 0 {
+		reason = fmt.Sprintf("あなたがフォローしているユーザーが、激戦区（熱量:%d）で王座に据えた至高の1軒です。", bestDensity)
+	}
+	reasonVO, _ := value_objects.NewReason(reason)
 
 	// 共鳴者がその店に対して残した熱量の高い投稿（Post）を抽出し、体験の証拠として添える。
-	allPosts, _ := s.spotRepo.FindPostsBySpot(ctx, bestSpot.ID)
+	// 共鳴者・フォロー中の集合はリポジトリの段階でブロック・ミュートの相手を除いているため、その投稿は添えない。
+	allPosts, _ := s.spotRepo.FindPostsBySpot(ctx, bestSpot.ID, user.ID)
 	var resonantPosts []*entities.Post
 	for _, p := range allPosts {
-		if _, ok := resonanceMap[p.UserID.Value()]; ok {
+		if _, ok := trustMap[p.UserID.Value()]; ok {
 			resonantPosts = append(resonantPosts, p)
 		}
 	}
@@ -165,6 +252,19 @@ func (s *RecommendationServiceImpl) Distill(
 	return bestSpot, totalScore, resCountVO, denScoreVO, reasonVO, resonantPosts, nil
 }
 
+// minRatingFactor は ratingFactor の下限です。重みが 0 になると代表選定・スコアの比較で必ず負けてしまい、
+// メッシュに他の候補がなくても推薦から消えるため、低評価の店は序列を下げるだけに留める。
+const minRatingFactor = 0.05
+
+// ratingFactor は共鳴者の評価を選択の重みに換算します。★3 を 1 として ★5 で 1+w、★1 で 1-w（minRatingFactor 未満にはしない）。
+// 未評価、または ratingWeight が 0 の場合は常に 1 です。
+func (s *RecommendationServiceImpl) ratingFactor(rating value_objects.Rating) float64 {
+	if s.ratingWeight == 0 || !rating.IsRated() {
+		return 1.0
+	}
+	return math.Max(1.0+s.ratingWeight*float64(rating.Int()-3)/2.0, minRatingFactor)
+}
+
 // calculateDistance は、2地点間の大圏距離（km）を算出する数学的な補助関数です。
 func (s *RecommendationServiceImpl) calculateDistance(lat1, lon1, lat2, lon2 float64) float64 {
 	const R = 6371 // 地球の半径 (km)
@@ -0,0 +1,180 @@
+package domain_impl_services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubSpotRepository は Distill が使うメソッドだけを固定のデータで返します。
+type stubSpotRepository struct {
+	entities.SpotRepository
+	resonant []entities.ResonantUser
+	spots    []*entities.Spot
+	ratings  map[int]entities.SpotRating
+	posts    []*entities.Post
+}
+
+func (r *stubSpotRepository) FindResonantUsersWithMatchCount(ctx context.Context, userID value_objects.ID) ([]entities.ResonantUser, error) {
+	return r.resonant, nil
+}
+
+// FindSpotsByMeshAndUsers は、渡された共鳴者が登録したスポットだけを返します。
+func (r *stubSpotRepository) FindSpotsByMeshAndUsers(ctx context.Context, meshIDs []value_objects.MeshID, userIDs []value_objects.ID, viewerID value_objects.ID) ([]*entities.Spot, error) {
+	var spots []*entities.Spot
+	for _, spot := range r.spots {
+		for _, id := range userIDs {
+			if spot.RegisteredUserID == id {
+				spots = append(spots, spot)
+			}
+		}
+	}
+	return spots, nil
+}
+
+func (r *stubSpotRepository) FindRatingsBySpots(ctx context.Context, spotIDs []value_objects.ID, viewerID value_objects.ID) (map[int]entities.SpotRating, error) {
+	if r.ratings == nil {
+		return map[int]entities.SpotRating{}, nil
+	}
+	return r.ratings, nil
+}
+
+func (r *stubSpotRepository) GetDensityScoreByMesh(ctx context.Context, meshID value_objects.MeshID, viewerID value_objects.ID) (value_objects.DensityScore, error) {
+	return value_objects.NewDensityScore(len(r.posts))
+}
+
+func (r *stubSpotRepository) FindPostsBySpot(ctx context.Context, spotID, viewerID value_objects.ID) ([]*entities.Post, error) {
+	var posts []*entities.Post
+	for _, p := range r.posts {
+		if p.SpotID == spotID {
+			posts = append(posts, p)
+		}
+	}
+	return posts, nil
+}
+
+type stubFollowRepository struct {
+	entities.FollowRepository
+	followees []value_objects.ID
+	called    bool
+}
+
+func (r *stubFollowRepository) FindFolloweeIDs(ctx context.Context, followerID value_objects.ID) ([]value_objects.ID, error) {
+	r.called = true
+	return r.followees, nil
+}
+
+func TestRecommendationService_DistillWithFollows(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	lat, _ := value_objects.NewLatitude(35.6467)
+	lng, _ := value_objects.NewLongitude(139.7101)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	// taro（id 3）は共通スポット1件の共鳴者、hanako（id 4）は共通スポットのないフォロー中のユーザー。
+	// 2人は同じメッシュで別の店を王座に据えている。
+	taroSpot, _ := entities.NewSpot(10, "恵比寿うどん", 35.6467, 139.7101, 3)
+	hanakoSpot, _ := entities.NewSpot(11, "恵比寿そば", 35.6468, 139.7102, 4)
+	taroPost, _ := entities.NewPost(100, 3, 10, "taro", "", "", now)
+	hanakoPost, _ := entities.NewPost(101, 4, 11, "hanako", "", "", now)
+	resonant := []entities.ResonantUser{{ID: taroSpot.RegisteredUserID, MatchCount: 1}}
+	followees := []value_objects.ID{hanakoSpot.RegisteredUserID}
+
+	tests := []struct {
+		name         string
+		resonant     []entities.ResonantUser
+		followWeight float64
+		wantSpot     *entities.Spot
+		wantErr      bool
+		check        func(t *testing.T, reason value_objects.Reason, posts []*entities.Post, follows *stubFollowRepository)
+	}{
+		{
+			name:         "【正常系】フォローの上乗せで信頼度が上回れば、フォロー中のユーザーの店をフォローを根拠に推薦する",
+			resonant:     resonant,
+			followWeight: 2,
+			wantSpot:     hanakoSpot,
+			check: func(t *testing.T, reason value_objects.Reason, posts []*entities.Post, follows *stubFollowRepository) {
+				assert.Contains(t, reason.String(), "フォローしているユーザー")
+				if assert.Len(t, posts, 1) {
+					assert.Equal(t, hanakoPost.ID, posts[0].ID)
+				}
+			},
+		},
+		{
+			name:         "【正常系】共鳴者がいなくても、フォロー中のユーザーがいれば推薦する",
+			followWeight: 2,
+			wantSpot:     hanakoSpot,
+		},
+		{
+			name:         "【正常系】followWeight が 0 ならフォローを参照せず、共鳴者の店を推薦する",
+			resonant:     resonant,
+			followWeight: 0,
+			wantSpot:     taroSpot,
+			check: func(t *testing.T, reason value_objects.Reason, posts []*entities.Post, follows *stubFollowRepository) {
+				assert.False(t, follows.called)
+				assert.Contains(t, reason.String(), "1 箇所")
+			},
+		},
+		{
+			name:         "【異常系】共鳴者もフォロー中のユーザーもいなければエラー",
+			followWeight: 0,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spots := &stubSpotRepository{
+				resonant: tt.resonant,
+				spots:    []*entities.Spot{taroSpot, hanakoSpot},
+				posts:    []*entities.Post{taroPost, hanakoPost},
+			}
+			follows := &stubFollowRepository{followees: followees}
+			service := NewRecommendationServiceImpl(spots, nil, follows, 0, 0, tt.followWeight)
+
+			spot, _, _, _, reason, posts, err := service.Distill(context.Background(), malloy, lat, lng, entities.SpotFilter{})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			if assert.NotNil(t, spot) {
+				assert.Equal(t, tt.wantSpot.ID, spot.ID)
+			}
+			if tt.check != nil {
+				tt.check(t, reason, posts, follows)
+			}
+		})
+	}
+}
+
+// ratingWeight が 1 でも、★1 の店はメッシュ内の唯一の候補であれば推薦から消えないこと
+func TestRecommendationService_DistillKeepsLowRatedOnlyCandidate(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	lat, _ := value_objects.NewLatitude(35.6467)
+	lng, _ := value_objects.NewLongitude(139.7101)
+	taroSpot, _ := entities.NewSpot(10, "恵比寿うどん", 35.6467, 139.7101, 3)
+	taroPost, _ := entities.NewPost(100, 3, 10, "taro", "", "", time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	oneStar, _ := value_objects.NewRating(1)
+
+	spots := &stubSpotRepository{
+		resonant: []entities.ResonantUser{{ID: taroSpot.RegisteredUserID, MatchCount: 1}},
+		spots:    []*entities.Spot{taroSpot},
+		ratings:  map[int]entities.SpotRating{taroSpot.ID.Value(): {Count: 1, RegisteredUserRating: oneStar}},
+		posts:    []*entities.Post{taroPost},
+	}
+	service := NewRecommendationServiceImpl(spots, nil, &stubFollowRepository{}, 0, 1, 0)
+
+	spot, score, _, _, _, _, err := service.Distill(context.Background(), malloy, lat, lng, entities.SpotFilter{})
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, spot) {
+		assert.Equal(t, taroSpot.ID, spot.ID)
+	}
+	assert.Greater(t, score.Float64(), 0.0)
+}
@@ -2,12 +2,17 @@ package router
 
 import (
 	"database/sql"
+	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"app/src/adapter/controller"
 	"app/src/adapter/presenter"
 	"app/src/infrastructure/database/postgres"
 	impl_services "app/src/infrastructure/domain_impl/services"
+	"app/src/infrastructure/storage"
 	"app/src/usecase"
 
 	"github.com/labstack/echo/v4"
@@ -18,14 +23,77 @@ func InitRoutes(e *echo.Echo, db *sql.DB) {
 	spotRepo := postgres.NewSpotRepository(db)
 	postRepo := postgres.NewPostRepository(db)
 	userRepo := postgres.NewUserRepository(db)
+	spotEditRepo := postgres.NewSpotEditRepository(db)
+	imageRepo := postgres.NewImageRepository(db)
+	hashtagRepo := postgres.NewHashtagRepository(db)
+	mentionRepo := postgres.NewMentionRepository(db)
+	reactionRepo := postgres.NewReactionRepository(db)
+	commentRepo := postgres.NewCommentRepository(db)
+	feedRepo := postgres.NewFeedRepository(db)
+	resonanceRepo := postgres.NewResonanceRepository(db)
+	followRepo := postgres.NewFollowRepository(db)
+	restrictionRepo := postgres.NewUserRestrictionRepository(db)
+	accountDeletionRepo := postgres.NewAccountDeletionRepository(db)
+	personalDataRepo := postgres.NewPersonalDataRepository(db)
+
+	// 画像の保存先（STORAGE_DRIVER=local | s3）
+	storageConfig := storage.NewConfigFromEnv()
+	blobStore, err := storage.NewBlobStore(storageConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize image storage: %v", err)
+	}
 
 	jwtSecret := os.Getenv("JWT_SECRET")
 	if jwtSecret == "" {
 		jwtSecret = "develop_secret_key_change_me"
 	}
 
+	// 修正提案を自動適用（または却下）する純得票数
+	spotEditThreshold := usecase.DefaultSpotEditApplyThreshold
+	if v, err := strconv.Atoi(os.Getenv("SPOT_EDIT_APPLY_THRESHOLD")); err == nil && v > 0 {
+		spotEditThreshold = v
+	}
+
+	// スポットを自動で閉店扱いにする閉店報告者数
+	spotClosureThreshold := usecase.DefaultSpotClosureReportThreshold
+	if v, err := strconv.Atoi(os.Getenv("SPOT_CLOSURE_REPORT_THRESHOLD")); err == nil && v > 0 {
+		spotClosureThreshold = v
+	}
+
+	// 推薦スコアに投稿へのリアクション件数を反映する強さ（未設定・0 なら反映しない）
+	var reactionWeight float64
+	if v, err := strconv.ParseFloat(os.Getenv("RECOMMENDATION_REACTION_WEIGHT"), 64); err == nil && v > 0 {
+		reactionWeight = v
+	}
+
+	// 共鳴者が自分の選んだ店に付けた評価を推薦に反映する強さ（0〜1、未設定・0 なら反映しない）
+	var ratingWeight float64
+	if v, err := strconv.ParseFloat(os.Getenv("RECOMMENDATION_RATING_WEIGHT"), 64); err == nil && v > 0 {
+		ratingWeight = v
+	}
+
+	// フォロー中のユーザーを共鳴者として扱う際の上乗せ分（共通スポット数に加算。未設定・0 なら反映しない）
+	var followWeight float64
+	if v, err := strconv.ParseFloat(os.Getenv("RECOMMENDATION_FOLLOW_WEIGHT"), 64); err == nil && v > 0 {
+		followWeight = v
+	}
+
+	// 退会の申請から完全に削除するまでの猶予期間（パージ処理の ACCOUNT_DELETION_GRACE_PERIOD と共通）
+	accountDeletionGracePeriod := usecase.DefaultAccountDeletionGracePeriod
+	if v, err := time.ParseDuration(os.Getenv("ACCOUNT_DELETION_GRACE_PERIOD")); err == nil && v > 0 {
+		accountDeletionGracePeriod = v
+	}
+
+	// コメントを自動で非表示にする禁止語（カンマ区切り、未設定なら自動判定しない）
+	var commentBlockedWords []string
+	if v := os.Getenv("COMMENT_BLOCKED_WORDS"); v != "" {
+		commentBlockedWords = strings.Split(v, ",")
+	}
+
 	authService := impl_services.NewAuthDomainServiceImpl(jwtSecret)
-	recommendationService := impl_services.NewRecommendationServiceImpl(spotRepo)
+	recommendationService := impl_services.NewRecommendationServiceImpl(spotRepo, reactionRepo, followRepo, reactionWeight, ratingWeight, followWeight)
+	imageProcessor := impl_services.NewImageProcessorImpl()
+	commentModerator := impl_services.NewKeywordCommentModeratorImpl(commentBlockedWords)
 
 	// 2. プレゼンターの初期化
 	authLoginPresenter := presenter.NewAuthLoginPresenter()
@@ -33,13 +101,71 @@ func InitRoutes(e *echo.Echo, db *sql.DB) {
 	registerSpotPostPresenter := presenter.NewRegisterSpotPostPresenter()
 	distillRecommendationPresenter := presenter.NewDistillRecommendationPresenter()
 	getUserSpotsPresenter := presenter.NewGetUserSpotsPresenter()
+	mergeSpotsPresenter := presenter.NewMergeSpotsPresenter()
+	getSpotDetailPresenter := presenter.NewGetSpotDetailPresenter()
+	searchSpotsPresenter := presenter.NewSearchSpotsPresenter()
+	exportUserSpotsExporters := presenter.NewSpotExporters()
+	proposeSpotEditPresenter := presenter.NewProposeSpotEditPresenter()
+	getSpotEditsPresenter := presenter.NewGetSpotEditsPresenter()
+	reportSpotClosurePresenter := presenter.NewReportSpotClosurePresenter()
+	updateSpotStatusPresenter := presenter.NewUpdateSpotStatusPresenter()
+	uploadImagePresenter := presenter.NewUploadImagePresenter()
+	getTagSpotsPresenter := presenter.NewGetTagSpotsPresenter()
+	getTrendingTagsPresenter := presenter.NewGetTrendingTagsPresenter()
+	getMentionsPresenter := presenter.NewGetMentionsPresenter()
+	reactToPostPresenter := presenter.NewReactToPostPresenter()
+	getPostReactionsPresenter := presenter.NewGetPostReactionsPresenter()
+	commentPresenter := presenter.NewCommentPresenter()
+	commentListPresenter := presenter.NewCommentListPresenter()
+	getFeedPresenter := presenter.NewGetFeedPresenter()
+	userProfilePresenter := presenter.NewUserProfilePresenter()
+	getPublicProfilePresenter := presenter.NewGetPublicProfilePresenter()
+	getResonanceCirclePresenter := presenter.NewGetResonanceCirclePresenter()
+	followUserPresenter := presenter.NewFollowUserPresenter()
+	getFollowsPresenter := presenter.NewGetFollowsPresenter()
+	restrictUserPresenter := presenter.NewRestrictUserPresenter()
+	getRestrictedUsersPresenter := presenter.NewGetRestrictedUsersPresenter()
+	requestAccountDeletionPresenter := presenter.NewRequestAccountDeletionPresenter()
+	personalDataArchiver := presenter.NewPersonalDataArchiver()
 
 	// 3. ユースケースの初期化
-	authLoginUsecase := usecase.NewAuthLoginInteractor(authLoginPresenter, userRepo, authService)
+	authLoginUsecase := usecase.NewAuthLoginInteractor(authLoginPresenter, userRepo, accountDeletionRepo, authService)
 	userSignupUsecase := usecase.NewUserSignupInteractor(userSignupPresenter, userRepo, authService)
-	registerSpotUsecase := usecase.NewRegisterSpotPostInteractor(registerSpotPostPresenter, spotRepo, postRepo, authService)
+	registerSpotUsecase := usecase.NewRegisterSpotPostInteractor(registerSpotPostPresenter, spotRepo, postRepo, imageRepo, authService)
 	distillRecommendationUsecase := usecase.NewDistillRecommendationInteractor(distillRecommendationPresenter, recommendationService, authService)
 	getUserSpotsUsecase := usecase.NewGetUserSpotsInteractor(getUserSpotsPresenter, spotRepo, postRepo, authService)
+	mergeSpotsUsecase := usecase.NewMergeSpotsInteractor(mergeSpotsPresenter, spotRepo, userRepo, authService)
+	getSpotDetailUsecase := usecase.NewGetSpotDetailInteractor(getSpotDetailPresenter, spotRepo, userRepo, authService)
+	searchSpotsUsecase := usecase.NewSearchSpotsInteractor(searchSpotsPresenter, spotRepo, authService)
+	exportUserSpotsUsecase := usecase.NewExportUserSpotsInteractor(exportUserSpotsExporters, spotRepo, postRepo, authService)
+	proposeSpotEditUsecase := usecase.NewProposeSpotEditInteractor(proposeSpotEditPresenter, spotRepo, spotEditRepo, authService, spotEditThreshold)
+	voteSpotEditUsecase := usecase.NewVoteSpotEditInteractor(proposeSpotEditPresenter, spotRepo, spotEditRepo, authService, spotEditThreshold)
+	getSpotEditsUsecase := usecase.NewGetSpotEditsInteractor(getSpotEditsPresenter, spotRepo, spotEditRepo, authService, spotEditThreshold)
+	reportSpotClosureUsecase := usecase.NewReportSpotClosureInteractor(reportSpotClosurePresenter, spotRepo, authService, spotClosureThreshold)
+	updateSpotStatusUsecase := usecase.NewUpdateSpotStatusInteractor(updateSpotStatusPresenter, spotRepo, userRepo, authService)
+	uploadImageUsecase := usecase.NewUploadImageInteractor(uploadImagePresenter, imageRepo, blobStore, imageProcessor, authService)
+	getTagSpotsUsecase := usecase.NewGetTagSpotsInteractor(getTagSpotsPresenter, hashtagRepo, authService)
+	getTrendingTagsUsecase := usecase.NewGetTrendingTagsInteractor(getTrendingTagsPresenter, hashtagRepo, authService)
+	getMentionsUsecase := usecase.NewGetMentionsInteractor(getMentionsPresenter, mentionRepo, authService)
+	reactToPostUsecase := usecase.NewReactToPostInteractor(reactToPostPresenter, postRepo, reactionRepo, authService)
+	getPostReactionsUsecase := usecase.NewGetPostReactionsInteractor(getPostReactionsPresenter, postRepo, reactionRepo, authService)
+	postCommentUsecase := usecase.NewPostCommentInteractor(commentPresenter, postRepo, commentRepo, commentModerator, authService)
+	getPostCommentsUsecase := usecase.NewGetPostCommentsInteractor(commentListPresenter, postRepo, commentRepo, authService)
+	getCommentRepliesUsecase := usecase.NewGetCommentRepliesInteractor(commentListPresenter, postRepo, commentRepo, authService)
+	updateCommentUsecase := usecase.NewUpdateCommentInteractor(commentPresenter, commentRepo, commentModerator, authService)
+	deleteCommentUsecase := usecase.NewDeleteCommentInteractor(commentRepo, userRepo, authService)
+	moderateCommentUsecase := usecase.NewModerateCommentInteractor(commentPresenter, commentRepo, userRepo, authService)
+	getFeedUsecase := usecase.NewGetFeedInteractor(getFeedPresenter, spotRepo, feedRepo, authService)
+	getMyProfileUsecase := usecase.NewGetMyProfileInteractor(userProfilePresenter, userRepo, authService)
+	updateMyProfileUsecase := usecase.NewUpdateMyProfileInteractor(userProfilePresenter, userRepo, imageRepo, authService)
+	getPublicProfileUsecase := usecase.NewGetPublicProfileInteractor(getPublicProfilePresenter, userRepo, spotRepo, postRepo, restrictionRepo, authService)
+	getResonanceCircleUsecase := usecase.NewGetResonanceCircleInteractor(getResonanceCirclePresenter, resonanceRepo, authService)
+	followUserUsecase := usecase.NewFollowUserInteractor(followUserPresenter, userRepo, followRepo, restrictionRepo, authService)
+	getFollowsUsecase := usecase.NewGetFollowsInteractor(getFollowsPresenter, userRepo, followRepo, restrictionRepo, authService)
+	restrictUserUsecase := usecase.NewRestrictUserInteractor(restrictUserPresenter, userRepo, restrictionRepo, authService)
+	getRestrictedUsersUsecase := usecase.NewGetRestrictedUsersInteractor(getRestrictedUsersPresenter, restrictionRepo, authService)
+	requestAccountDeletionUsecase := usecase.NewRequestAccountDeletionInteractor(requestAccountDeletionPresenter, userRepo, accountDeletionRepo, authService, accountDeletionGracePeriod)
+	exportPersonalDataUsecase := usecase.NewExportPersonalDataInteractor(personalDataArchiver, userRepo, personalDataRepo, authService)
 
 	// 4. コントローラーの初期化
 	authLoginController := controller.NewAuthLoginController(authLoginUsecase)
@@ -47,6 +173,38 @@ func InitRoutes(e *echo.Echo, db *sql.DB) {
 	registerSpotPostController := controller.NewRegisterSpotPostController(registerSpotUsecase)
 	distillRecommendationController := controller.NewDistillRecommendationController(distillRecommendationUsecase)
 	getUserSpotsController := controller.NewGetUserSpotsController(getUserSpotsUsecase)
+	mergeSpotsController := controller.NewMergeSpotsController(mergeSpotsUsecase)
+	getSpotDetailController := controller.NewGetSpotDetailController(getSpotDetailUsecase)
+	searchSpotsController := controller.NewSearchSpotsController(searchSpotsUsecase)
+	exportUserSpotsController := controller.NewExportUserSpotsController(exportUserSpotsUsecase)
+	proposeSpotEditController := controller.NewProposeSpotEditController(proposeSpotEditUsecase)
+	voteSpotEditController := controller.NewVoteSpotEditController(voteSpotEditUsecase)
+	getSpotEditsController := controller.NewGetSpotEditsController(getSpotEditsUsecase)
+	reportSpotClosureController := controller.NewReportSpotClosureController(reportSpotClosureUsecase)
+	updateSpotStatusController := controller.NewUpdateSpotStatusController(updateSpotStatusUsecase)
+	uploadImageController := controller.NewUploadImageController(uploadImageUsecase)
+	getTagSpotsController := controller.NewGetTagSpotsController(getTagSpotsUsecase)
+	getTrendingTagsController := controller.NewGetTrendingTagsController(getTrendingTagsUsecase)
+	getMentionsController := controller.NewGetMentionsController(getMentionsUsecase)
+	reactToPostController := controller.NewReactToPostController(reactToPostUsecase)
+	getPostReactionsController := controller.NewGetPostReactionsController(getPostReactionsUsecase)
+	postCommentController := controller.NewPostCommentController(postCommentUsecase)
+	getPostCommentsController := controller.NewGetPostCommentsController(getPostCommentsUsecase)
+	getCommentRepliesController := controller.NewGetCommentRepliesController(getCommentRepliesUsecase)
+	updateCommentController := controller.NewUpdateCommentController(updateCommentUsecase)
+	deleteCommentController := controller.NewDeleteCommentController(deleteCommentUsecase)
+	moderateCommentController := controller.NewModerateCommentController(moderateCommentUsecase)
+	getFeedController := controller.NewGetFeedController(getFeedUsecase)
+	getMyProfileController := controller.NewGetMyProfileController(getMyProfileUsecase)
+	updateMyProfileController := controller.NewUpdateMyProfileController(updateMyProfileUsecase)
+	getPublicProfileController := controller.NewGetPublicProfileController(getPublicProfileUsecase)
+	getResonanceCircleController := controller.NewGetResonanceCircleController(getResonanceCircleUsecase)
+	followUserController := controller.NewFollowUserController(followUserUsecase)
+	getFollowsController := controller.NewGetFollowsController(getFollowsUsecase)
+	restrictUserController := controller.NewRestrictUserController(restrictUserUsecase)
+	getRestrictedUsersController := controller.NewGetRestrictedUsersController(getRestrictedUsersUsecase)
+	requestAccountDeletionController := controller.NewRequestAccountDeletionController(requestAccountDeletionUsecase)
+	exportPersonalDataController := controller.NewExportPersonalDataController(exportPersonalDataUsecase)
 
 	// 5. ルーティング定義
 	v1 := e.Group("/v1")
@@ -57,7 +215,76 @@ func InitRoutes(e *echo.Echo, db *sql.DB) {
 	// PUT メソッドで定義された「情報の蒸留」エンドポイント
 	v1.PUT("/mesh/spots", registerSpotPostController.Execute)
 	v1.GET("/recommendation/distill", distillRecommendationController.Execute)
+	// 自分のプロフィール（表示名・自己紹介・拠点エリア・アイコン・ユーザー名の変更）
+	v1.GET("/users/me", getMyProfileController.Execute)
+	v1.PATCH("/users/me", updateMyProfileController.Execute)
+	// 退会の申請（猶予期間後に完全削除。期間中に再ログインすると取り消し）と、全個人データの ZIP エクスポート
+	v1.DELETE("/users/me", requestAccountDeletionController.Execute)
+	v1.GET("/users/me/data", exportPersonalDataController.Execute)
 	v1.GET("/users/me/spots", getUserSpotsController.Execute)
+	v1.GET("/users/me/export", exportUserSpotsController.Execute)
+	// 自分をメンションしている投稿（通知用）
+	v1.GET("/users/me/mentions", getMentionsController.Execute)
+	// 共鳴者の一覧（共通スポット数・直近に共通した時刻・共通スポット付き）
+	v1.GET("/users/me/resonance", getResonanceCircleController.Execute)
+	// 自分がブロック・ミュートしているユーザーの一覧
+	v1.GET("/users/me/blocks", getRestrictedUsersController.Blocks)
+	v1.GET("/users/me/mutes", getRestrictedUsersController.Mutes)
+	// 公開プロフィール（認証時は閲覧者との相性付き）。/users/me/... の固定パスが優先される
+	v1.GET("/users/:username", getPublicProfileController.Execute)
+	// フォロー（推薦ではフォロー中のユーザーも共鳴者として扱う）とフォロワー・フォロー中の一覧
+	v1.PUT("/users/:username/follow", followUserController.Execute)
+	v1.DELETE("/users/:username/follow", followUserController.Remove)
+	v1.GET("/users/:username/followers", getFollowsController.Followers)
+	v1.GET("/users/:username/following", getFollowsController.Following)
+	// ブロック（双方向に共鳴・推薦・フィード・プロフィールから外す）とミュート（自分の共鳴・推薦・フィードからのみ外す）
+	v1.PUT("/users/:username/block", restrictUserController.Block)
+	v1.DELETE("/users/:username/block", restrictUserController.Unblock)
+	v1.PUT("/users/:username/mute", restrictUserController.Mute)
+	v1.DELETE("/users/:username/mute", restrictUserController.Unmute)
+	// 共鳴者の最新の投稿（新規・上書き）のアクティビティフィード
+	v1.GET("/feed", getFeedController.Execute)
+
+	// 管理者向け：重複スポットの統合
+	v1.POST("/admin/spots/merge", mergeSpotsController.Execute)
+	// 管理者向け：閉店の確定・閉店済みスポットの復元
+	v1.PUT("/admin/spots/:id/status", updateSpotStatusController.Execute)
+	// 管理者向け：コメントの非表示・表示への復元
+	v1.PUT("/admin/comments/:id/status", moderateCommentController.Execute)
+
+	// スポット検索・詳細（投稿一覧はカーソルページング）
+	v1.GET("/spots/search", searchSpotsController.Execute)
+	v1.GET("/spots/:id", getSpotDetailController.Execute)
+
+	// スポットの修正提案（得票が閾値に達すると自動適用）と変更履歴
+	v1.POST("/spots/:id/edits", proposeSpotEditController.Execute)
+	v1.GET("/spots/:id/edits", getSpotEditsController.Execute)
+	v1.POST("/spot-edits/:id/votes", voteSpotEditController.Execute)
+
+	// 閉店報告（報告者数が閾値に達すると推薦・検索から外れる）
+	v1.POST("/spots/:id/closure-reports", reportSpotClosureController.Execute)
+
+	// 投稿へのリアクション（1投稿につき1種類）とリアクションした人の一覧
+	v1.PUT("/posts/:id/reactions", reactToPostController.Execute)
+	v1.DELETE("/posts/:id/reactions", reactToPostController.Remove)
+	v1.GET("/posts/:id/reactions", getPostReactionsController.Execute)
+
+	// 投稿へのコメント（返信は1階層まで）
+	v1.POST("/posts/:id/comments", postCommentController.Execute)
+	v1.GET("/posts/:id/comments", getPostCommentsController.Execute)
+	v1.GET("/comments/:id/replies", getCommentRepliesController.Execute)
+	v1.PATCH("/comments/:id", updateCommentController.Execute)
+	v1.DELETE("/comments/:id", deleteCommentController.Execute)
+
+	// ハッシュタグ（投稿のキャプションから抽出）
+	v1.GET("/tags/trending", getTrendingTagsController.Execute)
+	v1.GET("/tags/:tag/spots", getTagSpotsController.Execute)
+
+	// 画像アップロード（返却されたURLを投稿の image_url に使う）
+	v1.POST("/images", uploadImageController.Execute)
+	if storageConfig.Driver == "local" {
+		e.Static(storage.LocalURLPrefix, storageConfig.LocalDir)
+	}
 
 	e.GET("/health", func(c echo.Context) error {
 		return c.JSON(200, map[string]string{"status": "ok"})
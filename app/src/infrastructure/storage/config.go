@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"app/src/domain/services"
+)
+
+// LocalURLPrefix はローカル保存時に画像を配信するパスです（ルーターが静的配信に使う）。
+const LocalURLPrefix = "/uploads"
+
+// Configは画像ストレージの設定を保持します。
+type Config struct {
+	// Driver は "local"（既定）または "s3" です。
+	Driver string
+
+	// PublicBaseURL はAPIサーバー自身の公開URLです（local 時の画像URLの組み立てに使う）。
+	PublicBaseURL string
+	LocalDir      string
+
+	// S3 互換ストレージ（AWS S3 / MinIO / Cloudflare R2 など）の接続設定
+	S3Endpoint  string
+	S3Region    string
+	S3Bucket    string
+	S3AccessKey string
+	S3SecretKey string
+	// S3PublicURL は画像の公開URLの基点です。未指定の場合は <endpoint>/<bucket> を使います。
+	S3PublicURL string
+}
+
+// NewConfigFromEnvは環境変数から設定を生成します。
+func NewConfigFromEnv() *Config {
+	c := &Config{
+		Driver:        os.Getenv("STORAGE_DRIVER"),
+		PublicBaseURL: os.Getenv("PUBLIC_BASE_URL"),
+		LocalDir:      os.Getenv("STORAGE_LOCAL_DIR"),
+		S3Endpoint:    os.Getenv("S3_ENDPOINT"),
+		S3Region:      os.Getenv("S3_REGION"),
+		S3Bucket:      os.Getenv("S3_BUCKET"),
+		S3AccessKey:   os.Getenv("S3_ACCESS_KEY"),
+		S3SecretKey:   os.Getenv("S3_SECRET_KEY"),
+		S3PublicURL:   os.Getenv("S3_PUBLIC_URL"),
+	}
+	if c.Driver == "" {
+		c.Driver = "local"
+	}
+	if c.PublicBaseURL == "" {
+		c.PublicBaseURL = "http://localhost:8000"
+	}
+	if c.LocalDir == "" {
+		c.LocalDir = "./uploads"
+	}
+	if c.S3Region == "" {
+		c.S3Region = "us-east-1"
+	}
+	return c
+}
+
+// NewBlobStoreは設定に応じた BlobStore の実装を生成します。
+func NewBlobStore(c *Config) (services.BlobStore, error) {
+	switch c.Driver {
+	case "local":
+		return NewLocalBlobStore(c.LocalDir, strings.TrimRight(c.PublicBaseURL, "/")+LocalURLPrefix), nil
+	case "s3":
+		if c.S3Endpoint == "" || c.S3Bucket == "" || c.S3AccessKey == "" || c.S3SecretKey == "" {
+			return nil, fmt.Errorf("s3 storage requires S3_ENDPOINT, S3_BUCKET, S3_ACCESS_KEY and S3_SECRET_KEY")
+		}
+		return NewS3BlobStore(S3Options{
+			Endpoint:  c.S3Endpoint,
+			Region:    c.S3Region,
+			Bucket:    c.S3Bucket,
+			AccessKey: c.S3AccessKey,
+			SecretKey: c.S3SecretKey,
+			PublicURL: c.S3PublicURL,
+		}), nil
+	default:
+		return nil, fmt.Errorf("unknown STORAGE_DRIVER: %s", c.Driver)
+	}
+}
@@ -0,0 +1,222 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"app/src/domain/services"
+)
+
+// S3Options は S3 互換ストレージへの接続設定です。
+type S3Options struct {
+	// Endpoint は https://s3.ap-northeast-1.amazonaws.com や http://minio:9000 のような基点URLです。
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	PublicURL string
+
+	// HTTPClient が nil の場合は http.DefaultClient を使います。
+	HTTPClient *http.Client
+	// Now はテストで署名時刻を固定するためのものです（nil の場合は time.Now）。
+	Now func() time.Time
+}
+
+// s3BlobStore は S3 互換の REST API（パス形式: <endpoint>/<bucket>/<key>）に
+// AWS Signature Version 4 で署名したリクエストを送る BlobStore です。
+// SDK に依存せず、PUT / GET / DELETE Object のみを使います。
+type s3BlobStore struct {
+	opts S3Options
+}
+
+func NewS3BlobStore(opts S3Options) services.BlobStore {
+	opts.Endpoint = strings.TrimRight(opts.Endpoint, "/")
+	opts.PublicURL = strings.TrimRight(opts.PublicURL, "/")
+	if opts.PublicURL == "" {
+		opts.PublicURL = opts.Endpoint + "/" + opts.Bucket
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = http.DefaultClient
+	}
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	return &s3BlobStore{opts: opts}
+}
+
+func (s *s3BlobStore) objectURL(key string) string {
+	return s.opts.Endpoint + "/" + s.opts.Bucket + "/" + escapeKey(key)
+}
+
+func (s *s3BlobStore) Put(ctx context.Context, key, contentType string, body io.Reader, size int64) error {
+	// 署名にペイロードのハッシュが必要なため、一度読み切る（アップロード上限で大きさは抑えられている）。
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	_, err = s.do(req, data)
+	return err
+}
+
+func (s *s3BlobStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key), nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+func (s *s3BlobStore) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key), nil)
+	if err != nil {
+		return err
+	}
+	res, err := s.do(req, nil)
+	if err != nil {
+		return err
+	}
+	return res.Body.Close()
+}
+
+func (s *s3BlobStore) URL(key string) string {
+	return s.opts.PublicURL + "/" + escapeKey(key)
+}
+
+// do はリクエストに署名して送信し、2xx 以外をエラーとして返します。
+func (s *s3BlobStore) do(req *http.Request, payload []byte) (*http.Response, error) {
+	signV4(req, payload, s.opts.AccessKey, s.opts.SecretKey, s.opts.Region, s.opts.Now())
+
+	res, err := s.opts.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode/100 != 2 {
+		defer res.Body.Close()
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return nil, fmt.Errorf("s3 %s %s: %s: %s", req.Method, req.URL.Path, res.Status, strings.TrimSpace(string(msg)))
+	}
+	return res, nil
+}
+
+// signV4 は AWS Signature Version 4（サービス名 s3）でリクエストに署名します。
+// Host と、リクエストに設定済みのすべてのヘッダーを署名対象にします。
+func signV4(req *http.Request, payload []byte, accessKey, secretKey, region string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	sum := sha256.Sum256(payload)
+	payloadHash := hex.EncodeToString(sum[:])
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	// 1. 正規リクエスト
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	// 2. 署名対象文字列
+	scope := date + "/" + region + "/s3/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	// 3. 署名鍵の導出と署名
+	key := hmacSHA256([]byte("AWS4"+secretKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKey, scope, signedHeaders, signature,
+	))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func canonicalQuery(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		vs := append([]string(nil), values[k]...)
+		sort.Strings(vs)
+		for _, v := range vs {
+			parts = append(parts, uriEncode(k)+"="+uriEncode(v))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// escapeKey はオブジェクトキーを "/" 区切りを保ったままURIエンコードします。
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, seg := range segments {
+		segments[i] = uriEncode(seg)
+	}
+	return strings.Join(segments, "/")
+}
+
+// uriEncode は SigV4 の規則（RFC 3986 の非予約文字以外をすべて %XX）でエンコードします。
+func uriEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
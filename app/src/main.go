@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"os"
 	"fmt"
+	"app/src/infrastructure/cli"
 	"app/src/infrastructure/database/postgres" // MySQLからPostgreSQLに読み替え
 	"app/src/infrastructure/router"
 
@@ -29,6 +31,37 @@ func main() {
 		log.Fatalf("Failed to ping database: %v", err)
 	}
 
+	// サブコマンド: `app import ...` はサーバーを起動せずに一括取り込みを行う
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		if err := cli.RunImport(context.Background(), db, os.Args[2:], os.Stdout); err != nil {
+			log.Fatalf("Import failed: %v", err)
+		}
+		return
+	}
+	// サブコマンド: `app gc-images ...` は孤立したアップロード画像を一度だけ回収する
+	if len(os.Args) > 1 && os.Args[1] == "gc-images" {
+		if err := cli.RunGCImages(context.Background(), db, os.Args[2:], os.Stdout); err != nil {
+			log.Fatalf("Image GC failed: %v", err)
+		}
+		return
+	}
+	// サブコマンド: `app purge-accounts ...` は猶予期間を過ぎた退会済みユーザーを一度だけ削除する
+	if len(os.Args) > 1 && os.Args[1] == "purge-accounts" {
+		if err := cli.RunPurgeAccounts(context.Background(), db, os.Args[2:], os.Stdout); err != nil {
+			log.Fatalf("Account purge failed: %v", err)
+		}
+		return
+	}
+
+	// 投稿に使われなかったアップロード画像を定期的に回収する
+	if interval := cli.ImageGCIntervalFromEnv(); interval > 0 {
+		go cli.RunImageGCLoop(context.Background(), db, interval)
+	}
+	// 猶予期間を過ぎた退会済みユーザーを定期的に削除する
+	if interval := cli.AccountPurgeIntervalFromEnv(); interval > 0 {
+		go cli.RunAccountPurgeLoop(context.Background(), db, interval)
+	}
+
 	// 3. Echo インスタンスの生成
 	e := echo.New()
 
@@ -25,20 +25,23 @@ type AuthLoginUseCase interface {
 }
 
 type authLoginInteractor struct {
-	presenter   AuthLoginPresenter
-	userRepo    entities.UserRepository 
-	userService services.AuthDomainService
+	presenter    AuthLoginPresenter
+	userRepo     entities.UserRepository 
+	deletionRepo entities.AccountDeletionRepository
+	userService  services.AuthDomainService
 }
 
 func NewAuthLoginInteractor(
 	p AuthLoginPresenter, 
 	r entities.UserRepository, 
+	d entities.AccountDeletionRepository,
 	s services.AuthDomainService,
 ) AuthLoginUseCase {
 	return &authLoginInteractor{
-		presenter:   p,
-		userRepo:    r,
-		userService: s,
+		presenter:    p,
+		userRepo:     r,
+		deletionRepo: d,
+		userService:  s,
 	}
 }
 
@@ -49,6 +52,9 @@ func (i *authLoginInteractor) Execute(ctx context.Context, input AuthLoginInput)
 		// セキュリティのため、ユーザーの存在有無を特定させないメッセージを返す
 		return nil, errors.New("invalid username or password")
 	}
+	if user == nil {
+		return nil, errors.New("invalid username or password")
+	}
 
 	// 2. パスワードを照合する
 	// 【修正】インターフェースの変更（VOを受け取る形式）に合わせてキャストを削除
@@ -57,7 +63,14 @@ func (i *authLoginInteractor) Execute(ctx context.Context, input AuthLoginInput)
 		return nil, errors.New("invalid username or password")
 	}
 
-	// 3. 照合成功！JWT トークンを発行する
+	// 3. 退会の猶予期間中であれば、再ログインをもって申請を取り消す
+	if user.IsDeletionRequested() {
+		if err := i.deletionRepo.CancelDeletion(ctx, user.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	// 4. 照合成功！JWT トークンを発行する
 	token, err := i.userService.IssueToken(ctx, user)
 	if err != nil {
 		return nil, err
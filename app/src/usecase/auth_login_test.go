@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"app/src/domain/entities"
 	"app/src/domain/value_objects"
@@ -72,35 +73,49 @@ func (m *AuthLoginMockAuthService) VerifyToken(ctx context.Context, token string
 func TestAuthLogin_Execute(t *testing.T) {
 	bob, _ := entities.NewUser(1, "local_bob", "bob@example.com", "hashed_password")
 	hashedPass := bob.HashedPassword
+	carol, _ := entities.NewUser(2, "local_carol", "carol@example.com", "hashed_password")
+	requestedAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	carol.DeletionRequestedAt = &requestedAt
 
 	tests := []struct {
 		name      string
 		input     usecase.AuthLoginInput
-		setupMock func(am *AuthLoginMockAuthService, ur *AuthLoginMockUserRepository)
+		setupMock func(am *AuthLoginMockAuthService, ur *AuthLoginMockUserRepository, dm *MockAccountDeletionRepository)
 		wantErr   bool
 	}{
 		{
 			name:  "【正常系】正しいパスワードでログインに成功し、トークンが返る",
 			input: usecase.AuthLoginInput{Username: "local_bob", Password: "correct_password"},
-			setupMock: func(am *AuthLoginMockAuthService, ur *AuthLoginMockUserRepository) {
+			setupMock: func(am *AuthLoginMockAuthService, ur *AuthLoginMockUserRepository, dm *MockAccountDeletionRepository) {
 				ur.On("FindByUsername", mock.Anything, "local_bob").Return(bob, nil)
 				am.On("VerifyPassword", hashedPass, "correct_password").Return(nil)
 				am.On("IssueToken", mock.Anything, bob).Return("valid_jwt_token", nil)
 			},
 			wantErr: false,
 		},
+		{
+			name:  "【正常系】退会の猶予期間中にログインすると申請を取り消す",
+			input: usecase.AuthLoginInput{Username: "local_carol", Password: "correct_password"},
+			setupMock: func(am *AuthLoginMockAuthService, ur *AuthLoginMockUserRepository, dm *MockAccountDeletionRepository) {
+				ur.On("FindByUsername", mock.Anything, "local_carol").Return(carol, nil)
+				am.On("VerifyPassword", carol.HashedPassword, "correct_password").Return(nil)
+				dm.On("CancelDeletion", mock.Anything, carol.ID).Return(nil)
+				am.On("IssueToken", mock.Anything, carol).Return("valid_jwt_token", nil)
+			},
+			wantErr: false,
+		},
 		{
 			name:  "【異常系】ユーザーが存在しない場合、エラーを返す",
 			input: usecase.AuthLoginInput{Username: "none_user", Password: "any"},
-			setupMock: func(am *AuthLoginMockAuthService, ur *AuthLoginMockUserRepository) {
-				ur.On("FindByUsername", mock.Anything, "none_user").Return((*entities.User)(nil), errors.New("not found"))
+			setupMock: func(am *AuthLoginMockAuthService, ur *AuthLoginMockUserRepository, dm *MockAccountDeletionRepository) {
+				ur.On("FindByUsername", mock.Anything, "none_user").Return((*entities.User)(nil), nil)
 			},
 			wantErr: true,
 		},
 		{
 			name:  "【異常系】パスワードが間違っている場合、認証失敗",
 			input: usecase.AuthLoginInput{Username: "local_bob", Password: "wrong_password"},
-			setupMock: func(am *AuthLoginMockAuthService, ur *AuthLoginMockUserRepository) {
+			setupMock: func(am *AuthLoginMockAuthService, ur *AuthLoginMockUserRepository, dm *MockAccountDeletionRepository) {
 				ur.On("FindByUsername", mock.Anything, "local_bob").Return(bob, nil)
 				am.On("VerifyPassword", hashedPass, "wrong_password").Return(errors.New("invalid password"))
 			},
@@ -109,7 +124,7 @@ func TestAuthLogin_Execute(t *testing.T) {
 		{
 			name:  "【異常系】トークン生成に失敗した場合",
 			input: usecase.AuthLoginInput{Username: "local_bob", Password: "correct_password"},
-			setupMock: func(am *AuthLoginMockAuthService, ur *AuthLoginMockUserRepository) {
+			setupMock: func(am *AuthLoginMockAuthService, ur *AuthLoginMockUserRepository, dm *MockAccountDeletionRepository) {
 				ur.On("FindByUsername", mock.Anything, "local_bob").Return(bob, nil)
 				am.On("VerifyPassword", hashedPass, "correct_password").Return(nil)
 				am.On("IssueToken", mock.Anything, bob).Return("", errors.New("token creation failed"))
@@ -122,10 +137,11 @@ func TestAuthLogin_Execute(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			am := new(AuthLoginMockAuthService)
 			ur := new(AuthLoginMockUserRepository)
+			dm := new(MockAccountDeletionRepository)
 			presenter := &AuthLoginMockPresenter{}
-			tt.setupMock(am, ur)
+			tt.setupMock(am, ur, dm)
 
-			interactor := usecase.NewAuthLoginInteractor(presenter, ur, am)
+			interactor := usecase.NewAuthLoginInteractor(presenter, ur, dm, am)
 			output, err := interactor.Execute(context.Background(), tt.input)
 
 			if tt.wantErr {
@@ -139,6 +155,7 @@ func TestAuthLogin_Execute(t *testing.T) {
 
 			am.AssertExpectations(t)
 			ur.AssertExpectations(t)
+			dm.AssertExpectations(t)
 		})
 	}
 }
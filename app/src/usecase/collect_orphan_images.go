@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+)
+
+// DefaultOrphanImageGracePeriod は、アップロードから投稿に使われるまでを待つ猶予期間の既定値です。
+const DefaultOrphanImageGracePeriod = 24 * time.Hour
+
+// orphanImageBatchSize は1回の問い合わせで回収する孤立画像の件数です。
+const orphanImageBatchSize = 100
+
+type CollectOrphanImagesInput struct {
+	Now         time.Time
+	GracePeriod time.Duration
+	DryRun      bool
+}
+
+type CollectOrphanImagesOutput struct {
+	DryRun  bool     `json:"dry_run"`
+	Deleted int      `json:"deleted"`
+	Keys    []string `json:"keys"`
+}
+
+type CollectOrphanImagesUseCase interface {
+	Execute(ctx context.Context, input CollectOrphanImagesInput) (*CollectOrphanImagesOutput, error)
+}
+
+type collectOrphanImagesInteractor struct {
+	imageRepo entities.ImageRepository
+	blobStore services.BlobStore
+}
+
+func NewCollectOrphanImagesInteractor(r entities.ImageRepository, b services.BlobStore) CollectOrphanImagesUseCase {
+	return &collectOrphanImagesInteractor{imageRepo: r, blobStore: b}
+}
+
+// Execute は、猶予期間を過ぎてもどの投稿からも参照されていないアップロード画像を、実体（派生サイズを含む）・メタデータの順に削除します。
+// 実体の削除に失敗した画像はメタデータを残し、次回の回収で再試行します。
+func (i *collectOrphanImagesInteractor) Execute(ctx context.Context, input CollectOrphanImagesInput) (*CollectOrphanImagesOutput, error) {
+	grace := input.GracePeriod
+	if grace <= 0 {
+		grace = DefaultOrphanImageGracePeriod
+	}
+	cutoff := input.Now.Add(-grace)
+
+	out := &CollectOrphanImagesOutput{DryRun: input.DryRun, Keys: []string{}}
+	for {
+		orphans, err := i.imageRepo.FindOrphans(ctx, cutoff, orphanImageBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("orphan lookup error: %w", err)
+		}
+
+		for _, img := range orphans {
+			keys := img.StorageKeys()
+			out.Keys = append(out.Keys, keys...)
+			if input.DryRun {
+				continue
+			}
+			for _, key := range keys {
+				if err := i.blobStore.Delete(ctx, key); err != nil {
+					return out, fmt.Errorf("blob delete error (%s): %w", key, err)
+				}
+			}
+			if err := i.imageRepo.Delete(ctx, img.ID); err != nil {
+				return out, fmt.Errorf("image delete error (%d): %w", img.ID.Value(), err)
+			}
+			out.Deleted++
+		}
+
+		// dry-run では削除しないため、同じ候補が返り続けないよう1回で打ち切る。
+		if input.DryRun || len(orphans) < orphanImageBatchSize {
+			return out, nil
+		}
+	}
+}
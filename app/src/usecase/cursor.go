@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+)
+
+// 一覧APIのページングで使うカーソルは「posted_at(UnixNano):id」を base64url で包んだ不透明な文字列です。
+// クライアントは中身を解釈せず、レスポンスの next_cursor をそのまま次のリクエストに渡します。
+
+func encodePostCursor(post *entities.Post) string {
+	return encodeCursor(post.PostedAt, post.ID.Value())
+}
+
+func decodePostCursor(cursor string) (*entities.PostCursor, error) {
+	at, id, err := decodeCursor(cursor)
+	if err != nil || at == nil {
+		return nil, err
+	}
+	return &entities.PostCursor{PostedAt: *at, ID: id}, nil
+}
+
+// リアクション一覧のカーソルは「created_at(UnixNano):user_id」を同じ形式で包んだものです。
+func encodeReactionCursor(reaction *entities.Reaction) string {
+	return encodeCursor(reaction.CreatedAt, reaction.UserID.Value())
+}
+
+func decodeReactionCursor(cursor string) (*entities.ReactionCursor, error) {
+	at, id, err := decodeCursor(cursor)
+	if err != nil || at == nil {
+		return nil, err
+	}
+	return &entities.ReactionCursor{CreatedAt: *at, UserID: id}, nil
+}
+
+// コメント一覧のカーソルは「created_at(UnixNano):comment_id」です（古い順に並ぶため、次ページは基準点より新しいもの）。
+func encodeCommentCursor(comment *entities.Comment) string {
+	return encodeCursor(comment.CreatedAt, comment.ID.Value())
+}
+
+func decodeCommentCursor(cursor string) (*entities.CommentCursor, error) {
+	at, id, err := decodeCursor(cursor)
+	if err != nil || at == nil {
+		return nil, err
+	}
+	return &entities.CommentCursor{CreatedAt: *at, ID: id}, nil
+}
+
+// フォロワー・フォロー中一覧のカーソルは「followed_at(UnixNano):user_id」です。
+func encodeFollowCursor(edge entities.FollowEdge) string {
+	return encodeCursor(edge.FollowedAt, edge.User.ID.Value())
+}
+
+func decodeFollowCursor(cursor string) (*entities.FollowCursor, error) {
+	at, id, err := decodeCursor(cursor)
+	if err != nil || at == nil {
+		return nil, err
+	}
+	return &entities.FollowCursor{FollowedAt: *at, UserID: id}, nil
+}
+
+// 共鳴者一覧のカーソルは、直近順なら「last_shared_at(UnixNano):user_id」、
+// 共通スポット数順なら先頭に match_count を加えた「match_count:last_shared_at(UnixNano):user_id」です。
+func encodeResonanceCursor(peer entities.ResonantPeer, sort entities.ResonanceSort) string {
+	if sort == entities.ResonanceSortRecent {
+		return encodeCursor(peer.LastSharedAt, peer.User.ID.Value())
+	}
+	raw := fmt.Sprintf("%d:%d:%d", peer.MatchCount, peer.LastSharedAt.UnixNano(), peer.User.ID.Value())
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeResonanceCursor(cursor string, sort entities.ResonanceSort) (*entities.ResonanceCursor, error) {
+	if sort == entities.ResonanceSortRecent {
+		at, id, err := decodeCursor(cursor)
+		if err != nil || at == nil {
+			return nil, err
+		}
+		return &entities.ResonanceCursor{LastSharedAt: *at, UserID: id}, nil
+	}
+	if cursor == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidInput)
+	}
+	countStr, rest, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidInput)
+	}
+	count, err := strconv.Atoi(countStr)
+	if err != nil || count < 0 {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidInput)
+	}
+	at, id, err := decodeCursor(base64.RawURLEncoding.EncodeToString([]byte(rest)))
+	if err != nil || at == nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidInput)
+	}
+	return &entities.ResonanceCursor{MatchCount: count, LastSharedAt: *at, UserID: id}, nil
+}
+
+func encodeCursor(at time.Time, id int) string {
+	raw := fmt.Sprintf("%d:%d", at.UnixNano(), id)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeCursor は空文字なら (nil, 0, nil) を返します。
+func decodeCursor(cursor string) (*time.Time, value_objects.ID, error) {
+	if cursor == "" {
+		return nil, 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: malformed cursor", ErrInvalidInput)
+	}
+	parts := strings.SplitN(string(raw), ":", 2)
+	if len(parts) != 2 {
+		return nil, 0, fmt.Errorf("%w: malformed cursor", ErrInvalidInput)
+	}
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: malformed cursor", ErrInvalidInput)
+	}
+	id, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: malformed cursor", ErrInvalidInput)
+	}
+	idVO, err := value_objects.NewID(id)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: malformed cursor", ErrInvalidInput)
+	}
+	at := time.Unix(0, nanos)
+	return &at, idVO, nil
+}
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+// normalizePageLimit はクライアント指定の件数を 1〜maxPageLimit に丸めます（0以下は既定値）。
+func normalizePageLimit(limit int) int {
+	if limit <= 0 {
+		return defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		return maxPageLimit
+	}
+	return limit
+}
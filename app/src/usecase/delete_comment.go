@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+type DeleteCommentInput struct {
+	Token     string
+	CommentID int
+}
+
+type DeleteCommentUseCase interface {
+	Execute(ctx context.Context, input DeleteCommentInput) error
+}
+
+type deleteCommentInteractor struct {
+	commentRepo entities.CommentRepository
+	userRepo    entities.UserRepository
+	authService services.AuthDomainService
+}
+
+func NewDeleteCommentInteractor(
+	c entities.CommentRepository,
+	u entities.UserRepository,
+	a services.AuthDomainService,
+) DeleteCommentUseCase {
+	return &deleteCommentInteractor{
+		commentRepo: c,
+		userRepo:    u,
+		authService: a,
+	}
+}
+
+// Execute はコメントを削除します。削除できるのは投稿者本人と管理者です。
+// 返信が付いているコメントは会話の流れを残すため、本文を伏せた状態で一覧に残ります。
+func (i *deleteCommentInteractor) Execute(ctx context.Context, input DeleteCommentInput) error {
+	tokenUser, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	commentID, err := value_objects.NewID(input.CommentID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	comment, err := i.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		return fmt.Errorf("comment lookup error: %w", err)
+	}
+	if comment == nil || comment.IsDeleted() {
+		return fmt.Errorf("%w: comment %d", ErrNotFound, commentID.Value())
+	}
+	if comment.UserID != tokenUser.ID {
+		operator, err := i.userRepo.FindByID(tokenUser.ID)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrUnauthorized, err)
+		}
+		if !operator.IsAdmin {
+			return fmt.Errorf("%w: only the author can delete the comment", ErrForbidden)
+		}
+	}
+
+	now := time.Now()
+	comment.DeletedAt = &now
+	if err := i.commentRepo.Update(ctx, comment); err != nil {
+		return fmt.Errorf("comment delete error: %w", err)
+	}
+	return nil
+}
@@ -2,6 +2,9 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"time"
+
 	"app/src/domain/entities"
 	"app/src/domain/services"
 	"app/src/domain/value_objects"
@@ -12,6 +15,9 @@ type DistillRecommendationInput struct {
 	Token     string
 	Latitude  float64
 	Longitude float64
+	// 任意の絞り込み条件
+	Category string
+	OpenNow  bool
 }
 
 // DistillRecommendationResponse はフロントエンドへ返す最終的なレスポンス形状です
@@ -28,10 +34,31 @@ type RecommendationResult struct {
 // ... (SpotOutput, Location, AnalysisOutput, PostOutput の定義は同一のため維持) ...
 
 type SpotOutput struct {
-	ID       int      `json:"id"`
-	Name     string   `json:"name"`
-	MeshID   string   `json:"mesh_id"`
-	Location Location `json:"location"`
+	ID         int                   `json:"id"`
+	Name       string                `json:"name"`
+	MeshID     string                `json:"mesh_id"`
+	Location   Location              `json:"location"`
+	Attributes SpotAttributesPayload `json:"attributes"`
+	Rating     *SpotRatingPayload    `json:"rating"`
+}
+
+// SpotAttributesPayload はスポットの付帯情報です。未登録の項目は null になります。
+// スポットを返す各レスポンスで共通に使います。
+type SpotAttributesPayload struct {
+	Category     *string             `json:"category"`
+	Address      *string             `json:"address"`
+	PriceRange   *int                `json:"price_range"`
+	OpeningHours map[string][]string `json:"opening_hours"`
+}
+
+// SpotRatingPayload は現行の投稿に付いた評価の集計です。評価が1件もなければ rating 自体が null になります。
+// 平均は小数第1位まで。内訳の平均は、その内訳を付けた投稿がなければ null です。
+type SpotRatingPayload struct {
+	Count      int      `json:"count"`
+	Overall    float64  `json:"overall"`
+	Taste      *float64 `json:"taste"`
+	Value      *float64 `json:"value"`
+	Atmosphere *float64 `json:"atmosphere"`
 }
 
 type Location struct {
@@ -47,11 +74,16 @@ type AnalysisOutput struct {
 }
 
 type PostOutput struct {
-	ID       int    `json:"id"`
-	UserName string `json:"user_name"`
-	Caption  string `json:"caption"`
-	ImageURL string `json:"image_url"`
-	PostedAt string `json:"posted_at"`
+	ID           int                   `json:"id"`
+	UserName     string                `json:"user_name"`
+	Caption      string                `json:"caption"`
+	ImageURL     string                `json:"image_url"`
+	Images       *ImageVariantsPayload `json:"images"`
+	Photos       []PostPhotoPayload    `json:"photos"`
+	Reactions    ReactionCountsPayload `json:"reactions"`
+	CommentCount int                   `json:"comment_count"`
+	Rating       *PostRatingPayload    `json:"rating"`
+	PostedAt     string                `json:"posted_at"`
 }
 
 // DistillRecommendationPresenter の引数をバラバラに変更
@@ -106,18 +138,38 @@ func (i *distillRecommendationInteractor) Execute(ctx context.Context, input Dis
 		return nil, err
 	}
 
-	// 3. 蒸留アルゴリズム（Domain Service）の実行
+	// 3. 絞り込み条件（カテゴリ・今開いている店）
+	filter, err := newSpotFilter(input.Category, input.OpenNow, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. 蒸留アルゴリズム（Domain Service）の実行
 	// 戻り値をバラバラで受け取ることにより、ドメイン層内での循環参照を回避します
-	spot, totalScore, resonanceCount, density, reason, posts, err := i.recommendation.Distill(ctx, user, lat, lng)
+	spot, totalScore, resonanceCount, density, reason, posts, err := i.recommendation.Distill(ctx, user, lat, lng, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	// 4. 計算結果の空チェック
+	// 5. 計算結果の空チェック
 	if spot == nil {
 		return nil, nil
 	}
 
-	// 5. プレゼンターへ各ドメインオブジェクトを渡し、出力用 DTO を生成します
+	// 6. プレゼンターへ各ドメインオブジェクトを渡し、出力用 DTO を生成します
 	return i.presenter.Output(spot, totalScore, resonanceCount, density, reason, posts), nil
+}
+
+// newSpotFilter は、リクエストの絞り込み条件をドメインの SpotFilter に変換します。
+// openNow が指定された場合は now 時点で営業中の店に限定します。
+func newSpotFilter(category string, openNow bool, now time.Time) (entities.SpotFilter, error) {
+	c, err := value_objects.NewCategory(category)
+	if err != nil {
+		return entities.SpotFilter{}, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	filter := entities.SpotFilter{Category: c}
+	if openNow {
+		filter.OpenAt = &now
+	}
+	return filter, nil
 }
\ No newline at end of file
@@ -30,8 +30,8 @@ func (m *DistillMockAuthService) HashPassword(password string) (string, error) {
 func (m *DistillMockAuthService) VerifyPassword(hashed value_objects.HashedPassword, rawPassword string) error { return nil }
 func (m *DistillMockAuthService) IssueToken(ctx context.Context, user *entities.User) (string, error) { return "", nil }
 
-func (m *MockRecommendationService) Distill(ctx context.Context, u *entities.User, lat value_objects.Latitude, lng value_objects.Longitude) (*entities.Spot, value_objects.TotalScore, value_objects.ResonanceCount, value_objects.DensityScore, value_objects.Reason, []*entities.Post, error) {
-	args := m.Called(ctx, u, lat, lng)
+func (m *MockRecommendationService) Distill(ctx context.Context, u *entities.User, lat value_objects.Latitude, lng value_objects.Longitude, filter entities.SpotFilter) (*entities.Spot, value_objects.TotalScore, value_objects.ResonanceCount, value_objects.DensityScore, value_objects.Reason, []*entities.Post, error) {
+	args := m.Called(ctx, u, lat, lng, filter)
 	spot, _ := args.Get(0).(*entities.Spot)
 	total, _ := args.Get(1).(value_objects.TotalScore)
 	res, _ := args.Get(2).(value_objects.ResonanceCount)
@@ -94,7 +94,7 @@ func TestDistillRecommendation_Execute(t *testing.T) {
 			},
 			setupMock: func(am *DistillMockAuthService, rs *MockRecommendationService) {
 				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
-				rs.On("Distill", mock.Anything, malloy, mock.Anything, mock.Anything).
+				rs.On("Distill", mock.Anything, malloy, mock.Anything, mock.Anything, entities.SpotFilter{}).
 					Return(bobSpot, tsNormal, rcNormal, dsNormal, reasonNormal, []*entities.Post{}, nil)
 			},
 			wantErr: false,
@@ -113,7 +113,7 @@ func TestDistillRecommendation_Execute(t *testing.T) {
 			setupMock: func(am *DistillMockAuthService, rs *MockRecommendationService) {
 				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
 				// 強い共鳴結果を返すモック
-				rs.On("Distill", mock.Anything, malloy, mock.Anything, mock.Anything).
+				rs.On("Distill", mock.Anything, malloy, mock.Anything, mock.Anything, entities.SpotFilter{}).
 					Return(bobSpot, tsHigh, rcHigh, dsNormal, reasonHigh, []*entities.Post{}, nil)
 			},
 			wantErr: false,
@@ -129,7 +129,7 @@ func TestDistillRecommendation_Execute(t *testing.T) {
 			input: usecase.DistillRecommendationInput{Token: "valid_token", Latitude: 0, Longitude: 0},
 			setupMock: func(am *DistillMockAuthService, rs *MockRecommendationService) {
 				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
-				rs.On("Distill", mock.Anything, malloy, mock.Anything, mock.Anything).
+				rs.On("Distill", mock.Anything, malloy, mock.Anything, mock.Anything, entities.SpotFilter{}).
 					Return((*entities.Spot)(nil), zeroTS, zeroRC, zeroDS, noReason, []*entities.Post{}, nil)
 			},
 			wantErr: false,
@@ -137,6 +137,31 @@ func TestDistillRecommendation_Execute(t *testing.T) {
 				assert.Nil(t, out)
 			},
 		},
+		{
+			name: "【正常系】カテゴリと営業中の絞り込み条件を蒸留アルゴリズムへ渡す",
+			input: usecase.DistillRecommendationInput{
+				Token: "valid_token", Latitude: 35.6467, Longitude: 139.7101, Category: "Ramen", OpenNow: true,
+			},
+			setupMock: func(am *DistillMockAuthService, rs *MockRecommendationService) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				rs.On("Distill", mock.Anything, malloy, mock.Anything, mock.Anything, mock.MatchedBy(func(f entities.SpotFilter) bool {
+					return f.Category == value_objects.CategoryRamen && f.OpenAt != nil
+				})).Return(bobSpot, tsNormal, rcNormal, dsNormal, reasonNormal, []*entities.Post{}, nil)
+			},
+			wantErr: false,
+			check: func(t *testing.T, out *usecase.DistillRecommendationResponse) {
+				assert.NotNil(t, out.Recommendation)
+			},
+		},
+		{
+			name: "【異常系】未知のカテゴリが指定された場合、バリデーションで弾く",
+			input: usecase.DistillRecommendationInput{Token: "valid_token", Latitude: 35.6, Longitude: 139.7, Category: "fastfood"},
+			setupMock: func(am *DistillMockAuthService, rs *MockRecommendationService) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				// Distillは呼ばれないはず
+			},
+			wantErr: true,
+		},
 		{
 			name: "【異常系】トークンが不正で認証に失敗する",
 			input: usecase.DistillRecommendationInput{Token: "bad_token"},
@@ -168,7 +193,7 @@ func TestDistillRecommendation_Execute(t *testing.T) {
 			input: usecase.DistillRecommendationInput{Token: "valid_token", Latitude: 35.6, Longitude: 139.7},
 			setupMock: func(am *DistillMockAuthService, rs *MockRecommendationService) {
 				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
-				rs.On("Distill", mock.Anything, malloy, mock.Anything, mock.Anything).
+				rs.On("Distill", mock.Anything, malloy, mock.Anything, mock.Anything, entities.SpotFilter{}).
 					Return((*entities.Spot)(nil), zeroTS, zeroRC, zeroDS, noReason, []*entities.Post{}, errors.New("internal recommendation error"))
 			},
 			wantErr: true,
@@ -0,0 +1,17 @@
+package usecase
+
+import "errors"
+
+// ユースケース層で共通に扱うエラー。
+// コントローラーは errors.Is でこれらを判定し、HTTPステータスへ変換する。
+var (
+	ErrUnauthorized  = errors.New("unauthorized")
+	ErrAdminRequired = errors.New("admin privileges required")
+	ErrForbidden     = errors.New("forbidden")
+	ErrSpotNotFound  = errors.New("spot not found")
+	ErrInvalidInput  = errors.New("invalid input")
+	ErrNotFound      = errors.New("not found")
+	ErrConflict      = errors.New("conflict")
+	ErrTooLarge      = errors.New("payload too large")
+	ErrUnsupported   = errors.New("unsupported media type")
+)
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+)
+
+// PersonalDataDomainItem は本人に開示する個人データ一式です。
+type PersonalDataDomainItem struct {
+	User       *entities.User
+	ExportedAt time.Time
+	Sections   []entities.PersonalDataSection
+}
+
+// PersonalDataArchiver は、個人データの各区分を1つのアーカイブ（ZIP など）にまとめて w へ書き出します。
+type PersonalDataArchiver interface {
+	ContentType() string
+	FileExtension() string
+	Archive(w io.Writer, data PersonalDataDomainItem) error
+}
+
+type ExportPersonalDataInput struct {
+	Token string
+}
+
+// ExportPersonalDataOutput は書き出しの準備ができた状態です。
+// コントローラーはヘッダーを設定した後に Write でレスポンスへストリーミングします。
+type ExportPersonalDataOutput struct {
+	ContentType string
+	FileName    string
+
+	archiver PersonalDataArchiver
+	data     PersonalDataDomainItem
+}
+
+func (o *ExportPersonalDataOutput) Write(w io.Writer) error {
+	return o.archiver.Archive(w, o.data)
+}
+
+type ExportPersonalDataUseCase interface {
+	Execute(ctx context.Context, input ExportPersonalDataInput) (*ExportPersonalDataOutput, error)
+}
+
+type exportPersonalDataInteractor struct {
+	archiver    PersonalDataArchiver
+	userRepo    entities.UserRepository
+	dataRepo    entities.PersonalDataRepository
+	authService services.AuthDomainService
+}
+
+func NewExportPersonalDataInteractor(
+	ar PersonalDataArchiver,
+	u entities.UserRepository,
+	d entities.PersonalDataRepository,
+	a services.AuthDomainService,
+) ExportPersonalDataUseCase {
+	return &exportPersonalDataInteractor{
+		archiver:    ar,
+		userRepo:    u,
+		dataRepo:    d,
+		authService: a,
+	}
+}
+
+// Execute は本人の記録（プロフィール・スポット・投稿・リアクション・コメント・操作の記録など）をすべて集めます。
+// 退会の猶予期間中も書き出せます。
+func (i *exportPersonalDataInteractor) Execute(ctx context.Context, input ExportPersonalDataInput) (*ExportPersonalDataOutput, error) {
+	tokenUser, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+	user, err := i.userRepo.FindByID(tokenUser.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	sections, err := i.dataRepo.FindSections(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("personal data lookup error: %w", err)
+	}
+
+	return &ExportPersonalDataOutput{
+		ContentType: i.archiver.ContentType(),
+		FileName:    "trapizzino_personal_data." + i.archiver.FileExtension(),
+		archiver:    i.archiver,
+		data: PersonalDataDomainItem{
+			User:       user,
+			ExportedAt: time.Now(),
+			Sections:   sections,
+		},
+	}, nil
+}
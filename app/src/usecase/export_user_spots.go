@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+)
+
+// DefaultExportFormat は format 未指定時の書き出し形式です。
+const DefaultExportFormat = "geojson"
+
+type ExportUserSpotsInput struct {
+	Token  string
+	Format string
+}
+
+// SpotExporter は、ユーザーのスポット一覧を特定のファイル形式（GeoJSON / KML / GPX / CSV）で書き出します。
+// 各形式は w へ逐次書き込み、レスポンス全体をメモリ上に組み立てないようにします。
+type SpotExporter interface {
+	ContentType() string
+	FileExtension() string
+	Export(w io.Writer, items []UserSpotDomainItem) error
+}
+
+// ExportUserSpotsOutput は書き出しの準備ができた状態です。
+// コントローラーはヘッダーを設定した後に Write でレスポンスへストリーミングします。
+type ExportUserSpotsOutput struct {
+	ContentType string
+	FileName    string
+
+	exporter SpotExporter
+	items    []UserSpotDomainItem
+}
+
+func (o *ExportUserSpotsOutput) Write(w io.Writer) error {
+	return o.exporter.Export(w, o.items)
+}
+
+type ExportUserSpotsUseCase interface {
+	Execute(ctx context.Context, input ExportUserSpotsInput) (*ExportUserSpotsOutput, error)
+}
+
+type exportUserSpotsInteractor struct {
+	exporters   map[string]SpotExporter
+	spotRepo    entities.SpotRepository
+	postRepo    entities.PostRepository
+	authService services.AuthDomainService
+}
+
+func NewExportUserSpotsInteractor(
+	e map[string]SpotExporter,
+	s entities.SpotRepository,
+	r entities.PostRepository,
+	a services.AuthDomainService,
+) ExportUserSpotsUseCase {
+	return &exportUserSpotsInteractor{
+		exporters:   e,
+		spotRepo:    s,
+		postRepo:    r,
+		authService: a,
+	}
+}
+
+func (i *exportUserSpotsInteractor) Execute(ctx context.Context, input ExportUserSpotsInput) (*ExportUserSpotsOutput, error) {
+	user, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	// 1. 書き出し形式の決定
+	format := strings.ToLower(input.Format)
+	if format == "" {
+		format = DefaultExportFormat
+	}
+	exporter, ok := i.exporters[format]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported export format: %s", ErrInvalidInput, input.Format)
+	}
+
+	// 2. スポット一覧と同じ取得処理で、スポットと本人の最新投稿を集める
+	items, err := findUserSpotItems(ctx, i.spotRepo, i.postRepo, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExportUserSpotsOutput{
+		ContentType: exporter.ContentType(),
+		FileName:    "trapizzino_spots." + exporter.FileExtension(),
+		exporter:    exporter,
+		items:       items,
+	}, nil
+}
@@ -0,0 +1,131 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockSpotExporter は受け取ったスポットを「名前:キャプション」の行として書き出す
+type MockSpotExporter struct{}
+
+func (e *MockSpotExporter) ContentType() string   { return "text/plain" }
+func (e *MockSpotExporter) FileExtension() string { return "txt" }
+func (e *MockSpotExporter) Export(w io.Writer, items []usecase.UserSpotDomainItem) error {
+	for _, item := range items {
+		caption := "-"
+		if item.Post != nil {
+			caption = item.Post.Caption.String()
+		}
+		fmt.Fprintf(w, "%s:%s\n", item.Spot.Name.String(), caption)
+	}
+	return nil
+}
+
+func TestExportUserSpots_Execute(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	udon, _ := entities.NewSpot(1, "恵比寿うどん", 35.6467, 139.7101, 2)
+	// 他人が登録したスポットでも、本人が投稿していれば書き出しに含まれる
+	pizza, _ := entities.NewSpot(2, "中目黒ピッツァ", 35.6440, 139.6990, 9)
+
+	latestPost, _ := entities.NewPost(11, 2, 1, "local_malloy", "", "新", time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC))
+	pizzaPost, _ := entities.NewPost(12, 2, 2, "local_malloy", "", "ピザ", time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))
+
+	exporters := map[string]usecase.SpotExporter{"geojson": &MockSpotExporter{}, "csv": &MockSpotExporter{}}
+
+	tests := []struct {
+		name      string
+		input     usecase.ExportUserSpotsInput
+		setupMock func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository)
+		wantErr   bool
+		errIs     error
+		want      string
+	}{
+		{
+			name:  "【正常系】形式未指定の場合は GeoJSON で、本人が投稿したスポットを最新投稿とともに書き出す",
+			input: usecase.ExportUserSpotsInput{Token: "valid_token"},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				pm.On("FindByUserID", malloy.ID, malloy.ID).Return([]*entities.Post{latestPost, pizzaPost}, nil)
+				sm.On("FindByIDs", mock.Anything, []value_objects.ID{udon.ID, pizza.ID}).Return(map[int]*entities.Spot{1: udon, 2: pizza}, nil)
+			},
+			want: "恵比寿うどん:新\n中目黒ピッツァ:ピザ\n",
+		},
+		{
+			name:  "【正常系】形式指定は大文字小文字を区別しない",
+			input: usecase.ExportUserSpotsInput{Token: "valid_token", Format: "CSV"},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				pm.On("FindByUserID", malloy.ID, malloy.ID).Return([]*entities.Post{}, nil)
+				sm.On("FindByIDs", mock.Anything, []value_objects.ID{}).Return(map[int]*entities.Spot{}, nil)
+			},
+			want: "",
+		},
+		{
+			name:  "【異常系】未対応の形式は入力エラー",
+			input: usecase.ExportUserSpotsInput{Token: "valid_token", Format: "shp"},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】スポット取得時にDBエラーが発生した場合",
+			input: usecase.ExportUserSpotsInput{Token: "valid_token", Format: "geojson"},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				pm.On("FindByUserID", malloy.ID, malloy.ID).Return([]*entities.Post{latestPost}, nil)
+				sm.On("FindByIDs", mock.Anything, []value_objects.ID{udon.ID}).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "【異常系】トークンが不正な場合は認証エラー",
+			input: usecase.ExportUserSpotsInput{Token: "bad_token"},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
+				am.On("VerifyToken", mock.Anything, "bad_token").Return((*entities.User)(nil), errors.New("invalid token"))
+			},
+			wantErr: true,
+			errIs:   usecase.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, sm, pm := new(MockAuthService), new(MockSpotRepository), new(MockPostRepository)
+			tt.setupMock(am, sm, pm)
+			interactor := usecase.NewExportUserSpotsInteractor(exporters, sm, pm, am)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else if assert.NoError(t, err) {
+				assert.Equal(t, "text/plain", out.ContentType)
+				assert.Equal(t, "trapizzino_spots.txt", out.FileName)
+				var buf bytes.Buffer
+				assert.NoError(t, out.Write(&buf))
+				assert.Equal(t, tt.want, buf.String())
+			}
+			am.AssertExpectations(t)
+			sm.AssertExpectations(t)
+			pm.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+)
+
+type FollowUserInput struct {
+	Token    string
+	Username string
+	// Follow が false のときはフォローを解除する。
+	Follow bool
+}
+
+type FollowUserResponse struct {
+	User           PublicUserSummaryPayload `json:"user"`
+	Following      bool                     `json:"following"`
+	FollowerCount  int                      `json:"follower_count"`
+	FollowingCount int                      `json:"following_count"`
+}
+
+type FollowUserPresenter interface {
+	Output(user *entities.User, following bool, counts entities.FollowCounts) *FollowUserResponse
+}
+
+type FollowUserUseCase interface {
+	Execute(ctx context.Context, input FollowUserInput) (*FollowUserResponse, error)
+}
+
+type followUserInteractor struct {
+	presenter    FollowUserPresenter
+	userRepo     entities.UserRepository
+	followRepo   entities.FollowRepository
+	restrictRepo entities.UserRestrictionRepository
+	authService  services.AuthDomainService
+}
+
+func NewFollowUserInteractor(
+	p FollowUserPresenter,
+	u entities.UserRepository,
+	f entities.FollowRepository,
+	b entities.UserRestrictionRepository,
+	a services.AuthDomainService,
+) FollowUserUseCase {
+	return &followUserInteractor{
+		presenter:    p,
+		userRepo:     u,
+		followRepo:   f,
+		restrictRepo: b,
+		authService:  a,
+	}
+}
+
+func (i *followUserInteractor) Execute(ctx context.Context, input FollowUserInput) (*FollowUserResponse, error) {
+	follower, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	// 1. フォローする相手の確認（自分自身はフォローできない）
+	followee, err := i.userRepo.FindByUsername(ctx, input.Username)
+	if err != nil {
+		return nil, fmt.Errorf("user lookup error: %w", err)
+	}
+	if followee == nil {
+		return nil, fmt.Errorf("%w: user %q", ErrNotFound, input.Username)
+	}
+	if followee.ID == follower.ID {
+		return nil, fmt.Errorf("%w: cannot follow yourself", ErrInvalidInput)
+	}
+	// ブロックの関係にある相手は見えないため、フォローもできない
+	if err := ensureNotBlocked(ctx, i.restrictRepo, follower, followee); err != nil {
+		return nil, err
+	}
+
+	// 2. フォロー・解除（どちらも冪等）
+	if input.Follow {
+		err = i.followRepo.Follow(ctx, follower.ID, followee.ID)
+	} else {
+		err = i.followRepo.Unfollow(ctx, follower.ID, followee.ID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("follow storage error: %w", err)
+	}
+
+	// 3. 相手のフォロワー数・フォロー中の数
+	counts, err := i.followRepo.CountByUser(ctx, followee.ID)
+	if err != nil {
+		return nil, fmt.Errorf("follow count error: %w", err)
+	}
+
+	return i.presenter.Output(followee, input.Follow, counts), nil
+}
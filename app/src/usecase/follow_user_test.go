@@ -0,0 +1,240 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockFollowRepository struct {
+	mock.Mock
+}
+
+func (m *MockFollowRepository) Follow(ctx context.Context, followerID, followeeID value_objects.ID) error {
+	return m.Called(ctx, followerID, followeeID).Error(0)
+}
+
+func (m *MockFollowRepository) Unfollow(ctx context.Context, followerID, followeeID value_objects.ID) error {
+	return m.Called(ctx, followerID, followeeID).Error(0)
+}
+
+func (m *MockFollowRepository) CountByUser(ctx context.Context, userID value_objects.ID) (entities.FollowCounts, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(entities.FollowCounts), args.Error(1)
+}
+
+func (m *MockFollowRepository) FindFollowers(ctx context.Context, userID, viewerID value_objects.ID, before *entities.FollowCursor, limit int) ([]entities.FollowEdge, error) {
+	args := m.Called(ctx, userID, viewerID, before, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.FollowEdge), args.Error(1)
+}
+
+func (m *MockFollowRepository) FindFollowing(ctx context.Context, userID, viewerID value_objects.ID, before *entities.FollowCursor, limit int) ([]entities.FollowEdge, error) {
+	args := m.Called(ctx, userID, viewerID, before, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.FollowEdge), args.Error(1)
+}
+
+func (m *MockFollowRepository) FindFolloweeIDs(ctx context.Context, followerID value_objects.ID) ([]value_objects.ID, error) {
+	args := m.Called(ctx, followerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]value_objects.ID), args.Error(1)
+}
+
+type FollowUserMockPresenter struct{}
+
+func (p *FollowUserMockPresenter) Output(user *entities.User, following bool, counts entities.FollowCounts) *usecase.FollowUserResponse {
+	return &usecase.FollowUserResponse{
+		User:           usecase.PublicUserSummaryPayload{ID: user.ID.Value()},
+		Following:      following,
+		FollowerCount:  counts.Followers,
+		FollowingCount: counts.Following,
+	}
+}
+
+type GetFollowsMockPresenter struct{}
+
+func (p *GetFollowsMockPresenter) Output(edges []entities.FollowEdge, nextCursor string) *usecase.GetFollowsResponse {
+	out := &usecase.GetFollowsResponse{Users: []usecase.FollowUserPayload{}}
+	for _, edge := range edges {
+		out.Users = append(out.Users, usecase.FollowUserPayload{User: usecase.PublicUserSummaryPayload{ID: edge.User.ID.Value()}})
+	}
+	if nextCursor != "" {
+		out.NextCursor = &nextCursor
+	}
+	return out
+}
+
+func TestFollowUser_Execute(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	taro, _ := entities.NewUser(3, "taro", "taro@example.com", "hashed_password")
+
+	tests := []struct {
+		name      string
+		input     usecase.FollowUserInput
+		setupMock func(am *MockAuthService, um *MockUserRepository, fm *MockFollowRepository, bm *MockUserRestrictionRepository)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.FollowUserResponse)
+	}{
+		{
+			name:  "【正常系】フォローすると相手のフォロワー数を返す",
+			input: usecase.FollowUserInput{Token: "valid_token", Username: "taro", Follow: true},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, fm *MockFollowRepository, bm *MockUserRestrictionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				um.On("FindByUsername", mock.Anything, "taro").Return(taro, nil)
+				bm.On("IsBlockedBetween", mock.Anything, malloy.ID, taro.ID).Return(false, nil)
+				fm.On("Follow", mock.Anything, malloy.ID, taro.ID).Return(nil)
+				fm.On("CountByUser", mock.Anything, taro.ID).Return(entities.FollowCounts{Followers: 1, Following: 4}, nil)
+			},
+			check: func(t *testing.T, out *usecase.FollowUserResponse) {
+				assert.True(t, out.Following)
+				assert.Equal(t, 1, out.FollowerCount)
+				assert.Equal(t, 4, out.FollowingCount)
+			},
+		},
+		{
+			name:  "【正常系】フォローを解除できる",
+			input: usecase.FollowUserInput{Token: "valid_token", Username: "taro"},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, fm *MockFollowRepository, bm *MockUserRestrictionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				um.On("FindByUsername", mock.Anything, "taro").Return(taro, nil)
+				bm.On("IsBlockedBetween", mock.Anything, malloy.ID, taro.ID).Return(false, nil)
+				fm.On("Unfollow", mock.Anything, malloy.ID, taro.ID).Return(nil)
+				fm.On("CountByUser", mock.Anything, taro.ID).Return(entities.FollowCounts{}, nil)
+			},
+			check: func(t *testing.T, out *usecase.FollowUserResponse) {
+				assert.False(t, out.Following)
+				assert.Equal(t, 0, out.FollowerCount)
+			},
+		},
+		{
+			name:  "【異常系】自分自身はフォローできない",
+			input: usecase.FollowUserInput{Token: "valid_token", Username: "local_malloy", Follow: true},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, fm *MockFollowRepository, bm *MockUserRestrictionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				um.On("FindByUsername", mock.Anything, "local_malloy").Return(malloy, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】ブロックの関係にある相手はフォローできない（NotFound）",
+			input: usecase.FollowUserInput{Token: "valid_token", Username: "taro", Follow: true},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, fm *MockFollowRepository, bm *MockUserRestrictionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				um.On("FindByUsername", mock.Anything, "taro").Return(taro, nil)
+				bm.On("IsBlockedBetween", mock.Anything, malloy.ID, taro.ID).Return(true, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrNotFound,
+		},
+		{
+			name:  "【異常系】存在しないユーザーは NotFound",
+			input: usecase.FollowUserInput{Token: "valid_token", Username: "ghost", Follow: true},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, fm *MockFollowRepository, bm *MockUserRestrictionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				um.On("FindByUsername", mock.Anything, "ghost").Return((*entities.User)(nil), nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrNotFound,
+		},
+		{
+			name:  "【異常系】DBエラーが発生した場合",
+			input: usecase.FollowUserInput{Token: "valid_token", Username: "taro", Follow: true},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, fm *MockFollowRepository, bm *MockUserRestrictionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				um.On("FindByUsername", mock.Anything, "taro").Return(taro, nil)
+				bm.On("IsBlockedBetween", mock.Anything, malloy.ID, taro.ID).Return(false, nil)
+				fm.On("Follow", mock.Anything, malloy.ID, taro.ID).Return(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "【異常系】トークンが不正な場合は認証エラー",
+			input: usecase.FollowUserInput{Token: "bad_token", Username: "taro", Follow: true},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, fm *MockFollowRepository, bm *MockUserRestrictionRepository) {
+				am.On("VerifyToken", mock.Anything, "bad_token").Return((*entities.User)(nil), errors.New("invalid token"))
+			},
+			wantErr: true,
+			errIs:   usecase.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, um, fm, bm := new(MockAuthService), new(MockUserRepository), new(MockFollowRepository), new(MockUserRestrictionRepository)
+			tt.setupMock(am, um, fm, bm)
+			interactor := usecase.NewFollowUserInteractor(&FollowUserMockPresenter{}, um, fm, bm, am)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			am.AssertExpectations(t)
+			um.AssertExpectations(t)
+			fm.AssertExpectations(t)
+			bm.AssertExpectations(t)
+		})
+	}
+}
+
+// フォロワー一覧の次ページのカーソルは、フォローした時刻と相手のユーザーIDを復元できること
+func TestGetFollows_CursorRoundTrip(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	taro, _ := entities.NewUser(3, "taro", "taro@example.com", "hashed_password")
+	hanako, _ := entities.NewUser(4, "hanako", "hanako@example.com", "hashed_password")
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	edges := []entities.FollowEdge{
+		{User: malloy, FollowedAt: now},
+		{User: hanako, FollowedAt: now.Add(-time.Hour)},
+	}
+
+	am, um, fm, bm := new(MockAuthService), new(MockUserRepository), new(MockFollowRepository), new(MockUserRestrictionRepository)
+	am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+	um.On("FindByUsername", mock.Anything, "taro").Return(taro, nil)
+	bm.On("IsBlockedBetween", mock.Anything, malloy.ID, taro.ID).Return(false, nil)
+	fm.On("FindFollowers", mock.Anything, taro.ID, malloy.ID, (*entities.FollowCursor)(nil), 2).Return(edges, nil)
+	interactor := usecase.NewGetFollowsInteractor(&GetFollowsMockPresenter{}, um, fm, bm, am)
+
+	first, err := interactor.Execute(context.Background(), usecase.GetFollowsInput{Token: "valid_token", Username: "taro", List: usecase.FollowListFollowers, Limit: 1})
+	assert.NoError(t, err)
+	assert.Len(t, first.Users, 1)
+	if !assert.NotNil(t, first.NextCursor) {
+		return
+	}
+
+	fm.On("FindFollowers", mock.Anything, taro.ID, malloy.ID, mock.MatchedBy(func(c *entities.FollowCursor) bool {
+		return c != nil && c.UserID == malloy.ID && c.FollowedAt.Equal(now)
+	}), 2).Return(edges[1:], nil)
+
+	second, err := interactor.Execute(context.Background(), usecase.GetFollowsInput{Token: "valid_token", Username: "taro", List: usecase.FollowListFollowers, Cursor: *first.NextCursor, Limit: 1})
+	assert.NoError(t, err)
+	assert.Len(t, second.Users, 1)
+	assert.Nil(t, second.NextCursor)
+	fm.AssertExpectations(t)
+}
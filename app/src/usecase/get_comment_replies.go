@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+type GetCommentRepliesInput struct {
+	Token     string
+	CommentID int
+	Cursor    string
+	Limit     int
+}
+
+type GetCommentRepliesUseCase interface {
+	Execute(ctx context.Context, input GetCommentRepliesInput) (*CommentListResponse, error)
+}
+
+type getCommentRepliesInteractor struct {
+	presenter   CommentListPresenter
+	postRepo    entities.PostRepository
+	commentRepo entities.CommentRepository
+	authService services.AuthDomainService
+}
+
+func NewGetCommentRepliesInteractor(
+	p CommentListPresenter,
+	r entities.PostRepository,
+	c entities.CommentRepository,
+	a services.AuthDomainService,
+) GetCommentRepliesUseCase {
+	return &getCommentRepliesInteractor{
+		presenter:   p,
+		postRepo:    r,
+		commentRepo: c,
+		authService: a,
+	}
+}
+
+func (i *getCommentRepliesInteractor) Execute(ctx context.Context, input GetCommentRepliesInput) (*CommentListResponse, error) {
+	viewer, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	commentID, err := value_objects.NewID(input.CommentID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	after, err := decodeCommentCursor(input.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	limit := normalizePageLimit(input.Limit)
+
+	parent, err := i.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		return nil, fmt.Errorf("comment lookup error: %w", err)
+	}
+	if parent == nil || !canViewComment(parent, viewer.ID) {
+		return nil, fmt.Errorf("%w: comment %d", ErrNotFound, commentID.Value())
+	}
+	if parent.IsReply() {
+		return nil, fmt.Errorf("%w: replies cannot have replies", ErrInvalidInput)
+	}
+	// 閲覧者に見えない投稿（他人の private など）へのコメントは、返信も含めて存在しないものとして扱う
+	post, err := i.postRepo.FindByID(parent.PostID, viewer.ID)
+	if err != nil {
+		return nil, fmt.Errorf("post lookup error: %w", err)
+	}
+	if post == nil {
+		return nil, fmt.Errorf("%w: comment %d", ErrNotFound, commentID.Value())
+	}
+
+	// 返信（古い順）。次ページの有無を判定するため 1 件多く取得する。
+	replies, err := i.commentRepo.FindReplies(ctx, parent.ID, viewer.ID, after, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("reply lookup error: %w", err)
+	}
+	var nextCursor string
+	if len(replies) > limit {
+		replies = replies[:limit]
+		nextCursor = encodeCommentCursor(replies[len(replies)-1])
+	}
+
+	return i.presenter.Output(replies, nextCursor), nil
+}
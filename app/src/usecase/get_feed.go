@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+// フィードの投稿種別
+const (
+	FeedKindNew       = "new"
+	FeedKindOverwrite = "overwrite"
+)
+
+type GetFeedInput struct {
+	Token  string
+	Cursor string
+	Limit  int
+	// MinMatchCount は共鳴度（共通してピンしているスポット数）の下限です。0 なら共鳴者全員を対象にします。
+	MinMatchCount int
+	Latitude      *float64
+	Longitude     *float64
+}
+
+type GetFeedResponse struct {
+	Items      []FeedItemPayload `json:"items"`
+	NextCursor *string           `json:"next_cursor"`
+}
+
+// FeedItemPayload はフィードの1件（共鳴者の新規投稿または上書き投稿）です。
+type FeedItemPayload struct {
+	Kind       string          `json:"kind"`
+	MatchCount int             `json:"match_count"`
+	DistanceKm *float64        `json:"distance_km"`
+	Spot       FeedSpotPayload `json:"spot"`
+	Post       FeedPostPayload `json:"post"`
+}
+
+type FeedSpotPayload struct {
+	ID         int                   `json:"id"`
+	Name       string                `json:"name"`
+	MeshID     string                `json:"mesh_id"`
+	Location   SpotSearchLocation    `json:"location"`
+	Attributes SpotAttributesPayload `json:"attributes"`
+}
+
+type FeedPostPayload struct {
+	ID           int                   `json:"id"`
+	UserID       int                   `json:"user_id"`
+	UserName     string                `json:"user_name"`
+	ImageURL     *string               `json:"image_url"`
+	Photos       []PostPhotoPayload    `json:"photos"`
+	Reactions    ReactionCountsPayload `json:"reactions"`
+	CommentCount int                   `json:"comment_count"`
+	Rating       *PostRatingPayload    `json:"rating"`
+	Caption      string                `json:"caption"`
+	PostedAt     string                `json:"posted_at"`
+}
+
+// FeedDomainItem はフィードの1件に、投稿者と閲覧者の共鳴度を添えたものです。
+type FeedDomainItem struct {
+	Item       entities.FeedItem
+	MatchCount int
+}
+
+type GetFeedPresenter interface {
+	Output(items []FeedDomainItem, nextCursor string) *GetFeedResponse
+}
+
+type GetFeedUseCase interface {
+	Execute(ctx context.Context, input GetFeedInput) (*GetFeedResponse, error)
+}
+
+type getFeedInteractor struct {
+	presenter   GetFeedPresenter
+	spotRepo    entities.SpotRepository
+	feedRepo    entities.FeedRepository
+	authService services.AuthDomainService
+}
+
+func NewGetFeedInteractor(
+	p GetFeedPresenter,
+	s entities.SpotRepository,
+	f entities.FeedRepository,
+	a services.AuthDomainService,
+) GetFeedUseCase {
+	return &getFeedInteractor{
+		presenter:   p,
+		spotRepo:    s,
+		feedRepo:    f,
+		authService: a,
+	}
+}
+
+func (i *getFeedInteractor) Execute(ctx context.Context, input GetFeedInput) (*GetFeedResponse, error) {
+	user, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	if input.MinMatchCount < 0 {
+		return nil, fmt.Errorf("%w: min_match_count must not be negative", ErrInvalidInput)
+	}
+	before, err := decodePostCursor(input.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	limit := normalizePageLimit(input.Limit)
+
+	criteria := entities.FeedCriteria{ViewerID: user.ID, Before: before, Limit: limit + 1}
+
+	// 1. 現在地が指定されていれば、各スポットまでの距離を合わせて返す
+	if input.Latitude != nil && input.Longitude != nil {
+		lat, err := value_objects.NewLatitude(*input.Latitude)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+		lng, err := value_objects.NewLongitude(*input.Longitude)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+		criteria.Latitude = &lat
+		criteria.Longitude = &lng
+	}
+
+	// 2. 閲覧者の共鳴者集合を取得し、共鳴度の下限で絞り込む
+	resonantUsers, err := i.spotRepo.FindResonantUsersWithMatchCount(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("resonance lookup error: %w", err)
+	}
+	matchCounts := make(map[int]int, len(resonantUsers))
+	for _, ru := range resonantUsers {
+		if ru.ID == user.ID || ru.MatchCount < input.MinMatchCount {
+			continue
+		}
+		matchCounts[ru.ID.Value()] = ru.MatchCount
+		criteria.UserIDs = append(criteria.UserIDs, ru.ID)
+	}
+	if len(criteria.UserIDs) == 0 {
+		return i.presenter.Output([]FeedDomainItem{}, ""), nil
+	}
+
+	// 3. 共鳴者の現行の投稿（新しい順）。次ページの有無を判定するため 1 件多く取得する。
+	feed, err := i.feedRepo.FindByUsers(ctx, criteria)
+	if err != nil {
+		return nil, fmt.Errorf("feed lookup error: %w", err)
+	}
+	var nextCursor string
+	if len(feed) > limit {
+		feed = feed[:limit]
+		nextCursor = encodePostCursor(feed[len(feed)-1].Post)
+	}
+
+	items := make([]FeedDomainItem, 0, len(feed))
+	for _, item := range feed {
+		items = append(items, FeedDomainItem{
+			Item:       item,
+			MatchCount: matchCounts[item.Post.UserID.Value()],
+		})
+	}
+	return i.presenter.Output(items, nextCursor), nil
+}
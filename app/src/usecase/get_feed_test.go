@@ -0,0 +1,191 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockFeedRepository struct {
+	mock.Mock
+}
+
+func (m *MockFeedRepository) FindByUsers(ctx context.Context, criteria entities.FeedCriteria) ([]entities.FeedItem, error) {
+	args := m.Called(ctx, criteria)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.FeedItem), args.Error(1)
+}
+
+type GetFeedMockPresenter struct{}
+
+func (p *GetFeedMockPresenter) Output(items []usecase.FeedDomainItem, nextCursor string) *usecase.GetFeedResponse {
+	out := &usecase.GetFeedResponse{Items: []usecase.FeedItemPayload{}}
+	for _, it := range items {
+		kind := usecase.FeedKindNew
+		if it.Item.IsOverwrite {
+			kind = usecase.FeedKindOverwrite
+		}
+		out.Items = append(out.Items, usecase.FeedItemPayload{
+			Kind:       kind,
+			MatchCount: it.MatchCount,
+			DistanceKm: it.Item.DistanceKm,
+			Spot:       usecase.FeedSpotPayload{ID: it.Item.Spot.ID.Value()},
+			Post:       usecase.FeedPostPayload{ID: it.Item.Post.ID.Value(), UserID: it.Item.Post.UserID.Value()},
+		})
+	}
+	if nextCursor != "" {
+		out.NextCursor = &nextCursor
+	}
+	return out
+}
+
+func TestGetFeed_Execute(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	jiro, _ := entities.NewSpot(1, "ラーメン二郎 三田本店", 35.6467, 139.7101, 1)
+	postedAt := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	post10, _ := entities.NewPost(10, 3, 1, "taro", "", "また来た", postedAt)
+	post11, _ := entities.NewPost(11, 4, 1, "hanako", "", "初訪問", postedAt.Add(-time.Hour))
+	taro, _ := entities.NewUser(3, "taro", "taro@example.com", "hashed_password")
+	hanako, _ := entities.NewUser(4, "hanako", "hanako@example.com", "hashed_password")
+	resonance := []entities.ResonantUser{{ID: taro.ID, MatchCount: 5}, {ID: hanako.ID, MatchCount: 1}}
+	distance := 1.5
+	lat, lng := 35.65, 139.71
+	badLat := 91.0
+
+	tests := []struct {
+		name      string
+		input     usecase.GetFeedInput
+		setupMock func(am *MockAuthService, sm *MockSpotRepository, fm *MockFeedRepository)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.GetFeedResponse)
+	}{
+		{
+			name:  "【正常系】共鳴者の投稿を新しい順に返し、上書き投稿と共鳴度・距離を添える",
+			input: usecase.GetFeedInput{Token: "valid_token", Latitude: &lat, Longitude: &lng},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, fm *MockFeedRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindResonantUsersWithMatchCount", mock.Anything, malloy.ID).Return(resonance, nil)
+				fm.On("FindByUsers", mock.Anything, mock.MatchedBy(func(c entities.FeedCriteria) bool {
+					return c.ViewerID == malloy.ID && len(c.UserIDs) == 2 && c.Limit == 21 && c.Before == nil &&
+						c.Latitude != nil && c.Latitude.Value() == 35.65 && c.Longitude != nil
+				})).Return([]entities.FeedItem{
+					{Post: post10, Spot: jiro, IsOverwrite: true, DistanceKm: &distance},
+					{Post: post11, Spot: jiro, DistanceKm: &distance},
+				}, nil)
+			},
+			check: func(t *testing.T, out *usecase.GetFeedResponse) {
+				if assert.Len(t, out.Items, 2) {
+					assert.Equal(t, usecase.FeedKindOverwrite, out.Items[0].Kind)
+					assert.Equal(t, 5, out.Items[0].MatchCount)
+					assert.Equal(t, 1.5, *out.Items[0].DistanceKm)
+					assert.Equal(t, usecase.FeedKindNew, out.Items[1].Kind)
+					assert.Equal(t, 1, out.Items[1].MatchCount)
+				}
+				assert.Nil(t, out.NextCursor)
+			},
+		},
+		{
+			name:  "【正常系】共鳴度の下限で絞り込み、続きがあれば next_cursor を返す",
+			input: usecase.GetFeedInput{Token: "valid_token", MinMatchCount: 2, Limit: 1},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, fm *MockFeedRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindResonantUsersWithMatchCount", mock.Anything, malloy.ID).Return(resonance, nil)
+				fm.On("FindByUsers", mock.Anything, mock.MatchedBy(func(c entities.FeedCriteria) bool {
+					return len(c.UserIDs) == 1 && c.UserIDs[0] == taro.ID && c.Limit == 2 && c.Latitude == nil
+				})).Return([]entities.FeedItem{
+					{Post: post10, Spot: jiro},
+					{Post: post11, Spot: jiro},
+				}, nil)
+			},
+			check: func(t *testing.T, out *usecase.GetFeedResponse) {
+				assert.Len(t, out.Items, 1)
+				assert.NotNil(t, out.NextCursor)
+			},
+		},
+		{
+			name:  "【正常系】下限を満たす共鳴者がいなければ投稿を検索せず空のフィードを返す",
+			input: usecase.GetFeedInput{Token: "valid_token", MinMatchCount: 10},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, fm *MockFeedRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindResonantUsersWithMatchCount", mock.Anything, malloy.ID).Return(resonance, nil)
+			},
+			check: func(t *testing.T, out *usecase.GetFeedResponse) {
+				assert.Empty(t, out.Items)
+				assert.Nil(t, out.NextCursor)
+			},
+		},
+		{
+			name:  "【異常系】緯度が範囲外の場合は入力エラー",
+			input: usecase.GetFeedInput{Token: "valid_token", Latitude: &badLat, Longitude: &lng},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, fm *MockFeedRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】共鳴度の下限が負の場合は入力エラー",
+			input: usecase.GetFeedInput{Token: "valid_token", MinMatchCount: -1},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, fm *MockFeedRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】DBエラーが発生した場合",
+			input: usecase.GetFeedInput{Token: "valid_token"},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, fm *MockFeedRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindResonantUsersWithMatchCount", mock.Anything, malloy.ID).Return(resonance, nil)
+				fm.On("FindByUsers", mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "【異常系】トークンが不正な場合は認証エラー",
+			input: usecase.GetFeedInput{Token: "bad_token"},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, fm *MockFeedRepository) {
+				am.On("VerifyToken", mock.Anything, "bad_token").Return((*entities.User)(nil), errors.New("invalid token"))
+			},
+			wantErr: true,
+			errIs:   usecase.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, sm, fm := new(MockAuthService), new(MockSpotRepository), new(MockFeedRepository)
+			tt.setupMock(am, sm, fm)
+			interactor := usecase.NewGetFeedInteractor(&GetFeedMockPresenter{}, sm, fm, am)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			am.AssertExpectations(t)
+			sm.AssertExpectations(t)
+			fm.AssertExpectations(t)
+		})
+	}
+}
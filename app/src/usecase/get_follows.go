@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+)
+
+// フォロー一覧の種類
+const (
+	FollowListFollowers = "followers"
+	FollowListFollowing = "following"
+)
+
+type GetFollowsInput struct {
+	Token    string
+	Username string
+	// List は followers（フォロワー）または following（フォロー中）です。
+	List   string
+	Cursor string
+	Limit  int
+}
+
+type GetFollowsResponse struct {
+	Users      []FollowUserPayload `json:"users"`
+	NextCursor *string             `json:"next_cursor"`
+}
+
+type FollowUserPayload struct {
+	User       PublicUserSummaryPayload `json:"user"`
+	FollowedAt string                   `json:"followed_at"`
+}
+
+type GetFollowsPresenter interface {
+	Output(edges []entities.FollowEdge, nextCursor string) *GetFollowsResponse
+}
+
+type GetFollowsUseCase interface {
+	Execute(ctx context.Context, input GetFollowsInput) (*GetFollowsResponse, error)
+}
+
+type getFollowsInteractor struct {
+	presenter    GetFollowsPresenter
+	userRepo     entities.UserRepository
+	followRepo   entities.FollowRepository
+	restrictRepo entities.UserRestrictionRepository
+	authService  services.AuthDomainService
+}
+
+func NewGetFollowsInteractor(
+	p GetFollowsPresenter,
+	u entities.UserRepository,
+	f entities.FollowRepository,
+	b entities.UserRestrictionRepository,
+	a services.AuthDomainService,
+) GetFollowsUseCase {
+	return &getFollowsInteractor{
+		presenter:    p,
+		userRepo:     u,
+		followRepo:   f,
+		restrictRepo: b,
+		authService:  a,
+	}
+}
+
+func (i *getFollowsInteractor) Execute(ctx context.Context, input GetFollowsInput) (*GetFollowsResponse, error) {
+	viewer, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	user, err := i.userRepo.FindByUsername(ctx, input.Username)
+	if err != nil {
+		return nil, fmt.Errorf("user lookup error: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("%w: user %q", ErrNotFound, input.Username)
+	}
+	if err := ensureNotBlocked(ctx, i.restrictRepo, viewer, user); err != nil {
+		return nil, err
+	}
+
+	before, err := decodeFollowCursor(input.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	limit := normalizePageLimit(input.Limit)
+
+	// 新しい順。次ページの有無を判定するため 1 件多く取得する。
+	var edges []entities.FollowEdge
+	switch input.List {
+	case FollowListFollowers:
+		edges, err = i.followRepo.FindFollowers(ctx, user.ID, viewer.ID, before, limit+1)
+	case FollowListFollowing:
+		edges, err = i.followRepo.FindFollowing(ctx, user.ID, viewer.ID, before, limit+1)
+	default:
+		return nil, fmt.Errorf("%w: unknown follow list %q", ErrInvalidInput, input.List)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("follow lookup error: %w", err)
+	}
+	var nextCursor string
+	if len(edges) > limit {
+		edges = edges[:limit]
+		nextCursor = encodeFollowCursor(edges[len(edges)-1])
+	}
+
+	return i.presenter.Output(edges, nextCursor), nil
+}
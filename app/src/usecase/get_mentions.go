@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+)
+
+type GetMentionsInput struct {
+	Token  string
+	Cursor string
+	Limit  int
+}
+
+type GetMentionsResponse struct {
+	Mentions   []MentionPayload `json:"mentions"`
+	NextCursor *string          `json:"next_cursor"`
+}
+
+// MentionPayload は自分をメンションしている投稿1件分です。
+type MentionPayload struct {
+	PostID       int                   `json:"post_id"`
+	SpotID       int                   `json:"spot_id"`
+	SpotName     string                `json:"spot_name"`
+	UserID       int                   `json:"user_id"`
+	UserName     string                `json:"user_name"`
+	ImageURL     *string               `json:"image_url"`
+	Photos       []PostPhotoPayload    `json:"photos"`
+	Reactions    ReactionCountsPayload `json:"reactions"`
+	CommentCount int                   `json:"comment_count"`
+	Caption      string                `json:"caption"`
+	PostedAt     string                `json:"posted_at"`
+}
+
+type GetMentionsPresenter interface {
+	Output(mentions []entities.Mention, nextCursor string) *GetMentionsResponse
+}
+
+type GetMentionsUseCase interface {
+	Execute(ctx context.Context, input GetMentionsInput) (*GetMentionsResponse, error)
+}
+
+type getMentionsInteractor struct {
+	presenter   GetMentionsPresenter
+	mentionRepo entities.MentionRepository
+	authService services.AuthDomainService
+}
+
+func NewGetMentionsInteractor(
+	p GetMentionsPresenter,
+	m entities.MentionRepository,
+	a services.AuthDomainService,
+) GetMentionsUseCase {
+	return &getMentionsInteractor{
+		presenter:   p,
+		mentionRepo: m,
+		authService: a,
+	}
+}
+
+func (i *getMentionsInteractor) Execute(ctx context.Context, input GetMentionsInput) (*GetMentionsResponse, error) {
+	user, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	before, err := decodePostCursor(input.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	limit := normalizePageLimit(input.Limit)
+
+	// 自分をメンションしている現行の投稿（新しい順）。次ページの有無を判定するため 1 件多く取得する。
+	mentions, err := i.mentionRepo.FindByMentionedUser(ctx, user.ID, before, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("mention lookup error: %w", err)
+	}
+	var nextCursor string
+	if len(mentions) > limit {
+		mentions = mentions[:limit]
+		nextCursor = encodePostCursor(mentions[len(mentions)-1].Post)
+	}
+
+	return i.presenter.Output(mentions, nextCursor), nil
+}
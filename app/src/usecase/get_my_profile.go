@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+)
+
+type GetMyProfileInput struct {
+	Token string
+}
+
+// UserProfileResponse は自分のプロフィールです（GET / PATCH /v1/users/me 共通）。
+type UserProfileResponse struct {
+	ID          int                `json:"id"`
+	Username    string             `json:"username"`
+	Email       string             `json:"email"`
+	DisplayName string             `json:"display_name"`
+	Bio         string             `json:"bio"`
+	HomeArea    string             `json:"home_area"`
+	Avatar      *UserAvatarPayload `json:"avatar"`
+	CreatedAt   string             `json:"created_at"`
+	// Token はユーザー名を変更した場合にのみ返す、新しいユーザー名を埋め込んだ認証トークンです。
+	Token *string `json:"token,omitempty"`
+}
+
+// UserAvatarPayload はアイコン画像です。thumb_url は生成できない形式では null です。
+type UserAvatarPayload struct {
+	ImageID  int     `json:"image_id"`
+	URL      string  `json:"url"`
+	ThumbURL *string `json:"thumb_url"`
+}
+
+type UserProfilePresenter interface {
+	Output(user *entities.User) *UserProfileResponse
+}
+
+type GetMyProfileUseCase interface {
+	Execute(ctx context.Context, input GetMyProfileInput) (*UserProfileResponse, error)
+}
+
+type getMyProfileInteractor struct {
+	presenter   UserProfilePresenter
+	userRepo    entities.UserRepository
+	authService services.AuthDomainService
+}
+
+func NewGetMyProfileInteractor(
+	p UserProfilePresenter,
+	u entities.UserRepository,
+	a services.AuthDomainService,
+) GetMyProfileUseCase {
+	return &getMyProfileInteractor{
+		presenter:   p,
+		userRepo:    u,
+		authService: a,
+	}
+}
+
+func (i *getMyProfileInteractor) Execute(ctx context.Context, input GetMyProfileInput) (*UserProfileResponse, error) {
+	tokenUser, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	// トークンには ID とユーザー名しか含まれないため、プロフィールは DB から読み直す
+	user, err := i.userRepo.FindByID(tokenUser.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	return i.presenter.Output(user), nil
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+// CommentRepliesPreview はコメント一覧で各コメントに添える返信の件数です。続きは GET /v1/comments/:id/replies で取得します。
+const CommentRepliesPreview = 3
+
+type GetPostCommentsInput struct {
+	Token  string
+	PostID int
+	Cursor string
+	Limit  int
+}
+
+type CommentListResponse struct {
+	Comments   []CommentPayload `json:"comments"`
+	NextCursor *string          `json:"next_cursor"`
+}
+
+// CommentListPresenter はコメント一覧（トップレベル・返信）を整形します。
+type CommentListPresenter interface {
+	Output(comments []*entities.Comment, nextCursor string) *CommentListResponse
+}
+
+type GetPostCommentsUseCase interface {
+	Execute(ctx context.Context, input GetPostCommentsInput) (*CommentListResponse, error)
+}
+
+type getPostCommentsInteractor struct {
+	presenter   CommentListPresenter
+	postRepo    entities.PostRepository
+	commentRepo entities.CommentRepository
+	authService services.AuthDomainService
+}
+
+func NewGetPostCommentsInteractor(
+	p CommentListPresenter,
+	r entities.PostRepository,
+	c entities.CommentRepository,
+	a services.AuthDomainService,
+) GetPostCommentsUseCase {
+	return &getPostCommentsInteractor{
+		presenter:   p,
+		postRepo:    r,
+		commentRepo: c,
+		authService: a,
+	}
+}
+
+func (i *getPostCommentsInteractor) Execute(ctx context.Context, input GetPostCommentsInput) (*CommentListResponse, error) {
+	viewer, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	postID, err := value_objects.NewID(input.PostID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	after, err := decodeCommentCursor(input.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	limit := normalizePageLimit(input.Limit)
+
+	post, err := i.postRepo.FindByID(postID, viewer.ID)
+	if err != nil {
+		return nil, fmt.Errorf("post lookup error: %w", err)
+	}
+	if post == nil {
+		return nil, fmt.Errorf("%w: post %d", ErrNotFound, postID.Value())
+	}
+
+	// トップレベルのコメント（古い順）と先頭の返信。次ページの有無を判定するため 1 件多く取得する。
+	comments, err := i.commentRepo.FindByPost(ctx, post.ID, viewer.ID, after, limit+1, CommentRepliesPreview)
+	if err != nil {
+		return nil, fmt.Errorf("comment lookup error: %w", err)
+	}
+	var nextCursor string
+	if len(comments) > limit {
+		comments = comments[:limit]
+		nextCursor = encodeCommentCursor(comments[len(comments)-1])
+	}
+
+	return i.presenter.Output(comments, nextCursor), nil
+}
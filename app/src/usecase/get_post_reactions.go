@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+type GetPostReactionsInput struct {
+	Token  string
+	PostID int
+	// Kind を指定するとその種類のリアクションだけを返す（空文字なら全種類）。
+	Kind   string
+	Cursor string
+	Limit  int
+}
+
+type GetPostReactionsResponse struct {
+	PostID     int                   `json:"post_id"`
+	Reactions  ReactionCountsPayload `json:"reactions"`
+	Users      []ReactionUserPayload `json:"users"`
+	NextCursor *string               `json:"next_cursor"`
+}
+
+type ReactionUserPayload struct {
+	UserID    int    `json:"user_id"`
+	UserName  string `json:"user_name"`
+	Kind      string `json:"kind"`
+	ReactedAt string `json:"reacted_at"`
+}
+
+type GetPostReactionsPresenter interface {
+	Output(post *entities.Post, counts entities.ReactionCounts, reactions []*entities.Reaction, nextCursor string) *GetPostReactionsResponse
+}
+
+type GetPostReactionsUseCase interface {
+	Execute(ctx context.Context, input GetPostReactionsInput) (*GetPostReactionsResponse, error)
+}
+
+type getPostReactionsInteractor struct {
+	presenter    GetPostReactionsPresenter
+	postRepo     entities.PostRepository
+	reactionRepo entities.ReactionRepository
+	authService  services.AuthDomainService
+}
+
+func NewGetPostReactionsInteractor(
+	p GetPostReactionsPresenter,
+	r entities.PostRepository,
+	rr entities.ReactionRepository,
+	a services.AuthDomainService,
+) GetPostReactionsUseCase {
+	return &getPostReactionsInteractor{
+		presenter:    p,
+		postRepo:     r,
+		reactionRepo: rr,
+		authService:  a,
+	}
+}
+
+func (i *getPostReactionsInteractor) Execute(ctx context.Context, input GetPostReactionsInput) (*GetPostReactionsResponse, error) {
+	viewer, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	postID, err := value_objects.NewID(input.PostID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	var kind value_objects.ReactionKind
+	if input.Kind != "" {
+		if kind, err = value_objects.NewReactionKind(input.Kind); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+	}
+	before, err := decodeReactionCursor(input.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	limit := normalizePageLimit(input.Limit)
+
+	post, err := i.postRepo.FindByID(postID, viewer.ID)
+	if err != nil {
+		return nil, fmt.Errorf("post lookup error: %w", err)
+	}
+	if post == nil {
+		return nil, fmt.Errorf("%w: post %d", ErrNotFound, postID.Value())
+	}
+
+	// リアクションした人（新しい順）。次ページの有無を判定するため 1 件多く取得する。
+	reactions, err := i.reactionRepo.FindByPost(ctx, post.ID, kind, before, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("reaction lookup error: %w", err)
+	}
+	var nextCursor string
+	if len(reactions) > limit {
+		reactions = reactions[:limit]
+		nextCursor = encodeReactionCursor(reactions[len(reactions)-1])
+	}
+
+	counts, err := i.reactionRepo.CountByPost(ctx, post.ID)
+	if err != nil {
+		return nil, fmt.Errorf("reaction count error: %w", err)
+	}
+
+	return i.presenter.Output(post, counts, reactions, nextCursor), nil
+}
@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+// GetPublicProfileInput の Token は任意です。指定された場合は閲覧者との相性（compatibility）も返します。
+type GetPublicProfileInput struct {
+	Token    string
+	Username string
+}
+
+type GetPublicProfileResponse struct {
+	User        PublicUserPayload   `json:"user"`
+	SpotCount   int                 `json:"spot_count"`
+	ThroneCount int                 `json:"throne_count"`
+	Spots       []PublicSpotPayload `json:"spots"`
+	// Compatibility は認証済みの閲覧者が自分以外のプロフィールを見た場合のみ返します。
+	Compatibility *CompatibilityPayload `json:"compatibility"`
+}
+
+// PublicUserPayload は公開プロフィールです（メールアドレスは含めない）。
+type PublicUserPayload struct {
+	ID          int                `json:"id"`
+	Username    string             `json:"username"`
+	DisplayName string             `json:"display_name"`
+	Bio         string             `json:"bio"`
+	HomeArea    string             `json:"home_area"`
+	Avatar      *UserAvatarPayload `json:"avatar"`
+	JoinedAt    string             `json:"joined_at"`
+}
+
+// PublicSpotPayload はユーザーが現在ピンしているスポットと、そこでの本人の現行の投稿です。
+type PublicSpotPayload struct {
+	Spot     UserSpotPayload `json:"spot"`
+	Post     UserPostPayload `json:"post"`
+	IsThrone bool            `json:"is_throne"`
+}
+
+// CompatibilityPayload は閲覧者とプロフィールのユーザーの相性です。
+// score は互いの現行の投稿先スポット集合の Jaccard 係数（共通スポット数 / いずれかがピンしているスポット数、0〜1）です。
+type CompatibilityPayload struct {
+	MatchCount  int               `json:"match_count"`
+	Score       float64           `json:"score"`
+	SharedSpots []UserSpotPayload `json:"shared_spots"`
+}
+
+// PublicSpotDomainItem はユーザーのスポット1件と、そこでの本人の現行の投稿です。
+type PublicSpotDomainItem struct {
+	Spot     *entities.Spot
+	Post     *entities.Post
+	IsThrone bool
+}
+
+// CompatibilityDomainItem は閲覧者と共通してピンしているスポットと、その類似度です。
+type CompatibilityDomainItem struct {
+	SharedSpots []*entities.Spot
+	Score       float64
+}
+
+type PublicProfileDomainItem struct {
+	User          *entities.User
+	Spots         []PublicSpotDomainItem
+	ThroneCount   int
+	Compatibility *CompatibilityDomainItem
+}
+
+type GetPublicProfilePresenter interface {
+	Output(item PublicProfileDomainItem) *GetPublicProfileResponse
+}
+
+type GetPublicProfileUseCase interface {
+	Execute(ctx context.Context, input GetPublicProfileInput) (*GetPublicProfileResponse, error)
+}
+
+type getPublicProfileInteractor struct {
+	presenter    GetPublicProfilePresenter
+	userRepo     entities.UserRepository
+	spotRepo     entities.SpotRepository
+	postRepo     entities.PostRepository
+	restrictRepo entities.UserRestrictionRepository
+	authService  services.AuthDomainService
+}
+
+func NewGetPublicProfileInteractor(
+	p GetPublicProfilePresenter,
+	u entities.UserRepository,
+	s entities.SpotRepository,
+	r entities.PostRepository,
+	b entities.UserRestrictionRepository,
+	a services.AuthDomainService,
+) GetPublicProfileUseCase {
+	return &getPublicProfileInteractor{
+		presenter:    p,
+		userRepo:     u,
+		spotRepo:     s,
+		postRepo:     r,
+		restrictRepo: b,
+		authService:  a,
+	}
+}
+
+func (i *getPublicProfileInteractor) Execute(ctx context.Context, input GetPublicProfileInput) (*GetPublicProfileResponse, error) {
+	// 1. 閲覧者の認証（任意）。トークンが送られた場合は不正なら拒否する
+	var viewer *entities.User
+	if input.Token != "" {
+		user, err := i.authService.VerifyToken(ctx, input.Token)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+		}
+		viewer = user
+	}
+
+	// 2. プロフィールのユーザー
+	user, err := i.userRepo.FindByUsername(ctx, input.Username)
+	if err != nil {
+		return nil, fmt.Errorf("user lookup error: %w", err)
+	}
+	// 退会を申請したユーザーは、猶予期間中も公開プロフィールを出さない
+	if user == nil || user.IsDeletionRequested() {
+		return nil, fmt.Errorf("%w: user %q", ErrNotFound, input.Username)
+	}
+	// どちらかがブロックしている相手のプロフィールは、互いに存在しないものとして扱う
+	if err := ensureNotBlocked(ctx, i.restrictRepo, viewer, user); err != nil {
+		return nil, err
+	}
+
+	// 3. 閲覧者に見える現行の投稿先スポット（新しい順）と、王座を持っているスポット
+	var viewerID value_objects.ID
+	if viewer != nil {
+		viewerID = viewer.ID
+	}
+	thrones, err := i.spotRepo.FindByRegisteredUser(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("spot lookup error: %w", err)
+	}
+	throneIDs := make(map[int]bool, len(thrones))
+	for _, spot := range thrones {
+		throneIDs[spot.ID.Value()] = true
+	}
+
+	posts, err := i.postRepo.FindByUserID(user.ID, viewerID)
+	if err != nil {
+		return nil, fmt.Errorf("post lookup error: %w", err)
+	}
+	spotIDs := make([]value_objects.ID, 0, len(posts))
+	for _, post := range posts {
+		spotIDs = append(spotIDs, post.SpotID)
+	}
+	spotsByID, err := i.spotRepo.FindByIDs(ctx, spotIDs)
+	if err != nil {
+		return nil, fmt.Errorf("spot lookup error: %w", err)
+	}
+	spots := make([]PublicSpotDomainItem, 0, len(posts))
+	throneCount := 0
+	for _, post := range posts {
+		spot, ok := spotsByID[post.SpotID.Value()]
+		if !ok {
+			continue
+		}
+		if throneIDs[spot.ID.Value()] {
+			throneCount++
+		}
+		spots = append(spots, PublicSpotDomainItem{
+			Spot:     spot,
+			Post:     post,
+			IsThrone: throneIDs[spot.ID.Value()],
+		})
+	}
+
+	item := PublicProfileDomainItem{
+		User:        user,
+		Spots:       spots,
+		ThroneCount: throneCount,
+	}
+
+	// 4. 閲覧者との相性（共鳴者の判定と同じく、互いの現行の投稿先スポットの一致で数える）
+	if viewer != nil && viewer.ID != user.ID {
+		viewerPosts, err := i.postRepo.FindByUserID(viewer.ID, viewer.ID)
+		if err != nil {
+			return nil, fmt.Errorf("post lookup error: %w", err)
+		}
+		item.Compatibility = compatibilityOf(viewerPosts, spots)
+	}
+
+	return i.presenter.Output(item), nil
+}
+
+// compatibilityOf は閲覧者の現行の投稿先とユーザーのスポット一覧から、共通スポットと Jaccard 係数を求めます。
+func compatibilityOf(viewerPosts []*entities.Post, spots []PublicSpotDomainItem) *CompatibilityDomainItem {
+	viewerSpots := make(map[value_objects.ID]bool, len(viewerPosts))
+	for _, post := range viewerPosts {
+		viewerSpots[post.SpotID] = true
+	}
+
+	compat := &CompatibilityDomainItem{SharedSpots: []*entities.Spot{}}
+	userSpots := make(map[value_objects.ID]bool, len(spots))
+	for _, s := range spots {
+		if userSpots[s.Spot.ID] {
+			continue
+		}
+		userSpots[s.Spot.ID] = true
+		if viewerSpots[s.Spot.ID] {
+			compat.SharedSpots = append(compat.SharedSpots, s.Spot)
+		}
+	}
+
+	union := len(viewerSpots) + len(userSpots) - len(compat.SharedSpots)
+	if union > 0 {
+		compat.Score = float64(len(compat.SharedSpots)) / float64(union)
+	}
+	return compat
+}
@@ -0,0 +1,194 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetPublicProfileMockPresenter struct{}
+
+func (p *GetPublicProfileMockPresenter) Output(item usecase.PublicProfileDomainItem) *usecase.GetPublicProfileResponse {
+	out := &usecase.GetPublicProfileResponse{
+		User:        usecase.PublicUserPayload{ID: item.User.ID.Value(), Username: item.User.Username.String()},
+		SpotCount:   len(item.Spots),
+		ThroneCount: item.ThroneCount,
+		Spots:       []usecase.PublicSpotPayload{},
+	}
+	for _, s := range item.Spots {
+		out.Spots = append(out.Spots, usecase.PublicSpotPayload{
+			Spot:     usecase.UserSpotPayload{ID: s.Spot.ID.Value()},
+			Post:     usecase.UserPostPayload{ID: s.Post.ID.Value()},
+			IsThrone: s.IsThrone,
+		})
+	}
+	if item.Compatibility != nil {
+		out.Compatibility = &usecase.CompatibilityPayload{MatchCount: len(item.Compatibility.SharedSpots), Score: item.Compatibility.Score}
+		for _, spot := range item.Compatibility.SharedSpots {
+			out.Compatibility.SharedSpots = append(out.Compatibility.SharedSpots, usecase.UserSpotPayload{ID: spot.ID.Value()})
+		}
+	}
+	return out
+}
+
+func TestGetPublicProfile_Execute(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	taro, _ := entities.NewUser(3, "taro", "taro@example.com", "hashed_password")
+	jiro, _ := entities.NewSpot(1, "ラーメン二郎 三田本店", 35.6467, 139.7101, 3)
+	curry, _ := entities.NewSpot(4, "カレーの店", 35.6470, 139.7110, 2)
+	udon, _ := entities.NewSpot(5, "うどん屋", 35.6480, 139.7120, 2)
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	taroJiro, _ := entities.NewPost(10, 3, 1, "taro", "", "また来た", now)
+	taroCurry, _ := entities.NewPost(11, 3, 4, "taro", "", "辛い", now.Add(-time.Hour))
+	malloyJiro, _ := entities.NewPost(20, 2, 1, "local_malloy", "", "", now)
+	malloyUdon, _ := entities.NewPost(21, 2, udon.ID.Value(), "local_malloy", "", "", now)
+
+	// taro のプロフィールに共通するモック
+	setupProfile := func(um *MockUserRepository, sm *MockSpotRepository, pm *MockPostRepository, viewerID value_objects.ID) {
+		um.On("FindByUsername", mock.Anything, "taro").Return(taro, nil)
+		sm.On("FindByRegisteredUser", mock.Anything, taro.ID).Return([]*entities.Spot{jiro}, nil)
+		pm.On("FindByUserID", taro.ID, viewerID).Return([]*entities.Post{taroJiro, taroCurry}, nil)
+		sm.On("FindByIDs", mock.Anything, []value_objects.ID{jiro.ID, curry.ID}).
+			Return(map[int]*entities.Spot{jiro.ID.Value(): jiro, curry.ID.Value(): curry}, nil)
+	}
+
+	tests := []struct {
+		name      string
+		input     usecase.GetPublicProfileInput
+		setupMock func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository, pm *MockPostRepository, bm *MockUserRestrictionRepository)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.GetPublicProfileResponse)
+	}{
+		{
+			name:  "【正常系】未認証ならスポット一覧と王座数のみを返す",
+			input: usecase.GetPublicProfileInput{Username: "taro"},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository, pm *MockPostRepository, bm *MockUserRestrictionRepository) {
+				setupProfile(um, sm, pm, value_objects.ID(0))
+			},
+			check: func(t *testing.T, out *usecase.GetPublicProfileResponse) {
+				assert.Equal(t, 2, out.SpotCount)
+				assert.Equal(t, 1, out.ThroneCount)
+				if assert.Len(t, out.Spots, 2) {
+					assert.True(t, out.Spots[0].IsThrone)
+					assert.False(t, out.Spots[1].IsThrone)
+				}
+				assert.Nil(t, out.Compatibility)
+			},
+		},
+		{
+			name:  "【正常系】認証済みなら共通スポットと Jaccard 係数を返す",
+			input: usecase.GetPublicProfileInput{Token: "valid_token", Username: "taro"},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository, pm *MockPostRepository, bm *MockUserRestrictionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				setupProfile(um, sm, pm, malloy.ID)
+				bm.On("IsBlockedBetween", mock.Anything, malloy.ID, taro.ID).Return(false, nil)
+				pm.On("FindByUserID", malloy.ID, malloy.ID).Return([]*entities.Post{malloyJiro, malloyUdon}, nil)
+			},
+			check: func(t *testing.T, out *usecase.GetPublicProfileResponse) {
+				if assert.NotNil(t, out.Compatibility) {
+					// 共通 {二郎} / 和集合 {二郎, カレー, うどん}
+					assert.Equal(t, 1, out.Compatibility.MatchCount)
+					assert.InDelta(t, 1.0/3.0, out.Compatibility.Score, 1e-9)
+					assert.Equal(t, jiro.ID.Value(), out.Compatibility.SharedSpots[0].ID)
+				}
+			},
+		},
+		{
+			name:  "【正常系】自分のプロフィールには相性を付けない",
+			input: usecase.GetPublicProfileInput{Token: "valid_token", Username: "taro"},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository, pm *MockPostRepository, bm *MockUserRestrictionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(taro, nil)
+				setupProfile(um, sm, pm, taro.ID)
+			},
+			check: func(t *testing.T, out *usecase.GetPublicProfileResponse) {
+				assert.Nil(t, out.Compatibility)
+			},
+		},
+		{
+			name:  "【異常系】ブロックの関係にある相手のプロフィールは NotFound",
+			input: usecase.GetPublicProfileInput{Token: "valid_token", Username: "taro"},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository, pm *MockPostRepository, bm *MockUserRestrictionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				um.On("FindByUsername", mock.Anything, "taro").Return(taro, nil)
+				bm.On("IsBlockedBetween", mock.Anything, malloy.ID, taro.ID).Return(true, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrNotFound,
+		},
+		{
+			name:  "【異常系】退会を申請したユーザーのプロフィールは NotFound",
+			input: usecase.GetPublicProfileInput{Username: "leaving"},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository, pm *MockPostRepository, bm *MockUserRestrictionRepository) {
+				leaving, _ := entities.NewUser(4, "leaving", "leaving@example.com", "hashed_password")
+				leaving.DeletionRequestedAt = &now
+				um.On("FindByUsername", mock.Anything, "leaving").Return(leaving, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrNotFound,
+		},
+		{
+			name:  "【異常系】存在しないユーザー名は NotFound",
+			input: usecase.GetPublicProfileInput{Username: "nobody"},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository, pm *MockPostRepository, bm *MockUserRestrictionRepository) {
+				um.On("FindByUsername", mock.Anything, "nobody").Return(nil, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrNotFound,
+		},
+		{
+			name:  "【異常系】DBエラーが発生した場合",
+			input: usecase.GetPublicProfileInput{Username: "taro"},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository, pm *MockPostRepository, bm *MockUserRestrictionRepository) {
+				um.On("FindByUsername", mock.Anything, "taro").Return(taro, nil)
+				sm.On("FindByRegisteredUser", mock.Anything, taro.ID).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "【異常系】送られたトークンが不正な場合は認証エラー",
+			input: usecase.GetPublicProfileInput{Token: "bad_token", Username: "taro"},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository, pm *MockPostRepository, bm *MockUserRestrictionRepository) {
+				am.On("VerifyToken", mock.Anything, "bad_token").Return((*entities.User)(nil), errors.New("invalid token"))
+			},
+			wantErr: true,
+			errIs:   usecase.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, um, sm, pm, bm := new(MockAuthService), new(MockUserRepository), new(MockSpotRepository), new(MockPostRepository), new(MockUserRestrictionRepository)
+			tt.setupMock(am, um, sm, pm, bm)
+			interactor := usecase.NewGetPublicProfileInteractor(&GetPublicProfileMockPresenter{}, um, sm, pm, bm, am)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			am.AssertExpectations(t)
+			um.AssertExpectations(t)
+			sm.AssertExpectations(t)
+			pm.AssertExpectations(t)
+			bm.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+)
+
+// resonanceSharedSpotsPerUser は共鳴者1人あたりに返す共通スポットの上限です（件数は match_count で分かる）。
+const resonanceSharedSpotsPerUser = 20
+
+type GetResonanceCircleInput struct {
+	Token string
+	// Sort は "match_count"（既定）または "recent" です。
+	Sort   string
+	Cursor string
+	Limit  int
+}
+
+type GetResonanceCircleResponse struct {
+	Sort       string                `json:"sort"`
+	Users      []ResonantPeerPayload `json:"users"`
+	NextCursor *string               `json:"next_cursor"`
+}
+
+// ResonantPeerPayload は共鳴者1人分です。shared_spots は直近に共通した順で、最大 20 件です。
+type ResonantPeerPayload struct {
+	User         PublicUserSummaryPayload `json:"user"`
+	MatchCount   int                      `json:"match_count"`
+	LastSharedAt string                   `json:"last_shared_at"`
+	SharedSpots  []UserSpotPayload        `json:"shared_spots"`
+}
+
+// PublicUserSummaryPayload は一覧に載せる公開プロフィールの要約です。
+type PublicUserSummaryPayload struct {
+	ID          int                `json:"id"`
+	Username    string             `json:"username"`
+	DisplayName string             `json:"display_name"`
+	Avatar      *UserAvatarPayload `json:"avatar"`
+}
+
+type GetResonanceCirclePresenter interface {
+	Output(sort entities.ResonanceSort, peers []entities.ResonantPeer, nextCursor string) *GetResonanceCircleResponse
+}
+
+type GetResonanceCircleUseCase interface {
+	Execute(ctx context.Context, input GetResonanceCircleInput) (*GetResonanceCircleResponse, error)
+}
+
+type getResonanceCircleInteractor struct {
+	presenter     GetResonanceCirclePresenter
+	resonanceRepo entities.ResonanceRepository
+	authService   services.AuthDomainService
+}
+
+func NewGetResonanceCircleInteractor(
+	p GetResonanceCirclePresenter,
+	r entities.ResonanceRepository,
+	a services.AuthDomainService,
+) GetResonanceCircleUseCase {
+	return &getResonanceCircleInteractor{
+		presenter:     p,
+		resonanceRepo: r,
+		authService:   a,
+	}
+}
+
+func (i *getResonanceCircleInteractor) Execute(ctx context.Context, input GetResonanceCircleInput) (*GetResonanceCircleResponse, error) {
+	user, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	sort := entities.ResonanceSortMatchCount
+	switch entities.ResonanceSort(input.Sort) {
+	case "", entities.ResonanceSortMatchCount:
+	case entities.ResonanceSortRecent:
+		sort = entities.ResonanceSortRecent
+	default:
+		return nil, fmt.Errorf("%w: unknown sort %q", ErrInvalidInput, input.Sort)
+	}
+
+	after, err := decodeResonanceCursor(input.Cursor, sort)
+	if err != nil {
+		return nil, err
+	}
+	limit := normalizePageLimit(input.Limit)
+
+	// 次ページの有無を判定するため 1 件多く取得する。
+	peers, err := i.resonanceRepo.FindResonantPeers(ctx, entities.ResonanceCriteria{
+		UserID:             user.ID,
+		Sort:               sort,
+		After:              after,
+		Limit:              limit + 1,
+		SharedSpotsPerUser: resonanceSharedSpotsPerUser,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("resonance lookup error: %w", err)
+	}
+	var nextCursor string
+	if len(peers) > limit {
+		peers = peers[:limit]
+		nextCursor = encodeResonanceCursor(peers[len(peers)-1], sort)
+	}
+
+	return i.presenter.Output(sort, peers, nextCursor), nil
+}
@@ -0,0 +1,194 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockResonanceRepository struct {
+	mock.Mock
+}
+
+func (m *MockResonanceRepository) FindResonantPeers(ctx context.Context, criteria entities.ResonanceCriteria) ([]entities.ResonantPeer, error) {
+	args := m.Called(ctx, criteria)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.ResonantPeer), args.Error(1)
+}
+
+type GetResonanceCircleMockPresenter struct{}
+
+func (p *GetResonanceCircleMockPresenter) Output(sort entities.ResonanceSort, peers []entities.ResonantPeer, nextCursor string) *usecase.GetResonanceCircleResponse {
+	out := &usecase.GetResonanceCircleResponse{Sort: string(sort), Users: []usecase.ResonantPeerPayload{}}
+	for _, peer := range peers {
+		out.Users = append(out.Users, usecase.ResonantPeerPayload{
+			User:       usecase.PublicUserSummaryPayload{ID: peer.User.ID.Value()},
+			MatchCount: peer.MatchCount,
+		})
+	}
+	if nextCursor != "" {
+		out.NextCursor = &nextCursor
+	}
+	return out
+}
+
+func TestGetResonanceCircle_Execute(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	taro, _ := entities.NewUser(3, "taro", "taro@example.com", "hashed_password")
+	hanako, _ := entities.NewUser(4, "hanako", "hanako@example.com", "hashed_password")
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	peers := []entities.ResonantPeer{
+		{User: taro, MatchCount: 5, LastSharedAt: now},
+		{User: hanako, MatchCount: 2, LastSharedAt: now.Add(-time.Hour)},
+	}
+
+	tests := []struct {
+		name      string
+		input     usecase.GetResonanceCircleInput
+		setupMock func(am *MockAuthService, rm *MockResonanceRepository)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.GetResonanceCircleResponse)
+	}{
+		{
+			name:  "【正常系】既定では共通スポット数の多い順で、共通スポットを添えて取得する",
+			input: usecase.GetResonanceCircleInput{Token: "valid_token"},
+			setupMock: func(am *MockAuthService, rm *MockResonanceRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				rm.On("FindResonantPeers", mock.Anything, mock.MatchedBy(func(c entities.ResonanceCriteria) bool {
+					return c.UserID == malloy.ID && c.Sort == entities.ResonanceSortMatchCount &&
+						c.After == nil && c.Limit == 21 && c.SharedSpotsPerUser > 0
+				})).Return(peers, nil)
+			},
+			check: func(t *testing.T, out *usecase.GetResonanceCircleResponse) {
+				assert.Equal(t, "match_count", out.Sort)
+				assert.Len(t, out.Users, 2)
+				assert.Nil(t, out.NextCursor)
+			},
+		},
+		{
+			name:  "【正常系】直近順を指定できる",
+			input: usecase.GetResonanceCircleInput{Token: "valid_token", Sort: "recent"},
+			setupMock: func(am *MockAuthService, rm *MockResonanceRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				rm.On("FindResonantPeers", mock.Anything, mock.MatchedBy(func(c entities.ResonanceCriteria) bool {
+					return c.Sort == entities.ResonanceSortRecent
+				})).Return(peers[:1], nil)
+			},
+			check: func(t *testing.T, out *usecase.GetResonanceCircleResponse) {
+				assert.Equal(t, "recent", out.Sort)
+			},
+		},
+		{
+			name:  "【異常系】未知の並び順は入力エラー",
+			input: usecase.GetResonanceCircleInput{Token: "valid_token", Sort: "popular"},
+			setupMock: func(am *MockAuthService, rm *MockResonanceRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】不正なカーソルは入力エラー",
+			input: usecase.GetResonanceCircleInput{Token: "valid_token", Cursor: "!!"},
+			setupMock: func(am *MockAuthService, rm *MockResonanceRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】DBエラーが発生した場合",
+			input: usecase.GetResonanceCircleInput{Token: "valid_token"},
+			setupMock: func(am *MockAuthService, rm *MockResonanceRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				rm.On("FindResonantPeers", mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "【異常系】トークンが不正な場合は認証エラー",
+			input: usecase.GetResonanceCircleInput{Token: "bad_token"},
+			setupMock: func(am *MockAuthService, rm *MockResonanceRepository) {
+				am.On("VerifyToken", mock.Anything, "bad_token").Return((*entities.User)(nil), errors.New("invalid token"))
+			},
+			wantErr: true,
+			errIs:   usecase.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, rm := new(MockAuthService), new(MockResonanceRepository)
+			tt.setupMock(am, rm)
+			interactor := usecase.NewGetResonanceCircleInteractor(&GetResonanceCircleMockPresenter{}, rm, am)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			am.AssertExpectations(t)
+			rm.AssertExpectations(t)
+		})
+	}
+}
+
+// 次ページのカーソルは、並び順ごとの基準点（共通スポット数・直近に共通した時刻・ユーザーID）を復元できること
+func TestGetResonanceCircle_CursorRoundTrip(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	taro, _ := entities.NewUser(3, "taro", "taro@example.com", "hashed_password")
+	hanako, _ := entities.NewUser(4, "hanako", "hanako@example.com", "hashed_password")
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	peers := []entities.ResonantPeer{
+		{User: taro, MatchCount: 5, LastSharedAt: now},
+		{User: hanako, MatchCount: 2, LastSharedAt: now.Add(-time.Hour)},
+	}
+
+	for _, sort := range []string{"match_count", "recent"} {
+		t.Run(sort, func(t *testing.T) {
+			am, rm := new(MockAuthService), new(MockResonanceRepository)
+			am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			rm.On("FindResonantPeers", mock.Anything, mock.MatchedBy(func(c entities.ResonanceCriteria) bool { return c.After == nil })).
+				Return(peers, nil)
+			interactor := usecase.NewGetResonanceCircleInteractor(&GetResonanceCircleMockPresenter{}, rm, am)
+
+			first, err := interactor.Execute(context.Background(), usecase.GetResonanceCircleInput{Token: "valid_token", Sort: sort, Limit: 1})
+			assert.NoError(t, err)
+			if !assert.NotNil(t, first.NextCursor) {
+				return
+			}
+
+			rm.On("FindResonantPeers", mock.Anything, mock.MatchedBy(func(c entities.ResonanceCriteria) bool {
+				if c.After == nil || c.After.UserID != taro.ID || !c.After.LastSharedAt.Equal(now) {
+					return false
+				}
+				return sort == "recent" || c.After.MatchCount == 5
+			})).Return(peers[1:], nil)
+
+			second, err := interactor.Execute(context.Background(), usecase.GetResonanceCircleInput{Token: "valid_token", Sort: sort, Cursor: *first.NextCursor, Limit: 1})
+			assert.NoError(t, err)
+			assert.Len(t, second.Users, 1)
+			assert.Nil(t, second.NextCursor)
+			rm.AssertExpectations(t)
+		})
+	}
+}
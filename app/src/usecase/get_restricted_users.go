@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+)
+
+type GetRestrictedUsersInput struct {
+	Token string
+	// Kind は block（ブロック中）または mute（ミュート中）です。
+	Kind string
+}
+
+type GetRestrictedUsersResponse struct {
+	Kind  string                  `json:"kind"`
+	Users []RestrictedUserPayload `json:"users"`
+}
+
+type RestrictedUserPayload struct {
+	User         PublicUserSummaryPayload `json:"user"`
+	RestrictedAt string                   `json:"restricted_at"`
+}
+
+type GetRestrictedUsersPresenter interface {
+	Output(kind entities.UserRestrictionKind, users []entities.RestrictedUser) *GetRestrictedUsersResponse
+}
+
+type GetRestrictedUsersUseCase interface {
+	Execute(ctx context.Context, input GetRestrictedUsersInput) (*GetRestrictedUsersResponse, error)
+}
+
+type getRestrictedUsersInteractor struct {
+	presenter       GetRestrictedUsersPresenter
+	restrictionRepo entities.UserRestrictionRepository
+	authService     services.AuthDomainService
+}
+
+func NewGetRestrictedUsersInteractor(
+	p GetRestrictedUsersPresenter,
+	r entities.UserRestrictionRepository,
+	a services.AuthDomainService,
+) GetRestrictedUsersUseCase {
+	return &getRestrictedUsersInteractor{
+		presenter:       p,
+		restrictionRepo: r,
+		authService:     a,
+	}
+}
+
+func (i *getRestrictedUsersInteractor) Execute(ctx context.Context, input GetRestrictedUsersInput) (*GetRestrictedUsersResponse, error) {
+	user, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	kind, err := parseUserRestrictionKind(input.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := i.restrictionRepo.FindByUser(ctx, kind, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("restriction lookup error: %w", err)
+	}
+	return i.presenter.Output(kind, users), nil
+}
@@ -0,0 +1,204 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+type GetSpotDetailInput struct {
+	Token  string
+	SpotID int
+	Cursor string
+	Limit  int
+}
+
+type GetSpotDetailResponse struct {
+	Spot       SpotDetailPayload        `json:"spot"`
+	Mesh       SpotDetailMeshPayload    `json:"mesh"`
+	Throne     *SpotDetailThronePayload `json:"throne"`
+	Posts      []SpotDetailPostPayload  `json:"posts"`
+	NextCursor *string                  `json:"next_cursor"`
+}
+
+type SpotDetailPayload struct {
+	ID         int                   `json:"id"`
+	Name       string                `json:"name"`
+	MeshID     string                `json:"mesh_id"`
+	Location   SpotDetailLocation    `json:"location"`
+	Attributes SpotAttributesPayload `json:"attributes"`
+	Rating     *SpotRatingPayload    `json:"rating"`
+	// Status は active / reported / closed のいずれか（閉店済みでも詳細と投稿は閲覧できる）
+	Status string `json:"status"`
+}
+
+type SpotDetailLocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+type SpotDetailMeshPayload struct {
+	MeshID       string `json:"mesh_id"`
+	DensityScore int    `json:"density_score"`
+}
+
+type SpotDetailThronePayload struct {
+	UserID   int    `json:"user_id"`
+	UserName string `json:"user_name"`
+}
+
+type SpotDetailPostPayload struct {
+	ID           int                   `json:"id"`
+	UserName     string                `json:"user_name"`
+	ImageURL     *string               `json:"image_url"`
+	Images       *ImageVariantsPayload `json:"images"`
+	Photos       []PostPhotoPayload    `json:"photos"`
+	Reactions    ReactionCountsPayload `json:"reactions"`
+	CommentCount int                   `json:"comment_count"`
+	Rating       *PostRatingPayload    `json:"rating"`
+	Visibility   string                `json:"visibility"`
+	Caption      string                `json:"caption"`
+	PostedAt     string                `json:"posted_at"`
+	IsOwn        bool                  `json:"is_own"`
+	IsResonant   bool                  `json:"is_resonant"`
+	MatchCount   int                   `json:"match_count"`
+}
+
+// SpotDetailDomainItem はプレゼンターへ渡すスポット詳細のドメインオブジェクト群です。
+type SpotDetailDomainItem struct {
+	Spot         *entities.Spot
+	Density      value_objects.DensityScore
+	ThroneHolder *entities.User
+	Posts        []SpotDetailPostDomainItem
+	NextCursor   string
+}
+
+// SpotDetailPostDomainItem は閲覧者から見た投稿の位置付け（自分の投稿か／共鳴者の投稿か）を添えた投稿です。
+type SpotDetailPostDomainItem struct {
+	Post       *entities.Post
+	IsOwn      bool
+	IsResonant bool
+	MatchCount int
+}
+
+type GetSpotDetailPresenter interface {
+	Output(item SpotDetailDomainItem) *GetSpotDetailResponse
+}
+
+type GetSpotDetailUseCase interface {
+	Execute(ctx context.Context, input GetSpotDetailInput) (*GetSpotDetailResponse, error)
+}
+
+type getSpotDetailInteractor struct {
+	presenter   GetSpotDetailPresenter
+	spotRepo    entities.SpotRepository
+	userRepo    entities.UserRepository
+	authService services.AuthDomainService
+}
+
+func NewGetSpotDetailInteractor(
+	p GetSpotDetailPresenter,
+	s entities.SpotRepository,
+	u entities.UserRepository,
+	a services.AuthDomainService,
+) GetSpotDetailUseCase {
+	return &getSpotDetailInteractor{
+		presenter:   p,
+		spotRepo:    s,
+		userRepo:    u,
+		authService: a,
+	}
+}
+
+func (i *getSpotDetailInteractor) Execute(ctx context.Context, input GetSpotDetailInput) (*GetSpotDetailResponse, error) {
+	// 1. 閲覧者の特定
+	viewer, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	spotID, err := value_objects.NewID(input.SpotID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	before, err := decodePostCursor(input.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	limit := normalizePageLimit(input.Limit)
+
+	// 2. スポットの取得（統合済みの旧IDは統合先へ解決される）
+	spot, err := i.spotRepo.FindByID(ctx, spotID)
+	if err != nil {
+		return nil, fmt.Errorf("repository error: %w", err)
+	}
+	if spot == nil {
+		return nil, fmt.Errorf("%w: %d", ErrSpotNotFound, spotID.Value())
+	}
+
+	// 3. 評価の集計、メッシュの激戦区度と、現在の王座保持者
+	ratings, err := i.spotRepo.FindRatingsBySpots(ctx, []value_objects.ID{spot.ID}, viewer.ID)
+	if err != nil {
+		return nil, fmt.Errorf("rating lookup error: %w", err)
+	}
+	spot.Rating = ratings[spot.ID.Value()]
+	density, err := i.spotRepo.GetDensityScoreByMesh(ctx, spot.MeshID, viewer.ID)
+	if err != nil {
+		return nil, fmt.Errorf("density calculation error: %w", err)
+	}
+	// 王座保持者の現行の投稿が閲覧者に見えない（private など）場合は、王座保持者を伏せる
+	throneVisible, err := i.spotRepo.IsThroneVisible(ctx, spot.ID, viewer.ID)
+	if err != nil {
+		return nil, fmt.Errorf("throne visibility lookup error: %w", err)
+	}
+	var throneHolder *entities.User
+	if throneVisible {
+		throneHolder, err = i.userRepo.FindByID(spot.RegisteredUserID)
+		if err != nil {
+			return nil, fmt.Errorf("throne holder lookup error: %w", err)
+		}
+	}
+
+	// 4. 投稿一覧（新しい順）。次ページの有無を判定するため 1 件多く取得する。
+	posts, err := i.spotRepo.FindPostsBySpotPage(ctx, spot.ID, viewer.ID, before, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("post lookup error: %w", err)
+	}
+	var nextCursor string
+	if len(posts) > limit {
+		posts = posts[:limit]
+		nextCursor = encodePostCursor(posts[len(posts)-1])
+	}
+
+	// 5. 閲覧者の共鳴者集合を取得し、投稿ごとに「自分の投稿」「共鳴者の投稿」を判定する。
+	resonantUsers, err := i.spotRepo.FindResonantUsersWithMatchCount(ctx, viewer.ID)
+	if err != nil {
+		return nil, fmt.Errorf("resonance lookup error: %w", err)
+	}
+	resonanceMap := make(map[int]int, len(resonantUsers))
+	for _, ru := range resonantUsers {
+		resonanceMap[ru.ID.Value()] = ru.MatchCount
+	}
+
+	items := make([]SpotDetailPostDomainItem, 0, len(posts))
+	for _, post := range posts {
+		matchCount, resonant := resonanceMap[post.UserID.Value()]
+		items = append(items, SpotDetailPostDomainItem{
+			Post:       post,
+			IsOwn:      post.UserID == viewer.ID,
+			IsResonant: resonant,
+			MatchCount: matchCount,
+		})
+	}
+
+	return i.presenter.Output(SpotDetailDomainItem{
+		Spot:         spot,
+		Density:      density,
+		ThroneHolder: throneHolder,
+		Posts:        items,
+		NextCursor:   nextCursor,
+	}), nil
+}
@@ -0,0 +1,219 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetSpotDetailMockPresenter struct{}
+
+func (p *GetSpotDetailMockPresenter) Output(item usecase.SpotDetailDomainItem) *usecase.GetSpotDetailResponse {
+	out := &usecase.GetSpotDetailResponse{
+		Spot: usecase.SpotDetailPayload{ID: item.Spot.ID.Value(), Name: item.Spot.Name.String()},
+		Mesh: usecase.SpotDetailMeshPayload{MeshID: item.Spot.MeshID.String(), DensityScore: item.Density.Int()},
+	}
+	if item.Spot.Rating.Count > 0 {
+		out.Spot.Rating = &usecase.SpotRatingPayload{Count: item.Spot.Rating.Count, Overall: item.Spot.Rating.Overall}
+	}
+	if item.ThroneHolder != nil {
+		out.Throne = &usecase.SpotDetailThronePayload{UserID: item.ThroneHolder.ID.Value(), UserName: item.ThroneHolder.Username.String()}
+	}
+	for _, p := range item.Posts {
+		out.Posts = append(out.Posts, usecase.SpotDetailPostPayload{
+			ID:         p.Post.ID.Value(),
+			IsOwn:      p.IsOwn,
+			IsResonant: p.IsResonant,
+			MatchCount: p.MatchCount,
+		})
+	}
+	if item.NextCursor != "" {
+		out.NextCursor = &item.NextCursor
+	}
+	return out
+}
+
+func TestGetSpotDetail_Execute(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	bob, _ := entities.NewUser(3, "bob_the_mentor", "bob@example.com", "hashed_password")
+	spot, _ := entities.NewSpot(1, "恵比寿うどん", 35.6467, 139.7101, 3)
+
+	bobPost, _ := entities.NewPost(12, 3, 1, "bob_the_mentor", "", "王座", time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC))
+	ownPost, _ := entities.NewPost(11, 2, 1, "local_malloy", "", "自分", time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC))
+	strangerPost, _ := entities.NewPost(10, 9, 1, "stranger", "", "通りすがり", time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))
+
+	resonance := []entities.ResonantUser{{ID: bob.ID, MatchCount: 4}}
+
+	tests := []struct {
+		name      string
+		input     usecase.GetSpotDetailInput
+		setupMock func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.GetSpotDetailResponse)
+	}{
+		{
+			name:  "【正常系】自分の投稿と共鳴者の投稿を判定し、次ページのカーソルを返す",
+			input: usecase.GetSpotDetailInput{Token: "valid_token", SpotID: 1, Limit: 2},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindByID", mock.Anything, spot.ID).Return(spot, nil)
+				sm.On("FindRatingsBySpots", mock.Anything, []value_objects.ID{spot.ID}, malloy.ID).
+					Return(map[int]entities.SpotRating{1: {Count: 2, Overall: 4.5, RegisteredUserRating: 5}}, nil)
+				sm.On("IsThroneVisible", mock.Anything, spot.ID, malloy.ID).Return(true, nil)
+				um.On("FindByID", bob.ID).Return(bob, nil)
+				// limit + 1 件を要求し、溢れた分で次ページの有無を判定する
+				sm.On("FindPostsBySpotPage", mock.Anything, spot.ID, malloy.ID, (*entities.PostCursor)(nil), 3).
+					Return([]*entities.Post{bobPost, ownPost, strangerPost}, nil)
+				sm.On("FindResonantUsersWithMatchCount", mock.Anything, malloy.ID).Return(resonance, nil)
+			},
+			check: func(t *testing.T, out *usecase.GetSpotDetailResponse) {
+				assert.Equal(t, 1, out.Spot.ID)
+				if assert.NotNil(t, out.Spot.Rating) {
+					assert.Equal(t, 2, out.Spot.Rating.Count)
+					assert.Equal(t, 4.5, out.Spot.Rating.Overall)
+				}
+				if assert.NotNil(t, out.Throne) {
+					assert.Equal(t, "bob_the_mentor", out.Throne.UserName)
+				}
+				if assert.Len(t, out.Posts, 2) {
+					assert.True(t, out.Posts[0].IsResonant)
+					assert.Equal(t, 4, out.Posts[0].MatchCount)
+					assert.False(t, out.Posts[0].IsOwn)
+					assert.True(t, out.Posts[1].IsOwn)
+					assert.False(t, out.Posts[1].IsResonant)
+				}
+				assert.NotNil(t, out.NextCursor)
+			},
+		},
+		{
+			name:  "【正常系】最終ページでは next_cursor を返さない",
+			input: usecase.GetSpotDetailInput{Token: "valid_token", SpotID: 1},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindByID", mock.Anything, spot.ID).Return(spot, nil)
+				sm.On("FindRatingsBySpots", mock.Anything, []value_objects.ID{spot.ID}, malloy.ID).Return(map[int]entities.SpotRating{}, nil)
+				sm.On("IsThroneVisible", mock.Anything, spot.ID, malloy.ID).Return(true, nil)
+				um.On("FindByID", bob.ID).Return(bob, nil)
+				sm.On("FindPostsBySpotPage", mock.Anything, spot.ID, malloy.ID, (*entities.PostCursor)(nil), 21).
+					Return([]*entities.Post{strangerPost}, nil)
+				sm.On("FindResonantUsersWithMatchCount", mock.Anything, malloy.ID).Return([]entities.ResonantUser{}, nil)
+			},
+			check: func(t *testing.T, out *usecase.GetSpotDetailResponse) {
+				assert.Len(t, out.Posts, 1)
+				assert.Nil(t, out.NextCursor)
+				assert.Nil(t, out.Spot.Rating)
+			},
+		},
+		{
+			name:  "【正常系】王座保持者の投稿が閲覧者に見えない場合は王座を伏せる",
+			input: usecase.GetSpotDetailInput{Token: "valid_token", SpotID: 1},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindByID", mock.Anything, spot.ID).Return(spot, nil)
+				sm.On("FindRatingsBySpots", mock.Anything, []value_objects.ID{spot.ID}, malloy.ID).Return(map[int]entities.SpotRating{}, nil)
+				sm.On("IsThroneVisible", mock.Anything, spot.ID, malloy.ID).Return(false, nil)
+				sm.On("FindPostsBySpotPage", mock.Anything, spot.ID, malloy.ID, (*entities.PostCursor)(nil), 21).
+					Return([]*entities.Post{strangerPost}, nil)
+				sm.On("FindResonantUsersWithMatchCount", mock.Anything, malloy.ID).Return([]entities.ResonantUser{}, nil)
+			},
+			check: func(t *testing.T, out *usecase.GetSpotDetailResponse) {
+				assert.Nil(t, out.Throne)
+			},
+		},
+		{
+			name:  "【異常系】スポットが存在しない場合は not found",
+			input: usecase.GetSpotDetailInput{Token: "valid_token", SpotID: 404},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindByID", mock.Anything, value_objects.ID(404)).Return((*entities.Spot)(nil), nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrSpotNotFound,
+		},
+		{
+			name:  "【異常系】壊れたカーソルは入力エラー",
+			input: usecase.GetSpotDetailInput{Token: "valid_token", SpotID: 1, Cursor: "%%%"},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】トークンが不正な場合は認証エラー",
+			input: usecase.GetSpotDetailInput{Token: "bad_token", SpotID: 1},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "bad_token").Return((*entities.User)(nil), errors.New("invalid token"))
+			},
+			wantErr: true,
+			errIs:   usecase.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, um, sm := new(MockAuthService), new(MockUserRepository), new(MockSpotRepository)
+			tt.setupMock(am, um, sm)
+			interactor := usecase.NewGetSpotDetailInteractor(&GetSpotDetailMockPresenter{}, sm, um, am)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			am.AssertExpectations(t)
+			um.AssertExpectations(t)
+			sm.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetSpotDetail_CursorRoundTrip(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	spot, _ := entities.NewSpot(1, "恵比寿うどん", 35.6467, 139.7101, 2)
+	newer, _ := entities.NewPost(21, 2, 1, "local_malloy", "", "新", time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC))
+	older, _ := entities.NewPost(20, 2, 1, "local_malloy", "", "旧", time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))
+
+	am, um, sm := new(MockAuthService), new(MockUserRepository), new(MockSpotRepository)
+	am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+	sm.On("FindByID", mock.Anything, spot.ID).Return(spot, nil)
+	sm.On("FindRatingsBySpots", mock.Anything, []value_objects.ID{spot.ID}, malloy.ID).Return(map[int]entities.SpotRating{}, nil)
+	sm.On("IsThroneVisible", mock.Anything, spot.ID, malloy.ID).Return(true, nil)
+	um.On("FindByID", malloy.ID).Return(malloy, nil)
+	sm.On("FindResonantUsersWithMatchCount", mock.Anything, malloy.ID).Return([]entities.ResonantUser{}, nil)
+	sm.On("FindPostsBySpotPage", mock.Anything, spot.ID, malloy.ID, (*entities.PostCursor)(nil), 2).Return([]*entities.Post{newer, older}, nil)
+	// 2ページ目では、1ページ目の末尾（newer）がカーソルとして復元されて渡る
+	sm.On("FindPostsBySpotPage", mock.Anything, spot.ID, malloy.ID, mock.MatchedBy(func(c *entities.PostCursor) bool {
+		return c != nil && c.ID == newer.ID && c.PostedAt.Equal(newer.PostedAt)
+	}), 2).Return([]*entities.Post{older}, nil)
+
+	interactor := usecase.NewGetSpotDetailInteractor(&GetSpotDetailMockPresenter{}, sm, um, am)
+
+	first, err := interactor.Execute(context.Background(), usecase.GetSpotDetailInput{Token: "valid_token", SpotID: 1, Limit: 1})
+	assert.NoError(t, err)
+	if assert.NotNil(t, first.NextCursor) {
+		second, err := interactor.Execute(context.Background(), usecase.GetSpotDetailInput{Token: "valid_token", SpotID: 1, Limit: 1, Cursor: *first.NextCursor})
+		assert.NoError(t, err)
+		assert.Len(t, second.Posts, 1)
+		assert.Nil(t, second.NextCursor)
+	}
+	sm.AssertExpectations(t)
+}
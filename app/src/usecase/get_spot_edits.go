@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+type GetSpotEditsInput struct {
+	Token  string
+	SpotID int
+}
+
+type GetSpotEditsResponse struct {
+	SpotID    int                       `json:"spot_id"`
+	Threshold int                       `json:"threshold"`
+	Proposals []SpotEditProposalPayload `json:"proposals"`
+	History   []SpotEditHistoryPayload  `json:"history"`
+}
+
+type SpotEditHistoryPayload struct {
+	ID         int    `json:"id"`
+	ProposalID *int   `json:"proposal_id"`
+	Kind       string `json:"kind"`
+	OldValue   string `json:"old_value"`
+	NewValue   string `json:"new_value"`
+	AppliedAt  string `json:"applied_at"`
+}
+
+type GetSpotEditsPresenter interface {
+	Output(spot *entities.Spot, threshold int, proposals []*entities.SpotEditProposal, history []*entities.SpotEditHistory) *GetSpotEditsResponse
+}
+
+type GetSpotEditsUseCase interface {
+	Execute(ctx context.Context, input GetSpotEditsInput) (*GetSpotEditsResponse, error)
+}
+
+type getSpotEditsInteractor struct {
+	presenter   GetSpotEditsPresenter
+	spotRepo    entities.SpotRepository
+	editRepo    entities.SpotEditRepository
+	authService services.AuthDomainService
+	threshold   int
+}
+
+func NewGetSpotEditsInteractor(
+	p GetSpotEditsPresenter,
+	s entities.SpotRepository,
+	e entities.SpotEditRepository,
+	a services.AuthDomainService,
+	threshold int,
+) GetSpotEditsUseCase {
+	return &getSpotEditsInteractor{
+		presenter:   p,
+		spotRepo:    s,
+		editRepo:    e,
+		authService: a,
+		threshold:   threshold,
+	}
+}
+
+func (i *getSpotEditsInteractor) Execute(ctx context.Context, input GetSpotEditsInput) (*GetSpotEditsResponse, error) {
+	if _, err := i.authService.VerifyToken(ctx, input.Token); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	spotID, err := value_objects.NewID(input.SpotID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	spot, err := i.spotRepo.FindByID(ctx, spotID)
+	if err != nil {
+		return nil, fmt.Errorf("repository error: %w", err)
+	}
+	if spot == nil {
+		return nil, fmt.Errorf("%w: %d", ErrSpotNotFound, spotID.Value())
+	}
+
+	// 未解決の提案と、適用済みの変更履歴（統合元の履歴も含む）
+	proposals, err := i.editRepo.FindOpenProposalsBySpot(ctx, spot.ID)
+	if err != nil {
+		return nil, fmt.Errorf("proposal lookup error: %w", err)
+	}
+	history, err := i.editRepo.FindHistoryBySpot(ctx, spot.ID)
+	if err != nil {
+		return nil, fmt.Errorf("history lookup error: %w", err)
+	}
+
+	return i.presenter.Output(spot, i.threshold, proposals, history), nil
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+type GetTagSpotsInput struct {
+	Token string
+	Tag   string
+	Limit int
+}
+
+type GetTagSpotsResponse struct {
+	Tag   string           `json:"tag"`
+	Spots []TagSpotPayload `json:"spots"`
+}
+
+type TagSpotPayload struct {
+	Spot           SpotSearchSpotPayload `json:"spot"`
+	PostCount      int                   `json:"post_count"`
+	LatestPostedAt string                `json:"latest_posted_at"`
+}
+
+type GetTagSpotsPresenter interface {
+	Output(tag value_objects.Hashtag, spots []entities.TaggedSpot) *GetTagSpotsResponse
+}
+
+type GetTagSpotsUseCase interface {
+	Execute(ctx context.Context, input GetTagSpotsInput) (*GetTagSpotsResponse, error)
+}
+
+type getTagSpotsInteractor struct {
+	presenter   GetTagSpotsPresenter
+	hashtagRepo entities.HashtagRepository
+	authService services.AuthDomainService
+}
+
+func NewGetTagSpotsInteractor(
+	p GetTagSpotsPresenter,
+	h entities.HashtagRepository,
+	a services.AuthDomainService,
+) GetTagSpotsUseCase {
+	return &getTagSpotsInteractor{
+		presenter:   p,
+		hashtagRepo: h,
+		authService: a,
+	}
+}
+
+func (i *getTagSpotsInteractor) Execute(ctx context.Context, input GetTagSpotsInput) (*GetTagSpotsResponse, error) {
+	if _, err := i.authService.VerifyToken(ctx, input.Token); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	// 1. タグの正規化（投稿時と同じ表記に揃える。「#Ｒａｍｅｎ」でも「ramen」でも同じ結果になる）
+	tag, err := value_objects.NewHashtag(input.Tag)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	// 2. タグ付きの投稿が多いスポット順（閉店済みは除く）
+	spots, err := i.hashtagRepo.FindSpotsByTag(ctx, tag, normalizePageLimit(input.Limit))
+	if err != nil {
+		return nil, fmt.Errorf("tag lookup error: %w", err)
+	}
+
+	return i.presenter.Output(tag, spots), nil
+}
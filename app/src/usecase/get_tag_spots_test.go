@@ -0,0 +1,273 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockHashtagRepository struct {
+	mock.Mock
+}
+
+func (m *MockHashtagRepository) FindSpotsByTag(ctx context.Context, tag value_objects.Hashtag, limit int) ([]entities.TaggedSpot, error) {
+	args := m.Called(ctx, tag, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.TaggedSpot), args.Error(1)
+}
+
+func (m *MockHashtagRepository) FindTrending(ctx context.Context, since time.Time, limit int) ([]entities.TrendingTag, error) {
+	args := m.Called(ctx, since, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.TrendingTag), args.Error(1)
+}
+
+type GetTagSpotsMockPresenter struct{}
+
+func (p *GetTagSpotsMockPresenter) Output(tag value_objects.Hashtag, spots []entities.TaggedSpot) *usecase.GetTagSpotsResponse {
+	out := &usecase.GetTagSpotsResponse{Tag: tag.String(), Spots: []usecase.TagSpotPayload{}}
+	for _, s := range spots {
+		out.Spots = append(out.Spots, usecase.TagSpotPayload{
+			Spot:      usecase.SpotSearchSpotPayload{ID: s.Spot.ID.Value(), Name: s.Spot.Name.String()},
+			PostCount: s.PostCount,
+		})
+	}
+	return out
+}
+
+type GetTrendingTagsMockPresenter struct{}
+
+func (p *GetTrendingTagsMockPresenter) Output(days int, since time.Time, tags []entities.TrendingTag) *usecase.GetTrendingTagsResponse {
+	out := &usecase.GetTrendingTagsResponse{Days: days, Since: since.UTC().Format(time.RFC3339), Tags: []usecase.TrendingTagPayload{}}
+	for _, t := range tags {
+		out.Tags = append(out.Tags, usecase.TrendingTagPayload{Tag: t.Tag.String(), PostCount: t.PostCount, SpotCount: t.SpotCount})
+	}
+	return out
+}
+
+func TestCaption_HashtagsAndMentions(t *testing.T) {
+	tests := []struct {
+		name         string
+		caption      string
+		wantTags     []string
+		wantMentions []string
+	}{
+		{
+			name:         "【正常系】日本語・全角のタグを正規化し、重複を除いて出現順に返す",
+			caption:      "#二郎系 最高！ ＃Ｒａｍｅｎ #ramen #ニンニク @local_malloy と来た",
+			wantTags:     []string{"二郎系", "ramen", "ニンニク"},
+			wantMentions: []string{"local_malloy"},
+		},
+		{
+			name:         "【正常系】語の途中の記号・番号だけのタグ・メールアドレスは無視する",
+			caption:      "C#で書いた。#1 は除外。連絡は foo@example.com へ。@ab は短すぎる",
+			wantTags:     nil,
+			wantMentions: nil,
+		},
+		{
+			name:         "【正常系】文末の句読点はメンションに含めない",
+			caption:      "@saku.ra. と @taro-。ありがとう＠ＹＵＫＩ",
+			wantTags:     nil,
+			wantMentions: []string{"saku.ra", "taro", "ＹＵＫＩ"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caption, err := value_objects.NewCaption(tt.caption)
+			assert.NoError(t, err)
+
+			var tags, mentions []string
+			for _, tag := range caption.Hashtags() {
+				tags = append(tags, tag.String())
+			}
+			for _, m := range caption.Mentions() {
+				mentions = append(mentions, m.String())
+			}
+			assert.Equal(t, tt.wantTags, tags)
+			assert.Equal(t, tt.wantMentions, mentions)
+		})
+	}
+}
+
+func TestGetTagSpots_Execute(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	jiro, _ := entities.NewSpot(1, "ラーメン二郎 三田本店", 35.6467, 139.7101, 1)
+
+	tests := []struct {
+		name      string
+		input     usecase.GetTagSpotsInput
+		setupMock func(am *MockAuthService, hm *MockHashtagRepository)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.GetTagSpotsResponse)
+	}{
+		{
+			name:  "【正常系】# 付き・全角のタグも正規化してから検索する",
+			input: usecase.GetTagSpotsInput{Token: "valid_token", Tag: "＃Ｒａｍｅｎ"},
+			setupMock: func(am *MockAuthService, hm *MockHashtagRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				hm.On("FindSpotsByTag", mock.Anything, value_objects.Hashtag("ramen"), 20).
+					Return([]entities.TaggedSpot{{Spot: jiro, PostCount: 3, LatestPostedAt: time.Now()}}, nil)
+			},
+			check: func(t *testing.T, out *usecase.GetTagSpotsResponse) {
+				assert.Equal(t, "ramen", out.Tag)
+				if assert.Len(t, out.Spots, 1) {
+					assert.Equal(t, 1, out.Spots[0].Spot.ID)
+					assert.Equal(t, 3, out.Spots[0].PostCount)
+				}
+			},
+		},
+		{
+			name:  "【異常系】記号を含むタグは入力エラー",
+			input: usecase.GetTagSpotsInput{Token: "valid_token", Tag: "ramen!"},
+			setupMock: func(am *MockAuthService, hm *MockHashtagRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】DBエラーが発生した場合",
+			input: usecase.GetTagSpotsInput{Token: "valid_token", Tag: "ramen"},
+			setupMock: func(am *MockAuthService, hm *MockHashtagRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				hm.On("FindSpotsByTag", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "【異常系】トークンが不正な場合は認証エラー",
+			input: usecase.GetTagSpotsInput{Token: "bad_token", Tag: "ramen"},
+			setupMock: func(am *MockAuthService, hm *MockHashtagRepository) {
+				am.On("VerifyToken", mock.Anything, "bad_token").Return((*entities.User)(nil), errors.New("invalid token"))
+			},
+			wantErr: true,
+			errIs:   usecase.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, hm := new(MockAuthService), new(MockHashtagRepository)
+			tt.setupMock(am, hm)
+			interactor := usecase.NewGetTagSpotsInteractor(&GetTagSpotsMockPresenter{}, hm, am)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			am.AssertExpectations(t)
+			hm.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetTrendingTags_Execute(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		input     usecase.GetTrendingTagsInput
+		setupMock func(am *MockAuthService, hm *MockHashtagRepository)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.GetTrendingTagsResponse)
+	}{
+		{
+			name:  "【正常系】既定では直近7日間を集計する",
+			input: usecase.GetTrendingTagsInput{Token: "valid_token", Now: now},
+			setupMock: func(am *MockAuthService, hm *MockHashtagRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				hm.On("FindTrending", mock.Anything, now.AddDate(0, 0, -7), 20).
+					Return([]entities.TrendingTag{{Tag: "二郎系", PostCount: 12, SpotCount: 4}}, nil)
+			},
+			check: func(t *testing.T, out *usecase.GetTrendingTagsResponse) {
+				assert.Equal(t, 7, out.Days)
+				assert.Equal(t, "2025-06-08T12:00:00Z", out.Since)
+				if assert.Len(t, out.Tags, 1) {
+					assert.Equal(t, "二郎系", out.Tags[0].Tag)
+					assert.Equal(t, 12, out.Tags[0].PostCount)
+				}
+			},
+		},
+		{
+			name:  "【正常系】期間と件数を指定できる",
+			input: usecase.GetTrendingTagsInput{Token: "valid_token", Now: now, Days: 1, Limit: 5},
+			setupMock: func(am *MockAuthService, hm *MockHashtagRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				hm.On("FindTrending", mock.Anything, now.AddDate(0, 0, -1), 5).Return([]entities.TrendingTag{}, nil)
+			},
+			check: func(t *testing.T, out *usecase.GetTrendingTagsResponse) {
+				assert.Equal(t, 1, out.Days)
+				assert.Empty(t, out.Tags)
+			},
+		},
+		{
+			name:  "【異常系】上限を超える期間は入力エラー",
+			input: usecase.GetTrendingTagsInput{Token: "valid_token", Now: now, Days: 31},
+			setupMock: func(am *MockAuthService, hm *MockHashtagRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】トークンが不正な場合は認証エラー",
+			input: usecase.GetTrendingTagsInput{Token: "bad_token", Now: now},
+			setupMock: func(am *MockAuthService, hm *MockHashtagRepository) {
+				am.On("VerifyToken", mock.Anything, "bad_token").Return((*entities.User)(nil), errors.New("invalid token"))
+			},
+			wantErr: true,
+			errIs:   usecase.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, hm := new(MockAuthService), new(MockHashtagRepository)
+			tt.setupMock(am, hm)
+			interactor := usecase.NewGetTrendingTagsInteractor(&GetTrendingTagsMockPresenter{}, hm, am)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			am.AssertExpectations(t)
+			hm.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+)
+
+// トレンド集計の対象期間（日数）の既定値と上限
+const (
+	DefaultTrendingTagDays = 7
+	MaxTrendingTagDays     = 30
+)
+
+type GetTrendingTagsInput struct {
+	Token string
+	Now   time.Time
+	Days  int
+	Limit int
+}
+
+type GetTrendingTagsResponse struct {
+	Days  int                  `json:"days"`
+	Since string               `json:"since"`
+	Tags  []TrendingTagPayload `json:"tags"`
+}
+
+type TrendingTagPayload struct {
+	Tag       string `json:"tag"`
+	PostCount int    `json:"post_count"`
+	SpotCount int    `json:"spot_count"`
+}
+
+type GetTrendingTagsPresenter interface {
+	Output(days int, since time.Time, tags []entities.TrendingTag) *GetTrendingTagsResponse
+}
+
+type GetTrendingTagsUseCase interface {
+	Execute(ctx context.Context, input GetTrendingTagsInput) (*GetTrendingTagsResponse, error)
+}
+
+type getTrendingTagsInteractor struct {
+	presenter   GetTrendingTagsPresenter
+	hashtagRepo entities.HashtagRepository
+	authService services.AuthDomainService
+}
+
+func NewGetTrendingTagsInteractor(
+	p GetTrendingTagsPresenter,
+	h entities.HashtagRepository,
+	a services.AuthDomainService,
+) GetTrendingTagsUseCase {
+	return &getTrendingTagsInteractor{
+		presenter:   p,
+		hashtagRepo: h,
+		authService: a,
+	}
+}
+
+func (i *getTrendingTagsInteractor) Execute(ctx context.Context, input GetTrendingTagsInput) (*GetTrendingTagsResponse, error) {
+	if _, err := i.authService.VerifyToken(ctx, input.Token); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	days := input.Days
+	if days == 0 {
+		days = DefaultTrendingTagDays
+	}
+	if days < 1 || days > MaxTrendingTagDays {
+		return nil, fmt.Errorf("%w: days must be 1-%d", ErrInvalidInput, MaxTrendingTagDays)
+	}
+	now := input.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	since := now.Add(-time.Duration(days) * 24 * time.Hour)
+
+	// 期間内の現行の投稿で使われたタグを投稿数の多い順に集計する（上書き前の投稿は数えない）
+	tags, err := i.hashtagRepo.FindTrending(ctx, since, normalizePageLimit(input.Limit))
+	if err != nil {
+		return nil, fmt.Errorf("trending lookup error: %w", err)
+	}
+
+	return i.presenter.Output(days, since, tags), nil
+}
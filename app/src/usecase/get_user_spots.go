@@ -6,6 +6,7 @@ import (
 
 	"app/src/domain/entities"
 	"app/src/domain/services"
+	"app/src/domain/value_objects"
 )
 
 type GetUserSpotsInput struct {
@@ -22,10 +23,11 @@ type UserSpotResult struct {
 }
 
 type UserSpotPayload struct {
-	ID       int              `json:"id"`
-	Name     string           `json:"name"`
-	MeshID   string           `json:"mesh_id"`
-	Location UserSpotLocation `json:"location"`
+	ID         int                   `json:"id"`
+	Name       string                `json:"name"`
+	MeshID     string                `json:"mesh_id"`
+	Location   UserSpotLocation      `json:"location"`
+	Attributes SpotAttributesPayload `json:"attributes"`
 }
 
 type UserSpotLocation struct {
@@ -34,11 +36,16 @@ type UserSpotLocation struct {
 }
 
 type UserPostPayload struct {
-	ID       int     `json:"id"`
-	UserName string  `json:"user_name"`
-	ImageURL *string `json:"image_url"`
-	Caption  string  `json:"caption"`
-	PostedAt string  `json:"posted_at"`
+	ID           int                   `json:"id"`
+	UserName     string                `json:"user_name"`
+	ImageURL     *string               `json:"image_url"`
+	Images       *ImageVariantsPayload `json:"images"`
+	Photos       []PostPhotoPayload    `json:"photos"`
+	Reactions    ReactionCountsPayload `json:"reactions"`
+	CommentCount int                   `json:"comment_count"`
+	Visibility   string                `json:"visibility"`
+	Caption      string                `json:"caption"`
+	PostedAt     string                `json:"posted_at"`
 }
 
 type GetUserSpotsPresenter interface {
@@ -81,43 +88,44 @@ func (i *getUserSpotsInteractor) Execute(ctx context.Context, input GetUserSpots
 		return nil, fmt.Errorf("auth error: %w", err)
 	}
 
-	spots, err := i.spotRepo.FindByRegisteredUser(ctx, user.ID)
+	items, err := findUserSpotItems(ctx, i.spotRepo, i.postRepo, user.ID)
 	if err != nil {
-		return nil, fmt.Errorf("spot lookup error: %w", err)
+		return nil, err
 	}
 
-	items := make([]UserSpotDomainItem, 0, len(spots))
-	for _, spot := range spots {
-		posts, err := i.postRepo.FindBySpotID(spot.ID)
-		if err != nil {
-			return nil, fmt.Errorf("post lookup error: %w", err)
-		}
+	return i.presenter.Output(items), nil
+}
 
-		var latest *entities.Post
-		for _, post := range posts {
-			if post.UserID.Value() != user.ID.Value() {
-				continue
-			}
-			if latest == nil || isAfter(post, latest) {
-				latest = post
-			}
-		}
+// findUserSpotItems は、ユーザーが投稿したスポットの一覧と各スポットでの本人の最新投稿を取得します。
+// スポット一覧（GET /v1/users/me/spots）とエクスポートで同じ結果を返すための共通処理です。
+// 自分が登録したスポットに限らず、投稿したすべてのスポットを含みます（公開プロフィールと同じ取得方法）。
+func findUserSpotItems(ctx context.Context, spotRepo entities.SpotRepository, postRepo entities.PostRepository, userID value_objects.ID) ([]UserSpotDomainItem, error) {
+	// 現行の投稿は投稿日時の新しい順に返るため、スポットごとに最初の投稿が最新の投稿になる
+	posts, err := postRepo.FindByUserID(userID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("post lookup error: %w", err)
+	}
+	spotIDs := make([]value_objects.ID, 0, len(posts))
+	for _, post := range posts {
+		spotIDs = append(spotIDs, post.SpotID)
+	}
+	spotsByID, err := spotRepo.FindByIDs(ctx, spotIDs)
+	if err != nil {
+		return nil, fmt.Errorf("spot lookup error: %w", err)
+	}
 
+	items := make([]UserSpotDomainItem, 0, len(posts))
+	seen := make(map[int]bool, len(posts))
+	for _, post := range posts {
+		spot, ok := spotsByID[post.SpotID.Value()]
+		if !ok || seen[spot.ID.Value()] {
+			continue
+		}
+		seen[spot.ID.Value()] = true
 		items = append(items, UserSpotDomainItem{
 			Spot: spot,
-			Post: latest,
+			Post: post,
 		})
 	}
-
-	return i.presenter.Output(items), nil
-}
-
-func isAfter(current *entities.Post, base *entities.Post) bool {
-	if current.PostedAt.After(base.PostedAt) {
-		return true
-	}
-	if current.PostedAt.Equal(base.PostedAt) {
-		return current.ID.Value() > base.ID.Value()
-	}
-	return false
+	return items, nil
 }
@@ -45,6 +45,13 @@ func (m *GetUserSpotsMockSpotRepository) FindByRegisteredUser(ctx context.Contex
 func (m *GetUserSpotsMockSpotRepository) Create(spot *entities.Spot) (*entities.Spot, error) {
 	return nil, nil
 }
+func (m *GetUserSpotsMockSpotRepository) FindByIDs(ctx context.Context, ids []value_objects.ID) (map[int]*entities.Spot, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[int]*entities.Spot), args.Error(1)
+}
 func (m *GetUserSpotsMockSpotRepository) FindByID(ctx context.Context, id value_objects.ID) (*entities.Spot, error) {
 	return nil, nil
 }
@@ -56,26 +63,47 @@ func (m *GetUserSpotsMockSpotRepository) FindByLocation(ctx context.Context, lat
 }
 func (m *GetUserSpotsMockSpotRepository) Update(spot *entities.Spot) error { return nil }
 func (m *GetUserSpotsMockSpotRepository) Delete(id value_objects.ID) error { return nil }
+func (m *GetUserSpotsMockSpotRepository) Merge(ctx context.Context, sourceID, targetID, mergedBy value_objects.ID) (int, error) {
+	return 0, nil
+}
 func (m *GetUserSpotsMockSpotRepository) FindResonantUsersWithMatchCount(ctx context.Context, userID value_objects.ID) ([]entities.ResonantUser, error) {
 	return nil, nil
 }
 func (m *GetUserSpotsMockSpotRepository) FindSpotByMeshAndUser(ctx context.Context, meshID value_objects.MeshID, userID value_objects.ID) (*entities.Spot, error) {
 	return nil, nil
 }
-func (m *GetUserSpotsMockSpotRepository) FindSpotsByMeshAndUsers(ctx context.Context, meshIDs []value_objects.MeshID, userIDs []value_objects.ID) ([]*entities.Spot, error) {
+func (m *GetUserSpotsMockSpotRepository) FindSpotsByMeshAndUsers(ctx context.Context, meshIDs []value_objects.MeshID, userIDs []value_objects.ID, viewerID value_objects.ID) ([]*entities.Spot, error) {
 	return nil, nil
 }
-func (m *GetUserSpotsMockSpotRepository) GetDensityScoreByMesh(ctx context.Context, meshID value_objects.MeshID) (value_objects.DensityScore, error) {
+func (m *GetUserSpotsMockSpotRepository) GetDensityScoreByMesh(ctx context.Context, meshID value_objects.MeshID, viewerID value_objects.ID) (value_objects.DensityScore, error) {
 	return value_objects.NewDensityScore(0)
 }
-func (m *GetUserSpotsMockSpotRepository) FindPostsBySpot(ctx context.Context, spotID value_objects.ID) ([]*entities.Post, error) {
+func (m *GetUserSpotsMockSpotRepository) FindPostsBySpot(ctx context.Context, spotID, viewerID value_objects.ID) ([]*entities.Post, error) {
+	return nil, nil
+}
+func (m *GetUserSpotsMockSpotRepository) SearchByName(ctx context.Context, criteria entities.SpotSearchCriteria) ([]entities.SpotSearchHit, error) {
 	return nil, nil
 }
+func (m *GetUserSpotsMockSpotRepository) FindPostsBySpotPage(ctx context.Context, spotID, viewerID value_objects.ID, before *entities.PostCursor, limit int) ([]*entities.Post, error) {
+	return nil, nil
+}
+func (m *GetUserSpotsMockSpotRepository) FindRatingsBySpots(ctx context.Context, spotIDs []value_objects.ID, viewerID value_objects.ID) (map[int]entities.SpotRating, error) {
+	return nil, nil
+}
+func (m *GetUserSpotsMockSpotRepository) IsThroneVisible(ctx context.Context, spotID, viewerID value_objects.ID) (bool, error) {
+	return false, nil
+}
+func (m *GetUserSpotsMockSpotRepository) ReportClosure(ctx context.Context, spotID, userID value_objects.ID, threshold int) (int, entities.SpotStatus, error) {
+	return 0, entities.SpotActive, nil
+}
+func (m *GetUserSpotsMockSpotRepository) UpdateStatus(ctx context.Context, spotID value_objects.ID, status entities.SpotStatus) error {
+	return nil
+}
 
 type GetUserSpotsMockPostRepository struct{ mock.Mock }
 
-func (m *GetUserSpotsMockPostRepository) FindBySpotID(spotID value_objects.ID) ([]*entities.Post, error) {
-	args := m.Called(spotID)
+func (m *GetUserSpotsMockPostRepository) FindBySpotID(spotID, viewerID value_objects.ID) ([]*entities.Post, error) {
+	args := m.Called(spotID, viewerID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -84,7 +112,23 @@ func (m *GetUserSpotsMockPostRepository) FindBySpotID(spotID value_objects.ID) (
 func (m *GetUserSpotsMockPostRepository) Create(post *entities.Post) (*entities.Post, error) {
 	return nil, nil
 }
-func (m *GetUserSpotsMockPostRepository) FindByID(id value_objects.ID) (*entities.Post, error) {
+func (m *GetUserSpotsMockPostRepository) FindByID(id, viewerID value_objects.ID) (*entities.Post, error) {
+	return nil, nil
+}
+func (m *GetUserSpotsMockPostRepository) FindByUserID(userID, viewerID value_objects.ID) ([]*entities.Post, error) {
+	args := m.Called(userID, viewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Post), args.Error(1)
+}
+func (m *GetUserSpotsMockPostRepository) Supersede(post *entities.Post) (*entities.Post, error) {
+	return nil, nil
+}
+func (m *GetUserSpotsMockPostRepository) FindHistoryBySpotID(spotID, viewerID value_objects.ID) ([]*entities.Post, error) {
+	return nil, nil
+}
+func (m *GetUserSpotsMockPostRepository) FindHistoryByUserID(userID, viewerID value_objects.ID) ([]*entities.Post, error) {
 	return nil, nil
 }
 func (m *GetUserSpotsMockPostRepository) Update(post *entities.Post) error { return nil }
@@ -116,32 +160,34 @@ func (p *GetUserSpotsMockPresenter) Output(items []usecase.UserSpotDomainItem) *
 }
 
 func TestGetUserSpots_Execute(t *testing.T) {
-	user, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed")
+	user, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
 	spot1, _ := entities.NewSpot(101, "店A", 35.1, 139.1, 2)
-	spot2, _ := entities.NewSpot(102, "店B", 35.2, 139.2, 2)
-	otherUser, _ := entities.NewUser(99, "other_user", "other@example.com", "hashed")
+	// 他人が登録したスポットでも、本人が投稿していれば一覧に含まれる
+	spot2, _ := entities.NewSpot(102, "店B", 35.2, 139.2, 99)
 
-	oldPost, _ := entities.NewPost(1, 2, 101, "local_malloy", "https://example.com/old.jpg", "old", time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC))
 	latestPost, _ := entities.NewPost(2, 2, 101, "local_malloy", "https://example.com/new.jpg", "new", time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))
-	othersPost, _ := entities.NewPost(3, otherUser.ID.Value(), 101, "other_user", "https://example.com/other.jpg", "other", time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC))
+	postOnOthersSpot, _ := entities.NewPost(3, 2, 102, "local_malloy", "https://example.com/b.jpg", "b", time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC))
 
-	t.Run("最新の自分の投稿を紐付けて一覧を返す", func(t *testing.T) {
+	t.Run("本人が投稿したスポットを最新の投稿とともに返す", func(t *testing.T) {
 		am := new(GetUserSpotsMockAuthService)
 		sm := new(GetUserSpotsMockSpotRepository)
 		pm := new(GetUserSpotsMockPostRepository)
 		presenter := &GetUserSpotsMockPresenter{}
 
 		am.On("VerifyToken", mock.Anything, "valid_token").Return(user, nil)
-		sm.On("FindByRegisteredUser", mock.Anything, user.ID).Return([]*entities.Spot{spot1, spot2}, nil)
-		pm.On("FindBySpotID", spot1.ID).Return([]*entities.Post{oldPost, latestPost, othersPost}, nil)
-		pm.On("FindBySpotID", spot2.ID).Return([]*entities.Post{}, nil)
+		pm.On("FindByUserID", user.ID, user.ID).Return([]*entities.Post{latestPost, postOnOthersSpot}, nil)
+		sm.On("FindByIDs", mock.Anything, []value_objects.ID{spot1.ID, spot2.ID}).Return(map[int]*entities.Spot{101: spot1, 102: spot2}, nil)
 
 		interactor := usecase.NewGetUserSpotsInteractor(presenter, sm, pm, am)
 		out, err := interactor.Execute(context.Background(), usecase.GetUserSpotsInput{Token: "valid_token"})
 		assert.NoError(t, err)
-		assert.Len(t, out.UserSpots, 2)
-		assert.Equal(t, 2, out.UserSpots[0].Post.ID)
-		assert.Nil(t, out.UserSpots[1].Post)
+		if assert.Len(t, out.UserSpots, 2) {
+			assert.Equal(t, 2, out.UserSpots[0].Post.ID)
+			assert.Equal(t, 102, out.UserSpots[1].Spot.ID)
+			assert.Equal(t, 3, out.UserSpots[1].Post.ID)
+		}
+		sm.AssertExpectations(t)
+		pm.AssertExpectations(t)
 	})
 
 	t.Run("認証失敗時はエラー", func(t *testing.T) {
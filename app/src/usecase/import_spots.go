@@ -0,0 +1,229 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"app/src/domain/entities"
+)
+
+// ImportSpotRow は取り込みファイル（GeoJSON / CSV）の1行分です。
+type ImportSpotRow struct {
+	// Line は元ファイル上の位置（CSV はヘッダーを含む行番号、GeoJSON は Feature の通し番号）
+	Line         int
+	Name         string
+	Latitude     float64
+	Longitude    float64
+	Category     string
+	Address      string
+	PriceRange   int
+	OpeningHours map[string][]string
+	Caption      string
+	ImageURL     string
+	// ParseError はファイルの読み取り段階で見つかった不備です。設定されている行は検証せずに rejected とします。
+	ParseError string
+}
+
+type ImportSpotsInput struct {
+	// Username は新規スポットの登録者（および WithPosts 時の投稿者）となるユーザーです。
+	Username  string
+	Rows      []ImportSpotRow
+	WithPosts bool
+	DryRun    bool
+}
+
+type ImportSpotsOutput struct {
+	DryRun       bool                   `json:"dry_run"`
+	Created      int                    `json:"created"`
+	Merged       int                    `json:"merged"`
+	Rejected     int                    `json:"rejected"`
+	PostsCreated int                    `json:"posts_created"`
+	Rows         []ImportSpotsRowResult `json:"rows"`
+}
+
+type ImportSpotsRowResult struct {
+	Line        int    `json:"line"`
+	Name        string `json:"name"`
+	Status      string `json:"status"`
+	SpotID      *int   `json:"spot_id"`
+	PostCreated bool   `json:"post_created"`
+	Reason      string `json:"reason,omitempty"`
+}
+
+// ImportRowStatus は取り込み行の処理結果です。
+type ImportRowStatus string
+
+const (
+	ImportRowCreated  ImportRowStatus = "created"
+	ImportRowMerged   ImportRowStatus = "merged"
+	ImportRowRejected ImportRowStatus = "rejected"
+)
+
+// ImportSpotsRowDomainItem はプレゼンターへ渡す1行分の処理結果です。
+// dry-run で新規作成予定のスポットは ID が 0 のままです。
+type ImportSpotsRowDomainItem struct {
+	Row         ImportSpotRow
+	Status      ImportRowStatus
+	Spot        *entities.Spot
+	PostCreated bool
+	Reason      string
+}
+
+type ImportSpotsPresenter interface {
+	Output(dryRun bool, items []ImportSpotsRowDomainItem) *ImportSpotsOutput
+}
+
+type ImportSpotsUseCase interface {
+	Execute(ctx context.Context, input ImportSpotsInput) (*ImportSpotsOutput, error)
+}
+
+type importSpotsInteractor struct {
+	presenter ImportSpotsPresenter
+	spotRepo  entities.SpotRepository
+	postRepo  entities.PostRepository
+	userRepo  entities.UserRepository
+}
+
+func NewImportSpotsInteractor(
+	p ImportSpotsPresenter,
+	s entities.SpotRepository,
+	r entities.PostRepository,
+	u entities.UserRepository,
+) ImportSpotsUseCase {
+	return &importSpotsInteractor{
+		presenter: p,
+		spotRepo:  s,
+		postRepo:  r,
+		userRepo:  u,
+	}
+}
+
+func (i *importSpotsInteractor) Execute(ctx context.Context, input ImportSpotsInput) (*ImportSpotsOutput, error) {
+	// 1. 登録者（投稿者）となるユーザーの特定
+	user, err := i.userRepo.FindByUsername(ctx, input.Username)
+	if err != nil {
+		return nil, fmt.Errorf("%w: user %q: %v", ErrInvalidInput, input.Username, err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("%w: user %q not found", ErrInvalidInput, input.Username)
+	}
+
+	// ファイル内で同じ座標が複数回現れた場合は、API と同様に最初の行のスポットへ合流させる。
+	// dry-run では保存しないため、作成予定のスポットもここで覚えておく。
+	seen := make(map[[2]float64]*entities.Spot)
+	posted := make(map[[2]float64]bool)
+
+	items := make([]ImportSpotsRowDomainItem, 0, len(input.Rows))
+	for _, row := range input.Rows {
+		item, err := i.importRow(ctx, input, user, row, seen, posted)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", row.Line, err)
+		}
+		items = append(items, item)
+	}
+
+	return i.presenter.Output(input.DryRun, items), nil
+}
+
+// importRow は1行を API（PUT /v1/mesh/spots）と同じ検証・重複判定に通します。
+// 検証に失敗した行は rejected として返し、リポジトリのエラーのみを error として返します。
+func (i *importSpotsInteractor) importRow(
+	ctx context.Context,
+	input ImportSpotsInput,
+	user *entities.User,
+	row ImportSpotRow,
+	seen map[[2]float64]*entities.Spot,
+	posted map[[2]float64]bool,
+) (ImportSpotsRowDomainItem, error) {
+	item := ImportSpotsRowDomainItem{Row: row}
+	reject := func(reason string) (ImportSpotsRowDomainItem, error) {
+		item.Status = ImportRowRejected
+		item.Reason = reason
+		return item, nil
+	}
+
+	if row.ParseError != "" {
+		return reject(row.ParseError)
+	}
+
+	// 1. API と同じエンティティ生成・検証（座標・店名・付帯情報）
+	newSpot, err := entities.NewSpot(0, row.Name, row.Latitude, row.Longitude, user.ID.Value())
+	if err != nil {
+		return reject(err.Error())
+	}
+	if err := newSpot.SetAttributes(row.Category, row.Address, row.PriceRange, row.OpeningHours); err != nil {
+		return reject(err.Error())
+	}
+
+	// 投稿も書き込み前に検証しておき、スポットだけが作られる中途半端な状態を避ける。
+	var post *entities.Post
+	if input.WithPosts {
+		post, err = entities.NewPost(0, user.ID.Value(), 0, user.Username.String(), row.ImageURL, row.Caption, time.Now())
+		if err != nil {
+			return reject(err.Error())
+		}
+	}
+
+	// 2. 同一座標のスポット（統合済みのリダイレクト含む）があれば合流、なければ新規作成
+	key := [2]float64{row.Latitude, row.Longitude}
+	target, ok := seen[key]
+	if !ok {
+		target, err = i.spotRepo.FindByLocation(ctx, row.Latitude, row.Longitude)
+		if err != nil {
+			return item, fmt.Errorf("repository error: %w", err)
+		}
+	}
+
+	if target != nil && target.IsClosed() {
+		// API と同様に、閉店済みのスポットへは合流させない。
+		seen[key] = target
+		return reject("spot is closed")
+	}
+	if target != nil {
+		item.Status = ImportRowMerged
+	} else {
+		item.Status = ImportRowCreated
+		target = newSpot
+		if !input.DryRun {
+			target, err = i.spotRepo.Create(newSpot)
+			if err != nil {
+				return item, fmt.Errorf("spot storage error: %w", err)
+			}
+		}
+	}
+	seen[key] = target
+	item.Spot = target
+
+	// 3. 投稿の作成（1ユーザー1スポット1投稿。既に投稿済みのスポットには追加しない）
+	if post == nil {
+		return item, nil
+	}
+	if !posted[key] && target.ID.Value() != 0 {
+		existing, err := i.postRepo.FindBySpotID(target.ID, user.ID)
+		if err != nil {
+			return item, fmt.Errorf("post lookup error: %w", err)
+		}
+		for _, p := range existing {
+			if p.UserID == user.ID {
+				posted[key] = true
+				break
+			}
+		}
+	}
+	if posted[key] {
+		item.Reason = "post skipped: already posted to this spot"
+		return item, nil
+	}
+
+	posted[key] = true
+	item.PostCreated = true
+	if input.DryRun {
+		return item, nil
+	}
+	post.SpotID = target.ID
+	if _, err := i.postRepo.Create(post); err != nil {
+		return item, fmt.Errorf("post storage error: %w", err)
+	}
+	return item, nil
+}
@@ -0,0 +1,185 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type ImportSpotsMockPresenter struct{}
+
+func (p *ImportSpotsMockPresenter) Output(dryRun bool, items []usecase.ImportSpotsRowDomainItem) *usecase.ImportSpotsOutput {
+	out := &usecase.ImportSpotsOutput{DryRun: dryRun}
+	for _, item := range items {
+		row := usecase.ImportSpotsRowResult{Line: item.Row.Line, Status: string(item.Status), PostCreated: item.PostCreated, Reason: item.Reason}
+		if item.Spot != nil && item.Spot.ID.Value() != 0 {
+			id := item.Spot.ID.Value()
+			row.SpotID = &id
+		}
+		switch item.Status {
+		case usecase.ImportRowCreated:
+			out.Created++
+		case usecase.ImportRowMerged:
+			out.Merged++
+		case usecase.ImportRowRejected:
+			out.Rejected++
+		}
+		if item.PostCreated {
+			out.PostsCreated++
+		}
+		out.Rows = append(out.Rows, row)
+	}
+	return out
+}
+
+func TestImportSpots_Execute(t *testing.T) {
+	admin, _ := entities.NewUser(1, "trapizzino_admin", "admin@example.com", "hashed_password")
+	existing, _ := entities.NewSpot(5, "恵比寿うどん", 35.6467, 139.7101, 9)
+	created, _ := entities.NewSpot(50, "中目黒ピッツァ", 35.6440, 139.6990, 1)
+	adminPost, _ := entities.NewPost(300, 1, 5, "trapizzino_admin", "", "既存", time.Now())
+
+	rows := []usecase.ImportSpotRow{
+		{Line: 2, Name: "中目黒ピッツァ", Latitude: 35.6440, Longitude: 139.6990, Category: "pizza", Caption: "窯焼き"},
+		{Line: 3, Name: "恵比寿うどん(別名)", Latitude: 35.6467, Longitude: 139.7101},
+		{Line: 4, Name: "中目黒ピッツァ(重複行)", Latitude: 35.6440, Longitude: 139.6990},
+		{Line: 5, Name: "北極の店", Latitude: 95.0, Longitude: 139.0},
+		{Line: 6, Name: "謎の店", Latitude: 35.0, Longitude: 139.0, Category: "fastfood"},
+		{Line: 7, ParseError: "geometry must be a Point"},
+	}
+
+	tests := []struct {
+		name      string
+		input     usecase.ImportSpotsInput
+		setupMock func(um *MockUserRepository, sm *MockSpotRepository, pm *MockPostRepository)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.ImportSpotsOutput)
+	}{
+		{
+			name:  "【正常系】新規作成・既存への合流・ファイル内重複・検証エラーを行ごとに振り分ける",
+			input: usecase.ImportSpotsInput{Username: "trapizzino_admin", Rows: rows},
+			setupMock: func(um *MockUserRepository, sm *MockSpotRepository, pm *MockPostRepository) {
+				um.On("FindByUsername", mock.Anything, "trapizzino_admin").Return(admin, nil)
+				sm.On("FindByLocation", mock.Anything, 35.6440, 139.6990).Return((*entities.Spot)(nil), nil).Once()
+				sm.On("Create", mock.MatchedBy(func(s *entities.Spot) bool {
+					return s.Name.String() == "中目黒ピッツァ" && s.Category == value_objects.CategoryPizza && s.RegisteredUserID == admin.ID
+				})).Return(created, nil).Once()
+				sm.On("FindByLocation", mock.Anything, 35.6467, 139.7101).Return(existing, nil)
+			},
+			check: func(t *testing.T, out *usecase.ImportSpotsOutput) {
+				assert.False(t, out.DryRun)
+				assert.Equal(t, 1, out.Created)
+				assert.Equal(t, 2, out.Merged)
+				assert.Equal(t, 3, out.Rejected)
+				assert.Equal(t, 0, out.PostsCreated)
+				if assert.Len(t, out.Rows, 6) {
+					assert.Equal(t, "created", out.Rows[0].Status)
+					assert.Equal(t, 50, *out.Rows[0].SpotID)
+					assert.Equal(t, "merged", out.Rows[1].Status)
+					assert.Equal(t, 5, *out.Rows[1].SpotID)
+					// ファイル内で先に作成した行へ合流する（再検索も再作成もしない）
+					assert.Equal(t, "merged", out.Rows[2].Status)
+					assert.Equal(t, 50, *out.Rows[2].SpotID)
+					assert.Equal(t, "rejected", out.Rows[3].Status)
+					assert.NotEmpty(t, out.Rows[3].Reason)
+					assert.Equal(t, "rejected", out.Rows[4].Status)
+					assert.Equal(t, "geometry must be a Point", out.Rows[5].Reason)
+				}
+			},
+		},
+		{
+			name:  "【正常系】dry-run では書き込みを行わず、作成予定の行は spot_id が null になる",
+			input: usecase.ImportSpotsInput{Username: "trapizzino_admin", Rows: rows[:3], WithPosts: true, DryRun: true},
+			setupMock: func(um *MockUserRepository, sm *MockSpotRepository, pm *MockPostRepository) {
+				um.On("FindByUsername", mock.Anything, "trapizzino_admin").Return(admin, nil)
+				sm.On("FindByLocation", mock.Anything, 35.6440, 139.6990).Return((*entities.Spot)(nil), nil).Once()
+				sm.On("FindByLocation", mock.Anything, 35.6467, 139.7101).Return(existing, nil)
+				pm.On("FindBySpotID", existing.ID, admin.ID).Return([]*entities.Post{}, nil)
+				// Create は呼ばれないはず
+			},
+			check: func(t *testing.T, out *usecase.ImportSpotsOutput) {
+				assert.True(t, out.DryRun)
+				assert.Equal(t, 1, out.Created)
+				assert.Equal(t, 2, out.Merged)
+				// 同一座標の重複行には2件目の投稿を作らない
+				assert.Equal(t, 2, out.PostsCreated)
+				assert.Nil(t, out.Rows[0].SpotID)
+				assert.False(t, out.Rows[2].PostCreated)
+			},
+		},
+		{
+			name:  "【正常系】-posts 指定時は投稿を作成し、既に投稿済みのスポットには追加しない",
+			input: usecase.ImportSpotsInput{Username: "trapizzino_admin", Rows: rows[:2], WithPosts: true},
+			setupMock: func(um *MockUserRepository, sm *MockSpotRepository, pm *MockPostRepository) {
+				um.On("FindByUsername", mock.Anything, "trapizzino_admin").Return(admin, nil)
+				sm.On("FindByLocation", mock.Anything, 35.6440, 139.6990).Return((*entities.Spot)(nil), nil)
+				sm.On("Create", mock.Anything).Return(created, nil)
+				pm.On("FindBySpotID", created.ID, admin.ID).Return([]*entities.Post{}, nil)
+				pm.On("Create", mock.MatchedBy(func(p *entities.Post) bool {
+					return p.SpotID == created.ID && p.UserID == admin.ID && p.Caption.String() == "窯焼き"
+				})).Return(adminPost, nil)
+				sm.On("FindByLocation", mock.Anything, 35.6467, 139.7101).Return(existing, nil)
+				pm.On("FindBySpotID", existing.ID, admin.ID).Return([]*entities.Post{adminPost}, nil)
+			},
+			check: func(t *testing.T, out *usecase.ImportSpotsOutput) {
+				assert.Equal(t, 1, out.PostsCreated)
+				assert.True(t, out.Rows[0].PostCreated)
+				assert.False(t, out.Rows[1].PostCreated)
+				assert.NotEmpty(t, out.Rows[1].Reason)
+			},
+		},
+		{
+			name:  "【異常系】スポット保存時にDBエラーが発生した場合は取り込みを中断する",
+			input: usecase.ImportSpotsInput{Username: "trapizzino_admin", Rows: rows[:1]},
+			setupMock: func(um *MockUserRepository, sm *MockSpotRepository, pm *MockPostRepository) {
+				um.On("FindByUsername", mock.Anything, "trapizzino_admin").Return(admin, nil)
+				sm.On("FindByLocation", mock.Anything, 35.6440, 139.6990).Return((*entities.Spot)(nil), nil)
+				sm.On("Create", mock.Anything).Return((*entities.Spot)(nil), errors.New("db insert error"))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "【異常系】登録者のユーザーが存在しない場合は入力エラー",
+			input: usecase.ImportSpotsInput{Username: "ghost", Rows: rows},
+			setupMock: func(um *MockUserRepository, sm *MockSpotRepository, pm *MockPostRepository) {
+				um.On("FindByUsername", mock.Anything, "ghost").Return((*entities.User)(nil), errors.New("sql: no rows in result set"))
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			um, sm, pm := new(MockUserRepository), new(MockSpotRepository), new(MockPostRepository)
+			tt.setupMock(um, sm, pm)
+			interactor := usecase.NewImportSpotsInteractor(&ImportSpotsMockPresenter{}, sm, pm, um)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			um.AssertExpectations(t)
+			sm.AssertExpectations(t)
+			pm.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+type MergeSpotsInput struct {
+	Token        string
+	SourceSpotID int
+	TargetSpotID int
+}
+
+type MergeSpotsOutput struct {
+	Message       string                  `json:"message"`
+	SourceSpotID  int                     `json:"source_spot_id"`
+	Spot          MergeSpotsSpotPayload   `json:"spot"`
+	MovedPosts    int                     `json:"moved_posts"`
+	DensityScores []MergeSpotsMeshDensity `json:"density_scores"`
+}
+
+type MergeSpotsSpotPayload struct {
+	ID               int                       `json:"id"`
+	Name             string                    `json:"name"`
+	MeshID           string                    `json:"mesh_id"`
+	Location         MergeSpotsLocationPayload `json:"location"`
+	RegisteredUserID int                       `json:"registered_user_id"`
+}
+
+type MergeSpotsLocationPayload struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+type MergeSpotsMeshDensity struct {
+	MeshID       string `json:"mesh_id"`
+	DensityScore int    `json:"density_score"`
+}
+
+// MergeSpotsMeshDomainItem は統合によって影響を受けたメッシュと、その再計算後の激戦区度です。
+type MergeSpotsMeshDomainItem struct {
+	MeshID  value_objects.MeshID
+	Density value_objects.DensityScore
+}
+
+type MergeSpotsPresenter interface {
+	Output(sourceID value_objects.ID, target *entities.Spot, movedPosts int, densities []MergeSpotsMeshDomainItem) *MergeSpotsOutput
+}
+
+type MergeSpotsUseCase interface {
+	Execute(ctx context.Context, input MergeSpotsInput) (*MergeSpotsOutput, error)
+}
+
+type mergeSpotsInteractor struct {
+	presenter   MergeSpotsPresenter
+	spotRepo    entities.SpotRepository
+	userRepo    entities.UserRepository
+	authService services.AuthDomainService
+}
+
+func NewMergeSpotsInteractor(
+	p MergeSpotsPresenter,
+	s entities.SpotRepository,
+	u entities.UserRepository,
+	a services.AuthDomainService,
+) MergeSpotsUseCase {
+	return &mergeSpotsInteractor{
+		presenter:   p,
+		spotRepo:    s,
+		userRepo:    u,
+		authService: a,
+	}
+}
+
+func (i *mergeSpotsInteractor) Execute(ctx context.Context, input MergeSpotsInput) (*MergeSpotsOutput, error) {
+	// 1. 操作者の特定と管理者権限の確認
+	// トークンには権限情報を載せていないため、最新のユーザー情報をDBから引き直して判定する。
+	tokenUser, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+	operator, err := i.userRepo.FindByID(tokenUser.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+	if !operator.IsAdmin {
+		return nil, ErrAdminRequired
+	}
+
+	sourceID, err := value_objects.NewID(input.SourceSpotID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	targetID, err := value_objects.NewID(input.TargetSpotID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	if sourceID == targetID {
+		return nil, fmt.Errorf("%w: source and target must be different spots", ErrInvalidInput)
+	}
+
+	// 2. 統合元・統合先の存在確認
+	// FindByID はリダイレクトを解決するため、統合済みの旧IDは統合先として返ってくる。
+	source, err := i.spotRepo.FindByID(ctx, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("repository error: %w", err)
+	}
+	if source == nil {
+		return nil, fmt.Errorf("%w: source %d", ErrSpotNotFound, sourceID.Value())
+	}
+	target, err := i.spotRepo.FindByID(ctx, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("repository error: %w", err)
+	}
+	if target == nil {
+		return nil, fmt.Errorf("%w: target %d", ErrSpotNotFound, targetID.Value())
+	}
+	if source.ID == target.ID {
+		return nil, fmt.Errorf("%w: spot %d is already merged into %d", ErrInvalidInput, sourceID.Value(), target.ID.Value())
+	}
+
+	// 3. 統合の実行（投稿・王座・リダイレクトを単一トランザクションで付け替える）
+	moved, err := i.spotRepo.Merge(ctx, source.ID, target.ID, operator.ID)
+	if err != nil {
+		return nil, fmt.Errorf("spot merge error: %w", err)
+	}
+
+	// 4. 統合後の状態を取得（王座が入れ替わっている可能性がある）
+	merged, err := i.spotRepo.FindByID(ctx, target.ID)
+	if err != nil {
+		return nil, fmt.Errorf("repository error: %w", err)
+	}
+	if merged == nil {
+		return nil, fmt.Errorf("%w: target %d", ErrSpotNotFound, target.ID.Value())
+	}
+
+	// 5. 影響を受けたメッシュの激戦区度を再計算して返す
+	meshes := []value_objects.MeshID{merged.MeshID}
+	if source.MeshID != merged.MeshID {
+		meshes = append(meshes, source.MeshID)
+	}
+	densities := make([]MergeSpotsMeshDomainItem, 0, len(meshes))
+	for _, m := range meshes {
+		density, err := i.spotRepo.GetDensityScoreByMesh(ctx, m, operator.ID)
+		if err != nil {
+			return nil, fmt.Errorf("density calculation error: %w", err)
+		}
+		densities = append(densities, MergeSpotsMeshDomainItem{MeshID: m, Density: density})
+	}
+
+	return i.presenter.Output(source.ID, merged, moved, densities), nil
+}
@@ -0,0 +1,208 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- MOCK 定義 ---
+
+type MockUserRepository struct{ mock.Mock }
+
+func (m *MockUserRepository) FindByID(id value_objects.ID) (*entities.User, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.User), args.Error(1)
+}
+func (m *MockUserRepository) FindByUsername(ctx context.Context, username string) (*entities.User, error) {
+	args := m.Called(ctx, username)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.User), args.Error(1)
+}
+func (m *MockUserRepository) Create(user *entities.User) (*entities.User, error) { return nil, nil }
+func (m *MockUserRepository) FindByEmail(email value_objects.Email) (*entities.User, error) {
+	return nil, nil
+}
+func (m *MockUserRepository) Update(user *entities.User) error { return m.Called(user).Error(0) }
+func (m *MockUserRepository) Delete(id value_objects.ID) error { return nil }
+
+type MergeSpotsMockPresenter struct{}
+
+func (p *MergeSpotsMockPresenter) Output(sourceID value_objects.ID, target *entities.Spot, movedPosts int, densities []usecase.MergeSpotsMeshDomainItem) *usecase.MergeSpotsOutput {
+	out := &usecase.MergeSpotsOutput{
+		SourceSpotID: sourceID.Value(),
+		Spot: usecase.MergeSpotsSpotPayload{
+			ID:               target.ID.Value(),
+			Name:             target.Name.String(),
+			RegisteredUserID: target.RegisteredUserID.Value(),
+		},
+		MovedPosts: movedPosts,
+	}
+	for _, d := range densities {
+		out.DensityScores = append(out.DensityScores, usecase.MergeSpotsMeshDensity{MeshID: d.MeshID.String(), DensityScore: d.Density.Int()})
+	}
+	return out
+}
+
+// --- TEST 本体 ---
+
+func TestMergeSpots_Execute(t *testing.T) {
+	admin, _ := entities.NewUser(1, "trapizzino_admin", "admin@example.com", "hashed_password")
+	admin.IsAdmin = true
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+
+	source, _ := entities.NewSpot(10, "恵比寿うどん", 35.64670, 139.71010, 2)
+	target, _ := entities.NewSpot(11, "恵比寿うどん本店", 35.64671, 139.71012, 3)
+	farSource, _ := entities.NewSpot(12, "恵比寿うどん(旧)", 35.66, 139.73, 4)
+	mergedTarget, _ := entities.NewSpot(11, "恵比寿うどん本店", 35.64671, 139.71012, 2)
+
+	tests := []struct {
+		name      string
+		input     usecase.MergeSpotsInput
+		setupMock func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.MergeSpotsOutput)
+	}{
+		{
+			name:  "【正常系】管理者は統合元の投稿を統合先へ付け替え、再判定後の王座を返す",
+			input: usecase.MergeSpotsInput{Token: "admin_token", SourceSpotID: 10, TargetSpotID: 11},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "admin_token").Return(admin, nil)
+				um.On("FindByID", admin.ID).Return(admin, nil)
+				sm.On("FindByID", mock.Anything, source.ID).Return(source, nil)
+				sm.On("FindByID", mock.Anything, target.ID).Return(target, nil).Once()
+				sm.On("Merge", mock.Anything, source.ID, target.ID, admin.ID).Return(3, nil)
+				sm.On("FindByID", mock.Anything, target.ID).Return(mergedTarget, nil).Once()
+			},
+			check: func(t *testing.T, out *usecase.MergeSpotsOutput) {
+				assert.Equal(t, 10, out.SourceSpotID)
+				assert.Equal(t, 11, out.Spot.ID)
+				assert.Equal(t, 2, out.Spot.RegisteredUserID)
+				assert.Equal(t, 3, out.MovedPosts)
+				// 同一メッシュ内の統合なので、再計算対象のメッシュは1つ
+				assert.Len(t, out.DensityScores, 1)
+			},
+		},
+		{
+			name:  "【正常系】メッシュを跨ぐ統合では、統合元メッシュの激戦区度も再計算する",
+			input: usecase.MergeSpotsInput{Token: "admin_token", SourceSpotID: 12, TargetSpotID: 11},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "admin_token").Return(admin, nil)
+				um.On("FindByID", admin.ID).Return(admin, nil)
+				sm.On("FindByID", mock.Anything, farSource.ID).Return(farSource, nil)
+				sm.On("FindByID", mock.Anything, target.ID).Return(target, nil)
+				sm.On("Merge", mock.Anything, farSource.ID, target.ID, admin.ID).Return(1, nil)
+			},
+			check: func(t *testing.T, out *usecase.MergeSpotsOutput) {
+				if assert.Len(t, out.DensityScores, 2) {
+					assert.Equal(t, target.MeshID.String(), out.DensityScores[0].MeshID)
+					assert.Equal(t, farSource.MeshID.String(), out.DensityScores[1].MeshID)
+				}
+			},
+		},
+		{
+			name:  "【異常系】管理者でないユーザーは統合できない",
+			input: usecase.MergeSpotsInput{Token: "valid_token", SourceSpotID: 10, TargetSpotID: 11},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				um.On("FindByID", malloy.ID).Return(malloy, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrAdminRequired,
+		},
+		{
+			name:  "【異常系】統合元と統合先が同じIDの場合はエラー",
+			input: usecase.MergeSpotsInput{Token: "admin_token", SourceSpotID: 11, TargetSpotID: 11},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "admin_token").Return(admin, nil)
+				um.On("FindByID", admin.ID).Return(admin, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】統合済みの旧IDがリダイレクトで統合先に解決される場合は二重統合しない",
+			input: usecase.MergeSpotsInput{Token: "admin_token", SourceSpotID: 10, TargetSpotID: 11},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "admin_token").Return(admin, nil)
+				um.On("FindByID", admin.ID).Return(admin, nil)
+				sm.On("FindByID", mock.Anything, source.ID).Return(target, nil)
+				sm.On("FindByID", mock.Anything, target.ID).Return(target, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】統合先が存在しない場合は not found",
+			input: usecase.MergeSpotsInput{Token: "admin_token", SourceSpotID: 10, TargetSpotID: 404},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "admin_token").Return(admin, nil)
+				um.On("FindByID", admin.ID).Return(admin, nil)
+				sm.On("FindByID", mock.Anything, source.ID).Return(source, nil)
+				sm.On("FindByID", mock.Anything, value_objects.ID(404)).Return((*entities.Spot)(nil), nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrSpotNotFound,
+		},
+		{
+			name:  "【異常系】トランザクション内でエラーが発生した場合はエラーを返す",
+			input: usecase.MergeSpotsInput{Token: "admin_token", SourceSpotID: 10, TargetSpotID: 11},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "admin_token").Return(admin, nil)
+				um.On("FindByID", admin.ID).Return(admin, nil)
+				sm.On("FindByID", mock.Anything, source.ID).Return(source, nil)
+				sm.On("FindByID", mock.Anything, target.ID).Return(target, nil)
+				sm.On("Merge", mock.Anything, source.ID, target.ID, admin.ID).Return(0, errors.New("tx aborted"))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "【異常系】トークンが不正な場合は認証エラー",
+			input: usecase.MergeSpotsInput{Token: "bad_token", SourceSpotID: 10, TargetSpotID: 11},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "bad_token").Return((*entities.User)(nil), errors.New("invalid token"))
+			},
+			wantErr: true,
+			errIs:   usecase.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, um, sm := new(MockAuthService), new(MockUserRepository), new(MockSpotRepository)
+			tt.setupMock(am, um, sm)
+			interactor := usecase.NewMergeSpotsInteractor(&MergeSpotsMockPresenter{}, sm, um, am)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			am.AssertExpectations(t)
+			um.AssertExpectations(t)
+			sm.AssertExpectations(t)
+		})
+	}
+}
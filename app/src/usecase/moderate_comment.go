@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+type ModerateCommentInput struct {
+	Token     string
+	CommentID int
+	// Status は visible（表示に戻す）または hidden（投稿者本人以外には表示しない）。
+	Status string
+	Reason string
+}
+
+type ModerateCommentUseCase interface {
+	Execute(ctx context.Context, input ModerateCommentInput) (*CommentPayload, error)
+}
+
+type moderateCommentInteractor struct {
+	presenter   CommentPresenter
+	commentRepo entities.CommentRepository
+	userRepo    entities.UserRepository
+	authService services.AuthDomainService
+}
+
+func NewModerateCommentInteractor(
+	p CommentPresenter,
+	c entities.CommentRepository,
+	u entities.UserRepository,
+	a services.AuthDomainService,
+) ModerateCommentUseCase {
+	return &moderateCommentInteractor{
+		presenter:   p,
+		commentRepo: c,
+		userRepo:    u,
+		authService: a,
+	}
+}
+
+func (i *moderateCommentInteractor) Execute(ctx context.Context, input ModerateCommentInput) (*CommentPayload, error) {
+	// 1. 操作者の特定と管理者権限の確認
+	tokenUser, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+	operator, err := i.userRepo.FindByID(tokenUser.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+	if !operator.IsAdmin {
+		return nil, ErrAdminRequired
+	}
+
+	status := entities.CommentStatus(input.Status)
+	if status != entities.CommentVisible && status != entities.CommentHidden {
+		return nil, fmt.Errorf("%w: status must be \"visible\" or \"hidden\"", ErrInvalidInput)
+	}
+	commentID, err := value_objects.NewID(input.CommentID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	// 2. 公開状態の変更（自動判定で非表示になったコメントの復元を含む）
+	comment, err := i.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		return nil, fmt.Errorf("comment lookup error: %w", err)
+	}
+	if comment == nil {
+		return nil, fmt.Errorf("%w: comment %d", ErrNotFound, commentID.Value())
+	}
+	comment.Status = status
+	comment.ModerationReason = ""
+	if status == entities.CommentHidden {
+		comment.ModerationReason = input.Reason
+	}
+	if err := i.commentRepo.Update(ctx, comment); err != nil {
+		return nil, fmt.Errorf("comment save error: %w", err)
+	}
+	return i.presenter.Output(comment), nil
+}
@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+type PostCommentInput struct {
+	Token  string
+	PostID int
+	// ParentID は返信先のトップレベルのコメントです（0 ならトップレベルのコメント）。返信への返信はできない。
+	ParentID int
+	Body     string
+}
+
+// CommentPayload はコメント1件分です。削除済みのコメント（返信が残っているもの）は本文を伏せて deleted=true で返します。
+type CommentPayload struct {
+	ID         int              `json:"id"`
+	PostID     int              `json:"post_id"`
+	ParentID   *int             `json:"parent_id"`
+	UserID     int              `json:"user_id"`
+	UserName   string           `json:"user_name"`
+	Body       string           `json:"body"`
+	Status     string           `json:"status"`
+	Deleted    bool             `json:"deleted"`
+	CreatedAt  string           `json:"created_at"`
+	UpdatedAt  *string          `json:"updated_at"`
+	ReplyCount int              `json:"reply_count"`
+	Replies    []CommentPayload `json:"replies,omitempty"`
+}
+
+// CommentPresenter はコメントの作成・編集・モデレーションの結果を整形します。
+type CommentPresenter interface {
+	Output(comment *entities.Comment) *CommentPayload
+}
+
+type PostCommentUseCase interface {
+	Execute(ctx context.Context, input PostCommentInput) (*CommentPayload, error)
+}
+
+type postCommentInteractor struct {
+	presenter   CommentPresenter
+	postRepo    entities.PostRepository
+	commentRepo entities.CommentRepository
+	moderator   services.CommentModerator
+	authService services.AuthDomainService
+}
+
+func NewPostCommentInteractor(
+	p CommentPresenter,
+	r entities.PostRepository,
+	c entities.CommentRepository,
+	m services.CommentModerator,
+	a services.AuthDomainService,
+) PostCommentUseCase {
+	return &postCommentInteractor{
+		presenter:   p,
+		postRepo:    r,
+		commentRepo: c,
+		moderator:   m,
+		authService: a,
+	}
+}
+
+func (i *postCommentInteractor) Execute(ctx context.Context, input PostCommentInput) (*CommentPayload, error) {
+	user, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	var parentID *int
+	if input.ParentID != 0 {
+		parentID = &input.ParentID
+	}
+	comment, err := entities.NewComment(input.PostID, user.ID.Value(), parentID, input.Body, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	comment.Username = user.Username
+
+	// 1. 対象の投稿の確認（上書きされた過去の投稿にはコメントできない）
+	post, err := i.postRepo.FindByID(comment.PostID, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("post lookup error: %w", err)
+	}
+	if post == nil {
+		return nil, fmt.Errorf("%w: post %d", ErrNotFound, comment.PostID.Value())
+	}
+	if !post.IsCurrent() {
+		return nil, fmt.Errorf("%w: post %d has been superseded", ErrConflict, post.ID.Value())
+	}
+
+	// 2. 返信先の確認（同じ投稿のトップレベルのコメントにだけ返信できる）
+	if comment.ParentID != nil {
+		parent, err := i.commentRepo.FindByID(ctx, *comment.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("comment lookup error: %w", err)
+		}
+		if parent == nil || parent.PostID != post.ID || !canViewComment(parent, user.ID) {
+			return nil, fmt.Errorf("%w: comment %d", ErrNotFound, comment.ParentID.Value())
+		}
+		if parent.IsReply() {
+			return nil, fmt.Errorf("%w: replies cannot be nested", ErrInvalidInput)
+		}
+		if parent.IsDeleted() {
+			return nil, fmt.Errorf("%w: comment %d has been deleted", ErrConflict, parent.ID.Value())
+		}
+	}
+
+	// 3. 本文の審査（禁止語などに該当すれば投稿者本人にだけ表示する）
+	if err := moderateComment(ctx, i.moderator, comment); err != nil {
+		return nil, err
+	}
+
+	comment, err = i.commentRepo.Create(ctx, comment)
+	if err != nil {
+		return nil, fmt.Errorf("comment save error: %w", err)
+	}
+	return i.presenter.Output(comment), nil
+}
+
+// moderateComment は本文を審査し、非表示と判定されたコメントを hidden にします。
+// 審査で表示に戻すことはしない（運営者が非表示にしたコメントは、編集しても運営者が戻すまで非表示のまま）。
+func moderateComment(ctx context.Context, moderator services.CommentModerator, comment *entities.Comment) error {
+	result, err := moderator.Review(ctx, comment.Body)
+	if err != nil {
+		return fmt.Errorf("comment moderation error: %w", err)
+	}
+	if result.Hide {
+		comment.Status = entities.CommentHidden
+		comment.ModerationReason = result.Reason
+	}
+	return nil
+}
+
+// canViewComment は、閲覧者がコメントを見られるかどうか（非表示のコメントは本人だけ）を返します。
+func canViewComment(comment *entities.Comment, viewerID value_objects.ID) bool {
+	return comment.Status == entities.CommentVisible || comment.UserID == viewerID
+}
@@ -0,0 +1,502 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCommentRepository struct {
+	mock.Mock
+}
+
+func (m *MockCommentRepository) Create(ctx context.Context, c *entities.Comment) (*entities.Comment, error) {
+	args := m.Called(ctx, c)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Comment), args.Error(1)
+}
+
+func (m *MockCommentRepository) FindByID(ctx context.Context, id value_objects.ID) (*entities.Comment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Comment), args.Error(1)
+}
+
+func (m *MockCommentRepository) Update(ctx context.Context, c *entities.Comment) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+
+func (m *MockCommentRepository) FindByPost(ctx context.Context, postID, viewerID value_objects.ID, after *entities.CommentCursor, limit, repliesPerComment int) ([]*entities.Comment, error) {
+	args := m.Called(ctx, postID, viewerID, after, limit, repliesPerComment)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Comment), args.Error(1)
+}
+
+func (m *MockCommentRepository) FindReplies(ctx context.Context, parentID, viewerID value_objects.ID, after *entities.CommentCursor, limit int) ([]*entities.Comment, error) {
+	args := m.Called(ctx, parentID, viewerID, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Comment), args.Error(1)
+}
+
+type MockCommentModerator struct {
+	mock.Mock
+}
+
+func (m *MockCommentModerator) Review(ctx context.Context, body value_objects.CommentBody) (services.CommentModeration, error) {
+	args := m.Called(ctx, body)
+	return args.Get(0).(services.CommentModeration), args.Error(1)
+}
+
+type CommentMockPresenter struct{}
+
+func (p *CommentMockPresenter) Output(c *entities.Comment) *usecase.CommentPayload {
+	out := &usecase.CommentPayload{
+		ID:      c.ID.Value(),
+		PostID:  c.PostID.Value(),
+		UserID:  c.UserID.Value(),
+		Body:    c.Body.String(),
+		Status:  string(c.Status),
+		Deleted: c.IsDeleted(),
+	}
+	if c.ParentID != nil {
+		parentID := c.ParentID.Value()
+		out.ParentID = &parentID
+	}
+	return out
+}
+
+type CommentListMockPresenter struct{}
+
+func (p *CommentListMockPresenter) Output(comments []*entities.Comment, nextCursor string) *usecase.CommentListResponse {
+	out := &usecase.CommentListResponse{Comments: []usecase.CommentPayload{}}
+	for _, c := range comments {
+		out.Comments = append(out.Comments, *(&CommentMockPresenter{}).Output(c))
+	}
+	if nextCursor != "" {
+		out.NextCursor = &nextCursor
+	}
+	return out
+}
+
+func newTestComment(id, postID, userID int, parentID *int, body string, createdAt time.Time) *entities.Comment {
+	c, _ := entities.NewComment(postID, userID, parentID, body, createdAt)
+	c.ID, _ = value_objects.NewID(id)
+	return c
+}
+
+func TestPostComment_Execute(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	post, _ := entities.NewPost(10, 1, 5, "jiro_lover", "", "#二郎系 最高", time.Now())
+	superseded, _ := entities.NewPost(12, 1, 5, "jiro_lover", "", "古い投稿", time.Now())
+	supersededAt := time.Now()
+	superseded.SupersededAt = &supersededAt
+
+	parentID, replyID := 30, 31
+	topLevel := newTestComment(30, 10, 1, nil, "ニンニク入れますか", time.Now())
+	reply := newTestComment(31, 10, 3, &parentID, "全マシで", time.Now())
+	otherPost := newTestComment(40, 11, 1, nil, "別の投稿へのコメント", time.Now())
+	otherPostID := 40
+
+	created := newTestComment(50, 10, 2, nil, "行ってみたい！", time.Now())
+	createdReply := newTestComment(51, 10, 2, &parentID, "マシマシで", time.Now())
+	createdHidden := newTestComment(52, 10, 2, nil, "スパム", time.Now())
+	createdHidden.Status = entities.CommentHidden
+
+	tests := []struct {
+		name      string
+		input     usecase.PostCommentInput
+		setupMock func(am *MockAuthService, pm *MockPostRepository, cm *MockCommentRepository, mm *MockCommentModerator)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.CommentPayload)
+	}{
+		{
+			name:  "【正常系】トップレベルのコメントを作成する",
+			input: usecase.PostCommentInput{Token: "valid_token", PostID: 10, Body: "  行ってみたい！ "},
+			setupMock: func(am *MockAuthService, pm *MockPostRepository, cm *MockCommentRepository, mm *MockCommentModerator) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				pm.On("FindByID", value_objects.ID(10), malloy.ID).Return(post, nil)
+				mm.On("Review", mock.Anything, value_objects.CommentBody("行ってみたい！")).Return(services.CommentModeration{}, nil)
+				cm.On("Create", mock.Anything, mock.MatchedBy(func(c *entities.Comment) bool {
+					return c.PostID.Value() == 10 && c.UserID.Value() == 2 && c.ParentID == nil
+				})).Return(created, nil)
+			},
+			check: func(t *testing.T, out *usecase.CommentPayload) {
+				assert.Equal(t, 50, out.ID)
+				assert.Nil(t, out.ParentID)
+				assert.Equal(t, "visible", out.Status)
+			},
+		},
+		{
+			name:  "【正常系】トップレベルのコメントに返信する",
+			input: usecase.PostCommentInput{Token: "valid_token", PostID: 10, ParentID: 30, Body: "マシマシで"},
+			setupMock: func(am *MockAuthService, pm *MockPostRepository, cm *MockCommentRepository, mm *MockCommentModerator) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				pm.On("FindByID", value_objects.ID(10), malloy.ID).Return(post, nil)
+				cm.On("FindByID", mock.Anything, value_objects.ID(30)).Return(topLevel, nil)
+				mm.On("Review", mock.Anything, mock.Anything).Return(services.CommentModeration{}, nil)
+				cm.On("Create", mock.Anything, mock.MatchedBy(func(c *entities.Comment) bool {
+					return c.ParentID != nil && c.ParentID.Value() == 30
+				})).Return(createdReply, nil)
+			},
+			check: func(t *testing.T, out *usecase.CommentPayload) {
+				if assert.NotNil(t, out.ParentID) {
+					assert.Equal(t, 30, *out.ParentID)
+				}
+			},
+		},
+		{
+			name:  "【正常系】禁止語に該当したコメントは非表示で保存される",
+			input: usecase.PostCommentInput{Token: "valid_token", PostID: 10, Body: "スパム"},
+			setupMock: func(am *MockAuthService, pm *MockPostRepository, cm *MockCommentRepository, mm *MockCommentModerator) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				pm.On("FindByID", value_objects.ID(10), malloy.ID).Return(post, nil)
+				mm.On("Review", mock.Anything, mock.Anything).Return(services.CommentModeration{Hide: true, Reason: "blocked word"}, nil)
+				cm.On("Create", mock.Anything, mock.MatchedBy(func(c *entities.Comment) bool {
+					return c.Status == entities.CommentHidden && c.ModerationReason == "blocked word"
+				})).Return(createdHidden, nil)
+			},
+			check: func(t *testing.T, out *usecase.CommentPayload) {
+				assert.Equal(t, "hidden", out.Status)
+			},
+		},
+		{
+			name:  "【異常系】返信への返信はできない",
+			input: usecase.PostCommentInput{Token: "valid_token", PostID: 10, ParentID: replyID, Body: "さらに返信"},
+			setupMock: func(am *MockAuthService, pm *MockPostRepository, cm *MockCommentRepository, mm *MockCommentModerator) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				pm.On("FindByID", value_objects.ID(10), malloy.ID).Return(post, nil)
+				cm.On("FindByID", mock.Anything, value_objects.ID(31)).Return(reply, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】別の投稿のコメントには返信できない",
+			input: usecase.PostCommentInput{Token: "valid_token", PostID: 10, ParentID: otherPostID, Body: "返信"},
+			setupMock: func(am *MockAuthService, pm *MockPostRepository, cm *MockCommentRepository, mm *MockCommentModerator) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				pm.On("FindByID", value_objects.ID(10), malloy.ID).Return(post, nil)
+				cm.On("FindByID", mock.Anything, value_objects.ID(40)).Return(otherPost, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrNotFound,
+		},
+		{
+			name:  "【異常系】上書きされた過去の投稿にはコメントできない",
+			input: usecase.PostCommentInput{Token: "valid_token", PostID: 12, Body: "コメント"},
+			setupMock: func(am *MockAuthService, pm *MockPostRepository, cm *MockCommentRepository, mm *MockCommentModerator) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				pm.On("FindByID", value_objects.ID(12), malloy.ID).Return(superseded, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrConflict,
+		},
+		{
+			name:  "【異常系】空のコメントは入力エラー",
+			input: usecase.PostCommentInput{Token: "valid_token", PostID: 10, Body: "   "},
+			setupMock: func(am *MockAuthService, pm *MockPostRepository, cm *MockCommentRepository, mm *MockCommentModerator) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】保存時にDBエラーが発生した場合",
+			input: usecase.PostCommentInput{Token: "valid_token", PostID: 10, Body: "コメント"},
+			setupMock: func(am *MockAuthService, pm *MockPostRepository, cm *MockCommentRepository, mm *MockCommentModerator) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				pm.On("FindByID", value_objects.ID(10), malloy.ID).Return(post, nil)
+				mm.On("Review", mock.Anything, mock.Anything).Return(services.CommentModeration{}, nil)
+				cm.On("Create", mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, pm, cm, mm := new(MockAuthService), new(MockPostRepository), new(MockCommentRepository), new(MockCommentModerator)
+			tt.setupMock(am, pm, cm, mm)
+			interactor := usecase.NewPostCommentInteractor(&CommentMockPresenter{}, pm, cm, mm, am)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			am.AssertExpectations(t)
+			pm.AssertExpectations(t)
+			cm.AssertExpectations(t)
+			mm.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateComment_Execute(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+
+	t.Run("【正常系】投稿者本人は本文を編集でき、編集時刻が記録される", func(t *testing.T) {
+		am, cm, mm := new(MockAuthService), new(MockCommentRepository), new(MockCommentModerator)
+		comment := newTestComment(30, 10, 2, nil, "ニンニク入れますか", time.Now())
+		am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+		cm.On("FindByID", mock.Anything, value_objects.ID(30)).Return(comment, nil)
+		mm.On("Review", mock.Anything, value_objects.CommentBody("ニンニク少なめで")).Return(services.CommentModeration{}, nil)
+		cm.On("Update", mock.Anything, mock.MatchedBy(func(c *entities.Comment) bool {
+			return c.Body.String() == "ニンニク少なめで" && c.UpdatedAt != nil
+		})).Return(nil)
+		interactor := usecase.NewUpdateCommentInteractor(&CommentMockPresenter{}, cm, mm, am)
+
+		out, err := interactor.Execute(context.Background(), usecase.UpdateCommentInput{Token: "valid_token", CommentID: 30, Body: "ニンニク少なめで"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ニンニク少なめで", out.Body)
+		am.AssertExpectations(t)
+		cm.AssertExpectations(t)
+		mm.AssertExpectations(t)
+	})
+
+	t.Run("【異常系】他人のコメントは編集できない", func(t *testing.T) {
+		am, cm, mm := new(MockAuthService), new(MockCommentRepository), new(MockCommentModerator)
+		comment := newTestComment(30, 10, 1, nil, "ニンニク入れますか", time.Now())
+		am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+		cm.On("FindByID", mock.Anything, value_objects.ID(30)).Return(comment, nil)
+		interactor := usecase.NewUpdateCommentInteractor(&CommentMockPresenter{}, cm, mm, am)
+
+		out, err := interactor.Execute(context.Background(), usecase.UpdateCommentInput{Token: "valid_token", CommentID: 30, Body: "書き換え"})
+
+		assert.ErrorIs(t, err, usecase.ErrForbidden)
+		assert.Nil(t, out)
+		cm.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("【異常系】削除済みのコメントは編集できない", func(t *testing.T) {
+		am, cm, mm := new(MockAuthService), new(MockCommentRepository), new(MockCommentModerator)
+		comment := newTestComment(30, 10, 2, nil, "ニンニク入れますか", time.Now())
+		deletedAt := time.Now()
+		comment.DeletedAt = &deletedAt
+		am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+		cm.On("FindByID", mock.Anything, value_objects.ID(30)).Return(comment, nil)
+		interactor := usecase.NewUpdateCommentInteractor(&CommentMockPresenter{}, cm, mm, am)
+
+		_, err := interactor.Execute(context.Background(), usecase.UpdateCommentInput{Token: "valid_token", CommentID: 30, Body: "書き換え"})
+
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+	})
+}
+
+func TestDeleteComment_Execute(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	admin, _ := entities.NewUser(9, "operator", "operator@example.com", "hashed_password")
+	admin.IsAdmin = true
+
+	tests := []struct {
+		name      string
+		operator  *entities.User
+		authorID  int
+		setupMock func(um *MockUserRepository)
+		errIs     error
+	}{
+		{name: "【正常系】投稿者本人は削除できる", operator: malloy, authorID: 2, setupMock: func(um *MockUserRepository) {}},
+		{
+			name: "【正常系】管理者は他人のコメントを削除できる", operator: admin, authorID: 1,
+			setupMock: func(um *MockUserRepository) {
+				um.On("FindByID", value_objects.ID(9)).Return(admin, nil)
+			},
+		},
+		{
+			name: "【異常系】管理者でなければ他人のコメントは削除できない", operator: malloy, authorID: 1,
+			setupMock: func(um *MockUserRepository) {
+				um.On("FindByID", value_objects.ID(2)).Return(malloy, nil)
+			},
+			errIs: usecase.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, cm, um := new(MockAuthService), new(MockCommentRepository), new(MockUserRepository)
+			comment := newTestComment(30, 10, tt.authorID, nil, "ニンニク入れますか", time.Now())
+			am.On("VerifyToken", mock.Anything, "valid_token").Return(tt.operator, nil)
+			cm.On("FindByID", mock.Anything, value_objects.ID(30)).Return(comment, nil)
+			if tt.errIs == nil {
+				cm.On("Update", mock.Anything, mock.MatchedBy(func(c *entities.Comment) bool { return c.IsDeleted() })).Return(nil)
+			}
+			tt.setupMock(um)
+			interactor := usecase.NewDeleteCommentInteractor(cm, um, am)
+
+			err := interactor.Execute(context.Background(), usecase.DeleteCommentInput{Token: "valid_token", CommentID: 30})
+
+			if tt.errIs != nil {
+				assert.ErrorIs(t, err, tt.errIs)
+				assert.False(t, comment.IsDeleted())
+			} else {
+				assert.NoError(t, err)
+			}
+			am.AssertExpectations(t)
+			cm.AssertExpectations(t)
+			um.AssertExpectations(t)
+		})
+	}
+}
+
+func TestModerateComment_Execute(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	admin, _ := entities.NewUser(9, "operator", "operator@example.com", "hashed_password")
+	admin.IsAdmin = true
+
+	t.Run("【正常系】管理者は自動判定で非表示になったコメントを表示に戻せる", func(t *testing.T) {
+		am, cm, um := new(MockAuthService), new(MockCommentRepository), new(MockUserRepository)
+		comment := newTestComment(30, 10, 2, nil, "誤判定されたコメント", time.Now())
+		comment.Status = entities.CommentHidden
+		comment.ModerationReason = "blocked word"
+		am.On("VerifyToken", mock.Anything, "admin_token").Return(admin, nil)
+		um.On("FindByID", value_objects.ID(9)).Return(admin, nil)
+		cm.On("FindByID", mock.Anything, value_objects.ID(30)).Return(comment, nil)
+		cm.On("Update", mock.Anything, mock.MatchedBy(func(c *entities.Comment) bool {
+			return c.Status == entities.CommentVisible && c.ModerationReason == ""
+		})).Return(nil)
+		interactor := usecase.NewModerateCommentInteractor(&CommentMockPresenter{}, cm, um, am)
+
+		out, err := interactor.Execute(context.Background(), usecase.ModerateCommentInput{Token: "admin_token", CommentID: 30, Status: "visible"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "visible", out.Status)
+		cm.AssertExpectations(t)
+	})
+
+	t.Run("【異常系】管理者以外は実行できない", func(t *testing.T) {
+		am, cm, um := new(MockAuthService), new(MockCommentRepository), new(MockUserRepository)
+		am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+		um.On("FindByID", value_objects.ID(2)).Return(malloy, nil)
+		interactor := usecase.NewModerateCommentInteractor(&CommentMockPresenter{}, cm, um, am)
+
+		_, err := interactor.Execute(context.Background(), usecase.ModerateCommentInput{Token: "valid_token", CommentID: 30, Status: "hidden"})
+
+		assert.ErrorIs(t, err, usecase.ErrAdminRequired)
+		cm.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetPostComments_Execute(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	post, _ := entities.NewPost(10, 1, 5, "jiro_lover", "", "#二郎系 最高", time.Now())
+	base := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	c1 := newTestComment(30, 10, 1, nil, "1件目", base)
+	c2 := newTestComment(31, 10, 3, nil, "2件目", base.Add(time.Minute))
+	c3 := newTestComment(32, 10, 4, nil, "3件目", base.Add(2*time.Minute))
+
+	am, pm, cm := new(MockAuthService), new(MockPostRepository), new(MockCommentRepository)
+	am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+	pm.On("FindByID", value_objects.ID(10), malloy.ID).Return(post, nil)
+	cm.On("FindByPost", mock.Anything, value_objects.ID(10), value_objects.ID(2), (*entities.CommentCursor)(nil), 3, usecase.CommentRepliesPreview).
+		Return([]*entities.Comment{c1, c2, c3}, nil)
+	interactor := usecase.NewGetPostCommentsInteractor(&CommentListMockPresenter{}, pm, cm, am)
+
+	out, err := interactor.Execute(context.Background(), usecase.GetPostCommentsInput{Token: "valid_token", PostID: 10, Limit: 2})
+
+	assert.NoError(t, err)
+	if assert.Len(t, out.Comments, 2) {
+		assert.Equal(t, 30, out.Comments[0].ID)
+		assert.Equal(t, 31, out.Comments[1].ID)
+	}
+	if assert.NotNil(t, out.NextCursor) {
+		// 次ページは2件目（c2）より新しいコメントから始まる
+		cm2 := new(MockCommentRepository)
+		cm2.On("FindByPost", mock.Anything, value_objects.ID(10), value_objects.ID(2), mock.MatchedBy(func(c *entities.CommentCursor) bool {
+			return c != nil && c.CreatedAt.Equal(c2.CreatedAt) && c.ID.Value() == 31
+		}), 21, usecase.CommentRepliesPreview).Return([]*entities.Comment{c3}, nil)
+		next := usecase.NewGetPostCommentsInteractor(&CommentListMockPresenter{}, pm, cm2, am)
+
+		out2, err := next.Execute(context.Background(), usecase.GetPostCommentsInput{Token: "valid_token", PostID: 10, Cursor: *out.NextCursor})
+
+		assert.NoError(t, err)
+		assert.Len(t, out2.Comments, 1)
+		assert.Nil(t, out2.NextCursor)
+		cm2.AssertExpectations(t)
+	}
+	cm.AssertExpectations(t)
+}
+
+func TestGetCommentReplies_Execute(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	post, _ := entities.NewPost(10, 1, 5, "jiro_lover", "", "#二郎系 最高", time.Now())
+	base := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	parent := newTestComment(30, 10, 1, nil, "親コメント", base)
+	parentID := 30
+	reply := newTestComment(31, 10, 3, &parentID, "返信", base.Add(time.Minute))
+
+	tests := []struct {
+		name      string
+		setupMock func(pm *MockPostRepository, cm *MockCommentRepository)
+		wantErr   bool
+		errIs     error
+	}{
+		{
+			name: "【正常系】見える投稿へのコメントの返信を返す",
+			setupMock: func(pm *MockPostRepository, cm *MockCommentRepository) {
+				cm.On("FindByID", mock.Anything, value_objects.ID(30)).Return(parent, nil)
+				pm.On("FindByID", value_objects.ID(10), malloy.ID).Return(post, nil)
+				cm.On("FindReplies", mock.Anything, value_objects.ID(30), malloy.ID, (*entities.CommentCursor)(nil), 21).
+					Return([]*entities.Comment{reply}, nil)
+			},
+		},
+		{
+			name: "【異常系】閲覧者に見えない投稿へのコメントの返信は NotFound",
+			setupMock: func(pm *MockPostRepository, cm *MockCommentRepository) {
+				cm.On("FindByID", mock.Anything, value_objects.ID(30)).Return(parent, nil)
+				pm.On("FindByID", value_objects.ID(10), malloy.ID).Return((*entities.Post)(nil), nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, pm, cm := new(MockAuthService), new(MockPostRepository), new(MockCommentRepository)
+			am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			tt.setupMock(pm, cm)
+			interactor := usecase.NewGetCommentRepliesInteractor(&CommentListMockPresenter{}, pm, cm, am)
+
+			out, err := interactor.Execute(context.Background(), usecase.GetCommentRepliesInput{Token: "valid_token", CommentID: 30})
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.errIs)
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, out.Comments, 1)
+			}
+			pm.AssertExpectations(t)
+			cm.AssertExpectations(t)
+		})
+	}
+}
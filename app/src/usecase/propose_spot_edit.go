@@ -0,0 +1,187 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+// DefaultSpotEditApplyThreshold は、修正提案を自動適用（または却下）する純得票数の既定値です。
+const DefaultSpotEditApplyThreshold = 3
+
+type ProposeSpotEditInput struct {
+	Token     string
+	SpotID    int
+	Kind      string
+	Name      string
+	Latitude  float64
+	Longitude float64
+	Category  string
+}
+
+type SpotEditProposalResponse struct {
+	Proposal SpotEditProposalPayload `json:"proposal"`
+}
+
+type SpotEditProposalPayload struct {
+	ID         int                 `json:"id"`
+	SpotID     int                 `json:"spot_id"`
+	ProposerID int                 `json:"proposer_id"`
+	Kind       string              `json:"kind"`
+	Name       *string             `json:"name,omitempty"`
+	Location   *SpotDetailLocation `json:"location,omitempty"`
+	Category   *string             `json:"category,omitempty"`
+	Status     string              `json:"status"`
+	Upvotes    int                 `json:"upvotes"`
+	Downvotes  int                 `json:"downvotes"`
+	Score      int                 `json:"score"`
+	CreatedAt  string              `json:"created_at"`
+	ResolvedAt *string             `json:"resolved_at"`
+}
+
+type ProposeSpotEditPresenter interface {
+	Output(proposal *entities.SpotEditProposal) *SpotEditProposalResponse
+}
+
+type ProposeSpotEditUseCase interface {
+	Execute(ctx context.Context, input ProposeSpotEditInput) (*SpotEditProposalResponse, error)
+}
+
+type proposeSpotEditInteractor struct {
+	presenter   ProposeSpotEditPresenter
+	spotRepo    entities.SpotRepository
+	editRepo    entities.SpotEditRepository
+	authService services.AuthDomainService
+	threshold   int
+}
+
+func NewProposeSpotEditInteractor(
+	p ProposeSpotEditPresenter,
+	s entities.SpotRepository,
+	e entities.SpotEditRepository,
+	a services.AuthDomainService,
+	threshold int,
+) ProposeSpotEditUseCase {
+	return &proposeSpotEditInteractor{
+		presenter:   p,
+		spotRepo:    s,
+		editRepo:    e,
+		authService: a,
+		threshold:   threshold,
+	}
+}
+
+func (i *proposeSpotEditInteractor) Execute(ctx context.Context, input ProposeSpotEditInput) (*SpotEditProposalResponse, error) {
+	user, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	// 1. 提案内容の検証（種類に応じた店名・座標・カテゴリ）
+	proposal, err := entities.NewSpotEditProposal(input.SpotID, user.ID.Value(), input.Kind, input.Name, input.Latitude, input.Longitude, input.Category)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	// 2. 対象スポットの取得（統合済みの旧IDは統合先へ付け替える）
+	spot, err := i.spotRepo.FindByID(ctx, proposal.SpotID)
+	if err != nil {
+		return nil, fmt.Errorf("repository error: %w", err)
+	}
+	if spot == nil {
+		return nil, fmt.Errorf("%w: %d", ErrSpotNotFound, input.SpotID)
+	}
+	proposal.SpotID = spot.ID
+
+	// 3. 現在の値と同じ提案や、別スポットと座標が重なる移動は受け付けない
+	switch proposal.Kind {
+	case value_objects.SpotEditRename:
+		if proposal.Name == spot.Name {
+			return nil, fmt.Errorf("%w: name is unchanged", ErrInvalidInput)
+		}
+	case value_objects.SpotEditRecategory:
+		if proposal.Category == spot.Category {
+			return nil, fmt.Errorf("%w: category is unchanged", ErrInvalidInput)
+		}
+	case value_objects.SpotEditRelocate:
+		if proposal.Latitude == spot.Latitude && proposal.Longitude == spot.Longitude {
+			return nil, fmt.Errorf("%w: location is unchanged", ErrInvalidInput)
+		}
+		other, err := i.spotRepo.FindByLocation(ctx, proposal.Latitude.Value(), proposal.Longitude.Value())
+		if err != nil {
+			return nil, fmt.Errorf("repository error: %w", err)
+		}
+		if other != nil && other.ID != spot.ID {
+			return nil, fmt.Errorf("%w: spot %d already exists at the proposed location", ErrConflict, other.ID.Value())
+		}
+	}
+
+	// 4. 提案の保存と、提案者自身の賛成票
+	proposal, err = i.editRepo.CreateProposal(ctx, proposal)
+	if err != nil {
+		return nil, fmt.Errorf("proposal storage error: %w", err)
+	}
+	proposal, err = i.editRepo.Vote(ctx, proposal.ID, user.ID, 1)
+	if err != nil {
+		return nil, fmt.Errorf("vote storage error: %w", err)
+	}
+
+	// 5. 閾値が1以下の場合は提案者の票だけで適用される
+	if err := resolveSpotEdit(ctx, i.spotRepo, i.editRepo, proposal, i.threshold); err != nil {
+		return nil, err
+	}
+
+	return i.presenter.Output(proposal), nil
+}
+
+// resolveSpotEdit は、純得票数が閾値に達した提案をスポットへ適用し、-閾値以下になった提案を却下します。
+// 解決した場合は proposal の状態も更新します。
+func resolveSpotEdit(
+	ctx context.Context,
+	spotRepo entities.SpotRepository,
+	editRepo entities.SpotEditRepository,
+	proposal *entities.SpotEditProposal,
+	threshold int,
+) error {
+	now := time.Now()
+	switch {
+	case proposal.Score() >= threshold:
+		spot, err := spotRepo.FindByID(ctx, proposal.SpotID)
+		if err != nil {
+			return fmt.Errorf("repository error: %w", err)
+		}
+		if spot == nil {
+			return fmt.Errorf("%w: %d", ErrSpotNotFound, proposal.SpotID.Value())
+		}
+		history, err := proposal.ApplyTo(spot, now)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrConflict, err)
+		}
+		if err := editRepo.Apply(ctx, proposal, spot, history); err != nil {
+			// 移転先に別のスポットがある提案は適用できないため、開いたままにせず却下する
+			if !errors.Is(err, entities.ErrSpotLocationTaken) {
+				return fmt.Errorf("apply error: %w", err)
+			}
+			if err := editRepo.Reject(ctx, proposal.ID); err != nil {
+				return fmt.Errorf("reject error: %w", err)
+			}
+			proposal.Status = entities.SpotEditRejected
+			break
+		}
+		proposal.Status = entities.SpotEditApplied
+	case proposal.Score() <= -threshold:
+		if err := editRepo.Reject(ctx, proposal.ID); err != nil {
+			return fmt.Errorf("reject error: %w", err)
+		}
+		proposal.Status = entities.SpotEditRejected
+	default:
+		return nil
+	}
+	proposal.ResolvedAt = &now
+	return nil
+}
@@ -0,0 +1,219 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- MOCK 定義 ---
+
+type MockSpotEditRepository struct{ mock.Mock }
+
+func (m *MockSpotEditRepository) CreateProposal(ctx context.Context, p *entities.SpotEditProposal) (*entities.SpotEditProposal, error) {
+	args := m.Called(ctx, p)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.SpotEditProposal), args.Error(1)
+}
+func (m *MockSpotEditRepository) FindProposalByID(ctx context.Context, id value_objects.ID) (*entities.SpotEditProposal, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.SpotEditProposal), args.Error(1)
+}
+func (m *MockSpotEditRepository) FindOpenProposalsBySpot(ctx context.Context, spotID value_objects.ID) ([]*entities.SpotEditProposal, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).([]*entities.SpotEditProposal), args.Error(1)
+}
+func (m *MockSpotEditRepository) Vote(ctx context.Context, proposalID, userID value_objects.ID, value int) (*entities.SpotEditProposal, error) {
+	args := m.Called(ctx, proposalID, userID, value)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.SpotEditProposal), args.Error(1)
+}
+func (m *MockSpotEditRepository) Apply(ctx context.Context, p *entities.SpotEditProposal, s *entities.Spot, h *entities.SpotEditHistory) error {
+	return m.Called(ctx, p, s, h).Error(0)
+}
+func (m *MockSpotEditRepository) Reject(ctx context.Context, proposalID value_objects.ID) error {
+	return m.Called(ctx, proposalID).Error(0)
+}
+func (m *MockSpotEditRepository) FindHistoryBySpot(ctx context.Context, spotID value_objects.ID) ([]*entities.SpotEditHistory, error) {
+	args := m.Called(ctx, spotID)
+	return args.Get(0).([]*entities.SpotEditHistory), args.Error(1)
+}
+
+type SpotEditMockPresenter struct{}
+
+func (p *SpotEditMockPresenter) Output(proposal *entities.SpotEditProposal) *usecase.SpotEditProposalResponse {
+	return &usecase.SpotEditProposalResponse{Proposal: usecase.SpotEditProposalPayload{
+		ID:        proposal.ID.Value(),
+		SpotID:    proposal.SpotID.Value(),
+		Kind:      proposal.Kind.String(),
+		Status:    string(proposal.Status),
+		Upvotes:   proposal.Upvotes,
+		Downvotes: proposal.Downvotes,
+		Score:     proposal.Score(),
+	}}
+}
+
+// storedProposal は保存・集計済みの提案を模したテストデータを生成します。
+func storedProposal(id, spotID int, kind, name string, lat, lng float64, category string, up, down int) *entities.SpotEditProposal {
+	p, _ := entities.NewSpotEditProposal(spotID, 2, kind, name, lat, lng, category)
+	p.ID, _ = value_objects.NewID(id)
+	p.Upvotes, p.Downvotes = up, down
+	p.CreatedAt = time.Now()
+	return p
+}
+
+// --- TEST 本体 ---
+
+func TestProposeSpotEdit_Execute(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	other, _ := entities.NewSpot(9, "別の店", 35.6500, 139.7200, 1)
+
+	tests := []struct {
+		name      string
+		input     usecase.ProposeSpotEditInput
+		threshold int
+		setupMock func(am *MockAuthService, sm *MockSpotRepository, em *MockSpotEditRepository)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.SpotEditProposalResponse)
+	}{
+		{
+			name:      "【正常系】店名の修正を提案すると、提案者の賛成票付きで未解決の提案が作られる",
+			input:     usecase.ProposeSpotEditInput{Token: "valid_token", SpotID: 1, Kind: "rename", Name: "ボブの隠れ家 恵比寿店"},
+			threshold: 3,
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, em *MockSpotEditRepository) {
+				spot, _ := entities.NewSpot(1, "ボブの隠れ家", 35.6467, 139.7101, 1)
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindByID", mock.Anything, spot.ID).Return(spot, nil)
+				em.On("CreateProposal", mock.Anything, mock.MatchedBy(func(p *entities.SpotEditProposal) bool {
+					return p.Kind == value_objects.SpotEditRename && p.Name.String() == "ボブの隠れ家 恵比寿店" && p.ProposerID == malloy.ID
+				})).Return(storedProposal(10, 1, "rename", "ボブの隠れ家 恵比寿店", 0, 0, "", 0, 0), nil)
+				em.On("Vote", mock.Anything, mock.Anything, malloy.ID, 1).
+					Return(storedProposal(10, 1, "rename", "ボブの隠れ家 恵比寿店", 0, 0, "", 1, 0), nil)
+				// 閾値未満のため Apply / Reject は呼ばれない
+			},
+			check: func(t *testing.T, out *usecase.SpotEditProposalResponse) {
+				assert.Equal(t, 10, out.Proposal.ID)
+				assert.Equal(t, "open", out.Proposal.Status)
+				assert.Equal(t, 1, out.Proposal.Upvotes)
+			},
+		},
+		{
+			name:      "【正常系】閾値が1の場合は提案者の票だけで即座に適用され、履歴が記録される",
+			input:     usecase.ProposeSpotEditInput{Token: "valid_token", SpotID: 1, Kind: "recategorize", Category: "ramen"},
+			threshold: 1,
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, em *MockSpotEditRepository) {
+				spot, _ := entities.NewSpot(1, "ボブの隠れ家", 35.6467, 139.7101, 1)
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindByID", mock.Anything, spot.ID).Return(spot, nil)
+				em.On("CreateProposal", mock.Anything, mock.Anything).Return(storedProposal(11, 1, "recategorize", "", 0, 0, "ramen", 0, 0), nil)
+				em.On("Vote", mock.Anything, mock.Anything, malloy.ID, 1).Return(storedProposal(11, 1, "recategorize", "", 0, 0, "ramen", 1, 0), nil)
+				em.On("Apply", mock.Anything, mock.Anything, mock.MatchedBy(func(s *entities.Spot) bool {
+					return s.Category == value_objects.CategoryRamen
+				}), mock.MatchedBy(func(h *entities.SpotEditHistory) bool {
+					return h.OldValue == "" && h.NewValue == "ramen" && h.ProposalID.Value() == 11
+				})).Return(nil)
+			},
+			check: func(t *testing.T, out *usecase.SpotEditProposalResponse) {
+				assert.Equal(t, "applied", out.Proposal.Status)
+			},
+		},
+		{
+			name:      "【異常系】移動先の座標に別のスポットが既に存在する場合は競合エラー",
+			input:     usecase.ProposeSpotEditInput{Token: "valid_token", SpotID: 1, Kind: "relocate", Latitude: 35.6500, Longitude: 139.7200},
+			threshold: 3,
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, em *MockSpotEditRepository) {
+				spot, _ := entities.NewSpot(1, "ボブの隠れ家", 35.6467, 139.7101, 1)
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindByID", mock.Anything, spot.ID).Return(spot, nil)
+				sm.On("FindByLocation", mock.Anything, 35.6500, 139.7200).Return(other, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrConflict,
+		},
+		{
+			name:      "【異常系】現在と同じ店名への修正は入力エラー",
+			input:     usecase.ProposeSpotEditInput{Token: "valid_token", SpotID: 1, Kind: "rename", Name: "ボブの隠れ家"},
+			threshold: 3,
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, em *MockSpotEditRepository) {
+				spot, _ := entities.NewSpot(1, "ボブの隠れ家", 35.6467, 139.7101, 1)
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindByID", mock.Anything, spot.ID).Return(spot, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:      "【異常系】未知の提案種別は入力エラー",
+			input:     usecase.ProposeSpotEditInput{Token: "valid_token", SpotID: 1, Kind: "delete"},
+			threshold: 3,
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, em *MockSpotEditRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:      "【異常系】存在しないスポットへの提案",
+			input:     usecase.ProposeSpotEditInput{Token: "valid_token", SpotID: 404, Kind: "rename", Name: "新しい名前"},
+			threshold: 3,
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, em *MockSpotEditRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindByID", mock.Anything, mock.Anything).Return((*entities.Spot)(nil), nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrSpotNotFound,
+		},
+		{
+			name:      "【異常系】トークンが不正で認証に失敗する",
+			input:     usecase.ProposeSpotEditInput{Token: "bad_token", SpotID: 1, Kind: "rename", Name: "新しい名前"},
+			threshold: 3,
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, em *MockSpotEditRepository) {
+				am.On("VerifyToken", mock.Anything, "bad_token").Return((*entities.User)(nil), errors.New("invalid token"))
+			},
+			wantErr: true,
+			errIs:   usecase.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, sm, em := new(MockAuthService), new(MockSpotRepository), new(MockSpotEditRepository)
+			tt.setupMock(am, sm, em)
+			interactor := usecase.NewProposeSpotEditInteractor(&SpotEditMockPresenter{}, sm, em, am, tt.threshold)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			am.AssertExpectations(t)
+			sm.AssertExpectations(t)
+			em.AssertExpectations(t)
+		})
+	}
+}
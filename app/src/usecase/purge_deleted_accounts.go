@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"app/src/domain/entities"
+)
+
+// deletedAccountBatchSize は1回の問い合わせでパージする退会済みユーザーの件数です。
+const deletedAccountBatchSize = 100
+
+type PurgeDeletedAccountsInput struct {
+	Now         time.Time
+	GracePeriod time.Duration
+	DryRun      bool
+}
+
+type PurgeDeletedAccountsOutput struct {
+	DryRun          bool  `json:"dry_run"`
+	Purged          int   `json:"purged"`
+	UserIDs         []int `json:"user_ids"`
+	ReassignedSpots int   `json:"reassigned_spots"`
+	DeletedSpots    int   `json:"deleted_spots"`
+	// FailedUserIDs は削除に失敗したユーザーです。次回以降の実行で再試行されます。
+	FailedUserIDs []int `json:"failed_user_ids"`
+}
+
+type PurgeDeletedAccountsUseCase interface {
+	Execute(ctx context.Context, input PurgeDeletedAccountsInput) (*PurgeDeletedAccountsOutput, error)
+}
+
+type purgeDeletedAccountsInteractor struct {
+	deletionRepo entities.AccountDeletionRepository
+}
+
+func NewPurgeDeletedAccountsInteractor(d entities.AccountDeletionRepository) PurgeDeletedAccountsUseCase {
+	return &purgeDeletedAccountsInteractor{deletionRepo: d}
+}
+
+// Execute は、退会の申請から猶予期間を過ぎたユーザーを1人ずつ完全に削除します。
+// 王座を持つスポットの扱いは AccountDeletionRepository.Purge を参照してください。
+// 対象の検索後に申請が取り消されたユーザーは削除せず、件数にも含めません。
+// 削除に失敗したユーザーは飛ばして残りの削除を続け、失敗はまとめてエラーとして返します。
+func (i *purgeDeletedAccountsInteractor) Execute(ctx context.Context, input PurgeDeletedAccountsInput) (*PurgeDeletedAccountsOutput, error) {
+	grace := input.GracePeriod
+	if grace <= 0 {
+		grace = DefaultAccountDeletionGracePeriod
+	}
+	cutoff := input.Now.Add(-grace)
+
+	out := &PurgeDeletedAccountsOutput{DryRun: input.DryRun, UserIDs: []int{}, FailedUserIDs: []int{}}
+	failed := make(map[int]bool)
+	var errs []error
+	for {
+		due, err := i.deletionRepo.FindDue(ctx, cutoff, deletedAccountBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("deleted account lookup error: %w", err)
+		}
+
+		progressed := false
+		for _, userID := range due {
+			if failed[userID.Value()] {
+				continue
+			}
+			if input.DryRun {
+				out.UserIDs = append(out.UserIDs, userID.Value())
+				continue
+			}
+			result, err := i.deletionRepo.Purge(ctx, userID, cutoff)
+			if err != nil {
+				failed[userID.Value()] = true
+				out.FailedUserIDs = append(out.FailedUserIDs, userID.Value())
+				errs = append(errs, fmt.Errorf("account purge error (%d): %w", userID.Value(), err))
+				continue
+			}
+			progressed = true
+			if result == nil {
+				continue
+			}
+			out.UserIDs = append(out.UserIDs, userID.Value())
+			out.Purged++
+			out.ReassignedSpots += result.ReassignedSpots
+			out.DeletedSpots += result.DeletedSpots
+		}
+
+		// dry-run では削除しないため、同じ候補が返り続けないよう1回で打ち切る。
+		// 失敗したユーザーは検索結果に残り続けるため、新たに削除できた者がいなければ打ち切る。
+		if input.DryRun || len(due) < deletedAccountBatchSize || !progressed {
+			return out, errors.Join(errs...)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPurgeDeletedAccounts_Execute(t *testing.T) {
+	now := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	grace := 30 * 24 * time.Hour
+	cutoff := now.Add(-grace)
+	due := []value_objects.ID{value_objects.ID(1), value_objects.ID(2)}
+
+	tests := []struct {
+		name      string
+		input     usecase.PurgeDeletedAccountsInput
+		setupMock func(dm *MockAccountDeletionRepository)
+		wantErr   bool
+		check     func(t *testing.T, out *usecase.PurgeDeletedAccountsOutput)
+	}{
+		{
+			name:  "【正常系】猶予期間を過ぎたユーザーを削除し、スポットの付け替え件数を集計する",
+			input: usecase.PurgeDeletedAccountsInput{Now: now, GracePeriod: grace},
+			setupMock: func(dm *MockAccountDeletionRepository) {
+				dm.On("FindDue", mock.Anything, cutoff, 100).Return(due, nil)
+				dm.On("Purge", mock.Anything, due[0], cutoff).Return(&entities.AccountPurgeResult{UserID: due[0], ReassignedSpots: 2, DeletedSpots: 1}, nil)
+				dm.On("Purge", mock.Anything, due[1], cutoff).Return(&entities.AccountPurgeResult{UserID: due[1], ReassignedSpots: 1}, nil)
+			},
+			check: func(t *testing.T, out *usecase.PurgeDeletedAccountsOutput) {
+				assert.Equal(t, 2, out.Purged)
+				assert.Equal(t, []int{1, 2}, out.UserIDs)
+				assert.Equal(t, 3, out.ReassignedSpots)
+				assert.Equal(t, 1, out.DeletedSpots)
+			},
+		},
+		{
+			name:  "【正常系】検索後に申請が取り消されたユーザーは削除件数に含めない",
+			input: usecase.PurgeDeletedAccountsInput{Now: now, GracePeriod: grace},
+			setupMock: func(dm *MockAccountDeletionRepository) {
+				dm.On("FindDue", mock.Anything, cutoff, 100).Return(due, nil)
+				dm.On("Purge", mock.Anything, due[0], cutoff).Return(nil, nil)
+				dm.On("Purge", mock.Anything, due[1], cutoff).Return(&entities.AccountPurgeResult{UserID: due[1]}, nil)
+			},
+			check: func(t *testing.T, out *usecase.PurgeDeletedAccountsOutput) {
+				assert.Equal(t, 1, out.Purged)
+				assert.Equal(t, []int{2}, out.UserIDs)
+			},
+		},
+		{
+			name:  "【異常系】削除に失敗したユーザーは飛ばして残りを削除し、失敗をまとめて返す",
+			input: usecase.PurgeDeletedAccountsInput{Now: now, GracePeriod: grace},
+			setupMock: func(dm *MockAccountDeletionRepository) {
+				dm.On("FindDue", mock.Anything, cutoff, 100).Return(due, nil)
+				dm.On("Purge", mock.Anything, due[0], cutoff).Return(nil, errors.New("db error"))
+				dm.On("Purge", mock.Anything, due[1], cutoff).Return(&entities.AccountPurgeResult{UserID: due[1]}, nil)
+			},
+			wantErr: true,
+			check: func(t *testing.T, out *usecase.PurgeDeletedAccountsOutput) {
+				assert.Equal(t, 1, out.Purged)
+				assert.Equal(t, []int{2}, out.UserIDs)
+				assert.Equal(t, []int{1}, out.FailedUserIDs)
+			},
+		},
+		{
+			name:  "【正常系】dry-run では削除せず対象の一覧のみ返す",
+			input: usecase.PurgeDeletedAccountsInput{Now: now, GracePeriod: grace, DryRun: true},
+			setupMock: func(dm *MockAccountDeletionRepository) {
+				dm.On("FindDue", mock.Anything, cutoff, 100).Return(due, nil)
+			},
+			check: func(t *testing.T, out *usecase.PurgeDeletedAccountsOutput) {
+				assert.True(t, out.DryRun)
+				assert.Equal(t, 0, out.Purged)
+				assert.Equal(t, []int{1, 2}, out.UserIDs)
+			},
+		},
+		{
+			name:  "【異常系】DBエラーが発生した場合",
+			input: usecase.PurgeDeletedAccountsInput{Now: now, GracePeriod: grace},
+			setupMock: func(dm *MockAccountDeletionRepository) {
+				dm.On("FindDue", mock.Anything, cutoff, 100).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dm := new(MockAccountDeletionRepository)
+			tt.setupMock(dm)
+			interactor := usecase.NewPurgeDeletedAccountsInteractor(dm)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.check != nil {
+				tt.check(t, out)
+			}
+			dm.AssertExpectations(t)
+		})
+	}
+}
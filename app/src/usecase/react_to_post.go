@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+type ReactToPostInput struct {
+	Token  string
+	PostID int
+	// Kind は resonate / want_to_go / helpful のいずれか。空文字のときは自分のリアクションを取り消す。
+	Kind string
+}
+
+type ReactToPostResponse struct {
+	PostID     int                   `json:"post_id"`
+	Reactions  ReactionCountsPayload `json:"reactions"`
+	MyReaction *string               `json:"my_reaction"`
+}
+
+// ReactionCountsPayload は投稿に付いたリアクションの種類別の件数です。
+type ReactionCountsPayload struct {
+	Resonate int `json:"resonate"`
+	WantToGo int `json:"want_to_go"`
+	Helpful  int `json:"helpful"`
+	Total    int `json:"total"`
+}
+
+type ReactToPostPresenter interface {
+	Output(post *entities.Post, counts entities.ReactionCounts, mine *entities.Reaction) *ReactToPostResponse
+}
+
+type ReactToPostUseCase interface {
+	Execute(ctx context.Context, input ReactToPostInput) (*ReactToPostResponse, error)
+}
+
+type reactToPostInteractor struct {
+	presenter    ReactToPostPresenter
+	postRepo     entities.PostRepository
+	reactionRepo entities.ReactionRepository
+	authService  services.AuthDomainService
+}
+
+func NewReactToPostInteractor(
+	p ReactToPostPresenter,
+	r entities.PostRepository,
+	rr entities.ReactionRepository,
+	a services.AuthDomainService,
+) ReactToPostUseCase {
+	return &reactToPostInteractor{
+		presenter:    p,
+		postRepo:     r,
+		reactionRepo: rr,
+		authService:  a,
+	}
+}
+
+func (i *reactToPostInteractor) Execute(ctx context.Context, input ReactToPostInput) (*ReactToPostResponse, error) {
+	user, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	postID, err := value_objects.NewID(input.PostID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	var kind value_objects.ReactionKind
+	if input.Kind != "" {
+		if kind, err = value_objects.NewReactionKind(input.Kind); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+	}
+
+	// 1. 対象の投稿の確認（自分の投稿・上書きされた過去の投稿にはリアクションできない）
+	post, err := i.postRepo.FindByID(postID, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("post lookup error: %w", err)
+	}
+	if post == nil {
+		return nil, fmt.Errorf("%w: post %d", ErrNotFound, postID.Value())
+	}
+
+	// 2. リアクションの付与（種類の付け替えを含む）または取り消し
+	var mine *entities.Reaction
+	if kind == "" {
+		if err := i.reactionRepo.Delete(ctx, post.ID, user.ID); err != nil {
+			return nil, fmt.Errorf("reaction delete error: %w", err)
+		}
+	} else {
+		if post.UserID == user.ID {
+			return nil, fmt.Errorf("%w: cannot react to your own post", ErrInvalidInput)
+		}
+		if !post.IsCurrent() {
+			return nil, fmt.Errorf("%w: post %d has been superseded", ErrConflict, postID.Value())
+		}
+		mine = &entities.Reaction{PostID: post.ID, UserID: user.ID, Username: user.Username, Kind: kind, CreatedAt: time.Now()}
+		if err := i.reactionRepo.Upsert(ctx, mine); err != nil {
+			return nil, fmt.Errorf("reaction save error: %w", err)
+		}
+	}
+
+	counts, err := i.reactionRepo.CountByPost(ctx, post.ID)
+	if err != nil {
+		return nil, fmt.Errorf("reaction count error: %w", err)
+	}
+
+	return i.presenter.Output(post, counts, mine), nil
+}
@@ -0,0 +1,293 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockReactionRepository struct {
+	mock.Mock
+}
+
+func (m *MockReactionRepository) Upsert(ctx context.Context, r *entities.Reaction) error {
+	args := m.Called(ctx, r)
+	return args.Error(0)
+}
+
+func (m *MockReactionRepository) Delete(ctx context.Context, postID, userID value_objects.ID) error {
+	args := m.Called(ctx, postID, userID)
+	return args.Error(0)
+}
+
+func (m *MockReactionRepository) FindByPost(ctx context.Context, postID value_objects.ID, kind value_objects.ReactionKind, before *entities.ReactionCursor, limit int) ([]*entities.Reaction, error) {
+	args := m.Called(ctx, postID, kind, before, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Reaction), args.Error(1)
+}
+
+func (m *MockReactionRepository) FindByPostAndUser(ctx context.Context, postID, userID value_objects.ID) (*entities.Reaction, error) {
+	args := m.Called(ctx, postID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Reaction), args.Error(1)
+}
+
+func (m *MockReactionRepository) CountByPost(ctx context.Context, postID value_objects.ID) (entities.ReactionCounts, error) {
+	args := m.Called(ctx, postID)
+	return args.Get(0).(entities.ReactionCounts), args.Error(1)
+}
+
+func (m *MockReactionRepository) CountBySpots(ctx context.Context, spotIDs []value_objects.ID, viewerID value_objects.ID) (map[int]int, error) {
+	args := m.Called(ctx, spotIDs, viewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[int]int), args.Error(1)
+}
+
+type ReactToPostMockPresenter struct{}
+
+func (p *ReactToPostMockPresenter) Output(post *entities.Post, counts entities.ReactionCounts, mine *entities.Reaction) *usecase.ReactToPostResponse {
+	out := &usecase.ReactToPostResponse{
+		PostID:    post.ID.Value(),
+		Reactions: usecase.ReactionCountsPayload{Resonate: counts.Resonate, WantToGo: counts.WantToGo, Helpful: counts.Helpful, Total: counts.Total()},
+	}
+	if mine != nil {
+		kind := mine.Kind.String()
+		out.MyReaction = &kind
+	}
+	return out
+}
+
+type GetPostReactionsMockPresenter struct{}
+
+func (p *GetPostReactionsMockPresenter) Output(post *entities.Post, counts entities.ReactionCounts, reactions []*entities.Reaction, nextCursor string) *usecase.GetPostReactionsResponse {
+	out := &usecase.GetPostReactionsResponse{
+		PostID:    post.ID.Value(),
+		Reactions: usecase.ReactionCountsPayload{Total: counts.Total()},
+		Users:     []usecase.ReactionUserPayload{},
+	}
+	for _, r := range reactions {
+		out.Users = append(out.Users, usecase.ReactionUserPayload{UserID: r.UserID.Value(), Kind: r.Kind.String()})
+	}
+	if nextCursor != "" {
+		out.NextCursor = &nextCursor
+	}
+	return out
+}
+
+func TestReactToPost_Execute(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	post, _ := entities.NewPost(10, 1, 5, "jiro_lover", "", "#二郎系 最高", time.Now())
+	ownPost, _ := entities.NewPost(11, 2, 5, "local_malloy", "", "自分の投稿", time.Now())
+	superseded, _ := entities.NewPost(12, 1, 5, "jiro_lover", "", "古い投稿", time.Now())
+	supersededAt := time.Now()
+	superseded.SupersededAt = &supersededAt
+
+	tests := []struct {
+		name      string
+		input     usecase.ReactToPostInput
+		setupMock func(am *MockAuthService, pm *MockPostRepository, rm *MockReactionRepository)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.ReactToPostResponse)
+	}{
+		{
+			name:  "【正常系】リアクションを付与し、種類別の件数と自分のリアクションを返す",
+			input: usecase.ReactToPostInput{Token: "valid_token", PostID: 10, Kind: "resonate"},
+			setupMock: func(am *MockAuthService, pm *MockPostRepository, rm *MockReactionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				pm.On("FindByID", value_objects.ID(10), malloy.ID).Return(post, nil)
+				rm.On("Upsert", mock.Anything, mock.MatchedBy(func(r *entities.Reaction) bool {
+					return r.PostID.Value() == 10 && r.UserID.Value() == 2 && r.Kind == value_objects.ReactionResonate
+				})).Return(nil)
+				rm.On("CountByPost", mock.Anything, value_objects.ID(10)).Return(entities.ReactionCounts{Resonate: 3, Helpful: 1}, nil)
+			},
+			check: func(t *testing.T, out *usecase.ReactToPostResponse) {
+				assert.Equal(t, 3, out.Reactions.Resonate)
+				assert.Equal(t, 4, out.Reactions.Total)
+				if assert.NotNil(t, out.MyReaction) {
+					assert.Equal(t, "resonate", *out.MyReaction)
+				}
+			},
+		},
+		{
+			name:  "【正常系】種類を空にすると自分のリアクションを取り消す（自分の投稿でも可）",
+			input: usecase.ReactToPostInput{Token: "valid_token", PostID: 11},
+			setupMock: func(am *MockAuthService, pm *MockPostRepository, rm *MockReactionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				pm.On("FindByID", value_objects.ID(11), malloy.ID).Return(ownPost, nil)
+				rm.On("Delete", mock.Anything, value_objects.ID(11), value_objects.ID(2)).Return(nil)
+				rm.On("CountByPost", mock.Anything, value_objects.ID(11)).Return(entities.ReactionCounts{}, nil)
+			},
+			check: func(t *testing.T, out *usecase.ReactToPostResponse) {
+				assert.Equal(t, 0, out.Reactions.Total)
+				assert.Nil(t, out.MyReaction)
+			},
+		},
+		{
+			name:  "【異常系】自分の投稿にはリアクションできない",
+			input: usecase.ReactToPostInput{Token: "valid_token", PostID: 11, Kind: "helpful"},
+			setupMock: func(am *MockAuthService, pm *MockPostRepository, rm *MockReactionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				pm.On("FindByID", value_objects.ID(11), malloy.ID).Return(ownPost, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】上書きされた過去の投稿にはリアクションできない",
+			input: usecase.ReactToPostInput{Token: "valid_token", PostID: 12, Kind: "want_to_go"},
+			setupMock: func(am *MockAuthService, pm *MockPostRepository, rm *MockReactionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				pm.On("FindByID", value_objects.ID(12), malloy.ID).Return(superseded, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrConflict,
+		},
+		{
+			name:  "【異常系】存在しない投稿",
+			input: usecase.ReactToPostInput{Token: "valid_token", PostID: 99, Kind: "resonate"},
+			setupMock: func(am *MockAuthService, pm *MockPostRepository, rm *MockReactionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				pm.On("FindByID", value_objects.ID(99), malloy.ID).Return(nil, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrNotFound,
+		},
+		{
+			name:  "【異常系】未知のリアクションの種類は入力エラー",
+			input: usecase.ReactToPostInput{Token: "valid_token", PostID: 10, Kind: "like"},
+			setupMock: func(am *MockAuthService, pm *MockPostRepository, rm *MockReactionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】保存時にDBエラーが発生した場合",
+			input: usecase.ReactToPostInput{Token: "valid_token", PostID: 10, Kind: "resonate"},
+			setupMock: func(am *MockAuthService, pm *MockPostRepository, rm *MockReactionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				pm.On("FindByID", value_objects.ID(10), malloy.ID).Return(post, nil)
+				rm.On("Upsert", mock.Anything, mock.Anything).Return(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "【異常系】トークンが不正な場合は認証エラー",
+			input: usecase.ReactToPostInput{Token: "bad_token", PostID: 10, Kind: "resonate"},
+			setupMock: func(am *MockAuthService, pm *MockPostRepository, rm *MockReactionRepository) {
+				am.On("VerifyToken", mock.Anything, "bad_token").Return((*entities.User)(nil), errors.New("invalid token"))
+			},
+			wantErr: true,
+			errIs:   usecase.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, pm, rm := new(MockAuthService), new(MockPostRepository), new(MockReactionRepository)
+			tt.setupMock(am, pm, rm)
+			interactor := usecase.NewReactToPostInteractor(&ReactToPostMockPresenter{}, pm, rm, am)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			am.AssertExpectations(t)
+			pm.AssertExpectations(t)
+			rm.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetPostReactions_Execute(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	post, _ := entities.NewPost(10, 1, 5, "jiro_lover", "", "#二郎系 最高", time.Now())
+	base := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	r1, _ := entities.NewReaction(10, 3, "resonate", base)
+	r2, _ := entities.NewReaction(10, 4, "resonate", base.Add(-time.Minute))
+	r3, _ := entities.NewReaction(10, 5, "resonate", base.Add(-2*time.Minute))
+
+	t.Run("【正常系】種類で絞り込み、1件多く取得できた場合は次ページのカーソルを返す", func(t *testing.T) {
+		am, pm, rm := new(MockAuthService), new(MockPostRepository), new(MockReactionRepository)
+		am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+		pm.On("FindByID", value_objects.ID(10), malloy.ID).Return(post, nil)
+		rm.On("FindByPost", mock.Anything, value_objects.ID(10), value_objects.ReactionResonate, (*entities.ReactionCursor)(nil), 3).
+			Return([]*entities.Reaction{r1, r2, r3}, nil)
+		rm.On("CountByPost", mock.Anything, value_objects.ID(10)).Return(entities.ReactionCounts{Resonate: 3}, nil)
+		interactor := usecase.NewGetPostReactionsInteractor(&GetPostReactionsMockPresenter{}, pm, rm, am)
+
+		out, err := interactor.Execute(context.Background(), usecase.GetPostReactionsInput{Token: "valid_token", PostID: 10, Kind: "resonate", Limit: 2})
+
+		assert.NoError(t, err)
+		if assert.Len(t, out.Users, 2) {
+			assert.Equal(t, 3, out.Users[0].UserID)
+			assert.Equal(t, 4, out.Users[1].UserID)
+		}
+		if assert.NotNil(t, out.NextCursor) {
+			// 次ページは2件目（r2）より古いリアクションから始まる
+			pm2, rm2 := new(MockPostRepository), new(MockReactionRepository)
+			pm2.On("FindByID", value_objects.ID(10), malloy.ID).Return(post, nil)
+			rm2.On("FindByPost", mock.Anything, value_objects.ID(10), value_objects.ReactionKind(""), mock.MatchedBy(func(c *entities.ReactionCursor) bool {
+				return c != nil && c.CreatedAt.Equal(r2.CreatedAt) && c.UserID.Value() == 4
+			}), 21).Return([]*entities.Reaction{r3}, nil)
+			rm2.On("CountByPost", mock.Anything, value_objects.ID(10)).Return(entities.ReactionCounts{Resonate: 3}, nil)
+			next := usecase.NewGetPostReactionsInteractor(&GetPostReactionsMockPresenter{}, pm2, rm2, am)
+
+			out2, err := next.Execute(context.Background(), usecase.GetPostReactionsInput{Token: "valid_token", PostID: 10, Cursor: *out.NextCursor})
+
+			assert.NoError(t, err)
+			assert.Len(t, out2.Users, 1)
+			assert.Nil(t, out2.NextCursor)
+			rm2.AssertExpectations(t)
+		}
+		rm.AssertExpectations(t)
+	})
+
+	t.Run("【異常系】不正なカーソルは入力エラー", func(t *testing.T) {
+		am, pm, rm := new(MockAuthService), new(MockPostRepository), new(MockReactionRepository)
+		am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+		interactor := usecase.NewGetPostReactionsInteractor(&GetPostReactionsMockPresenter{}, pm, rm, am)
+
+		out, err := interactor.Execute(context.Background(), usecase.GetPostReactionsInput{Token: "valid_token", PostID: 10, Cursor: "!!"})
+
+		assert.ErrorIs(t, err, usecase.ErrInvalidInput)
+		assert.Nil(t, out)
+	})
+
+	t.Run("【異常系】存在しない投稿", func(t *testing.T) {
+		am, pm, rm := new(MockAuthService), new(MockPostRepository), new(MockReactionRepository)
+		am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+		pm.On("FindByID", value_objects.ID(99), malloy.ID).Return(nil, nil)
+		interactor := usecase.NewGetPostReactionsInteractor(&GetPostReactionsMockPresenter{}, pm, rm, am)
+
+		out, err := interactor.Execute(context.Background(), usecase.GetPostReactionsInput{Token: "valid_token", PostID: 99})
+
+		assert.ErrorIs(t, err, usecase.ErrNotFound)
+		assert.Nil(t, out)
+	})
+}
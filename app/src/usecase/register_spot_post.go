@@ -9,6 +9,15 @@ import (
 	"time"
 )
 
+// ImageLocationToleranceMeters は、送信された座標と画像の撮影地点のずれを同じ場所とみなす距離です。
+const ImageLocationToleranceMeters = 200.0
+
+// 投稿先の座標をどちらから採用したか
+const (
+	LocationSourceSubmitted = "submitted"
+	LocationSourceImage     = "image"
+)
+
 type RegisterSpotPostInput struct {
 	Token     string
 	Username  string
@@ -17,24 +26,64 @@ type RegisterSpotPostInput struct {
 	Longitude float64
 	ImageURL  string
 	Caption   string
+	// Images は添付画像（表示順、最大 entities.MaxPostImages 枚）です。先頭がカバー画像になり、
+	// image_url を併せて送る場合は先頭の画像と一致している必要がある。
+	Images []RegisterSpotPostImageInput
+	// ImageID は POST /v1/images でアップロードした自分の画像です（任意）。
+	// 指定すると image_url を補完し、撮影地点があれば座標の補完・確認に使う。
+	ImageID int
+	// Rating は投稿者による評価です（任意。ゼロ値なら未評価）。
+	Rating RegisterSpotPostRatingInput
+	// Visibility は投稿の公開範囲です（public / followers / private。空なら public）。
+	Visibility string
+	// 店舗の付帯情報（任意）。Spot を新規作成する場合のみ反映し、既存 Spot の属性は変更しない。
+	Category     string
+	Address      string
+	PriceRange   int
+	OpeningHours map[string][]string
 	// Overwrite=true のときは「登録先Spot」を再解決し（存在すれば利用、なければ作成）、
 	// かつそのSpotに対する自分の既存投稿を入れ替えて新規投稿を1件作成する。
 	// Overwrite=false のときは上記店舗への新規投稿を行わず、既存店舗情報のみ返す。
 	Overwrite bool
 }
 
+// RegisterSpotPostRatingInput は 1〜5 の総合評価と、味・コスパ・雰囲気の内訳です（0 は未評価）。
+type RegisterSpotPostRatingInput struct {
+	Overall    int
+	Taste      int
+	Value      int
+	Atmosphere int
+}
+
+// RegisterSpotPostImageInput は添付画像1枚分の入力です。
+type RegisterSpotPostImageInput struct {
+	ImageURL string
+	AltText  string
+}
+
 type RegisterSpotPostOutput struct {
 	Message         string                       `json:"message,omitempty"`
 	HasExistingInfo bool                         `json:"has_existing_info"`
 	Spot            RegisterSpotPostSpotPayload  `json:"spot"`
 	Post            *RegisterSpotPostPostPayload `json:"post,omitempty"`
+	// LocationCheck は image_id を指定した場合の座標確認の結果です。
+	LocationCheck *RegisterSpotPostLocationCheck `json:"location_check,omitempty"`
+}
+
+// RegisterSpotPostLocationCheck は、送信された座標と画像の撮影地点の照合結果です。
+// 撮影地点から ImageLocationToleranceMeters 以上離れた座標が送られた場合（帰宅後の投稿など）は、撮影地点を採用します。
+type RegisterSpotPostLocationCheck struct {
+	Source         string                           `json:"source"`
+	DistanceMeters *float64                         `json:"distance_meters"`
+	ImageLocation  *RegisterSpotPostLocationPayload `json:"image_location"`
 }
 
 type RegisterSpotPostSpotPayload struct {
-	ID       int                             `json:"id"`
-	Name     string                          `json:"name"`
-	MeshID   string                          `json:"mesh_id"`
-	Location RegisterSpotPostLocationPayload `json:"location"`
+	ID         int                             `json:"id"`
+	Name       string                          `json:"name"`
+	MeshID     string                          `json:"mesh_id"`
+	Location   RegisterSpotPostLocationPayload `json:"location"`
+	Attributes SpotAttributesPayload           `json:"attributes"`
 }
 
 type RegisterSpotPostLocationPayload struct {
@@ -43,11 +92,32 @@ type RegisterSpotPostLocationPayload struct {
 }
 
 type RegisterSpotPostPostPayload struct {
-	ID       int    `json:"id"`
+	ID           int                   `json:"id"`
+	UserName     string                `json:"user_name"`
+	ImageURL     string                `json:"image_url"`
+	Photos       []PostPhotoPayload    `json:"photos"`
+	Reactions    ReactionCountsPayload `json:"reactions"`
+	CommentCount int                   `json:"comment_count"`
+	Rating       *PostRatingPayload    `json:"rating"`
+	Visibility   string                `json:"visibility"`
+	Caption      string                `json:"caption"`
+	PostedAt     string                `json:"posted_at"`
+	// Tags / Mentions はキャプションから抽出したハッシュタグ（正規化済み）と、実在するユーザーへ解決できたメンションです。
+	Tags     []string             `json:"tags"`
+	Mentions []PostMentionPayload `json:"mentions"`
+}
+
+// PostRatingPayload は投稿者による評価です。評価のない投稿では rating 自体が null になり、未評価の内訳は null です。
+type PostRatingPayload struct {
+	Overall    int  `json:"overall"`
+	Taste      *int `json:"taste"`
+	Value      *int `json:"value"`
+	Atmosphere *int `json:"atmosphere"`
+}
+
+type PostMentionPayload struct {
+	UserID   int    `json:"user_id"`
 	UserName string `json:"user_name"`
-	ImageURL string `json:"image_url"`
-	Caption  string `json:"caption"`
-	PostedAt string `json:"posted_at"`
 }
 
 type RegisterSpotPostPresenter interface {
@@ -63,6 +133,7 @@ type registerSpotPostInteractor struct {
 	presenter   RegisterSpotPostPresenter
 	spotRepo    entities.SpotRepository
 	postRepo    entities.PostRepository
+	imageRepo   entities.ImageRepository
 	authService services.AuthDomainService
 }
 
@@ -70,12 +141,14 @@ func NewRegisterSpotPostInteractor(
 	p RegisterSpotPostPresenter,
 	s entities.SpotRepository,
 	r entities.PostRepository,
+	ir entities.ImageRepository,
 	a services.AuthDomainService,
 ) RegisterSpotPostUseCase {
 	return &registerSpotPostInteractor{
 		presenter:   p,
 		spotRepo:    s,
 		postRepo:    r,
+		imageRepo:   ir,
 		authService: a,
 	}
 }
@@ -89,6 +162,36 @@ func (i *registerSpotPostInteractor) Execute(ctx context.Context, input Register
 		return nil, fmt.Errorf("auth error: %w", err)
 	}
 
+	// 評価と添付画像の検証（Spot を作成する前に行う）。
+	rating, err := entities.NewPostRating(input.Rating.Overall, input.Rating.Taste, input.Rating.Value, input.Rating.Atmosphere)
+	if err != nil {
+		return nil, fmt.Errorf("%w: rating: %v", ErrInvalidInput, err)
+	}
+	visibility, err := value_objects.NewPostVisibility(input.Visibility)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	// image_url は先頭の画像（カバー画像）に揃える。
+	postImages, err := newPostImagesFromInput(input.Images)
+	if err != nil {
+		return nil, err
+	}
+	if len(postImages) > 0 {
+		if input.ImageURL != "" && input.ImageURL != postImages[0].URL.String() {
+			return nil, fmt.Errorf("%w: image_url must match the first element of images", ErrInvalidInput)
+		}
+		input.ImageURL = postImages[0].URL.String()
+	}
+
+	// 画像が指定されていれば、image_url と座標を画像の情報で補完・確認する。
+	var locationCheck *RegisterSpotPostLocationCheck
+	if input.ImageID != 0 {
+		input, locationCheck, err = i.applyImage(ctx, input, user)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// 2. 座標から mesh_id を算出する。
 	meshID, err := value_objects.NewMeshID(input.Latitude, input.Longitude)
 	if err != nil {
@@ -106,7 +209,7 @@ func (i *registerSpotPostInteractor) Execute(ctx context.Context, input Register
 		targetSpot := userSpotInMesh
 		if !input.Overwrite {
 			// ユーザーの過去登録がある場合で overwrite=false なら、投稿は作らず既存情報を返す。
-			posts, err := i.postRepo.FindBySpotID(targetSpot.ID)
+			posts, err := i.postRepo.FindBySpotID(targetSpot.ID, user.ID)
 			if err != nil {
 				return nil, fmt.Errorf("post lookup error: %w", err)
 			}
@@ -122,7 +225,9 @@ func (i *registerSpotPostInteractor) Execute(ctx context.Context, input Register
 			}
 
 			if latestUserPost != nil {
-				return i.presenter.OutputExisting(targetSpot, latestUserPost), nil
+				output := i.presenter.OutputExisting(targetSpot, latestUserPost)
+				output.LocationCheck = locationCheck
+				return output, nil
 			}
 
 			// ユーザー過去登録はあるが過去投稿がない場合は、入力座標ベースの通常フローに進む。
@@ -133,27 +238,17 @@ func (i *registerSpotPostInteractor) Execute(ctx context.Context, input Register
 				return nil, fmt.Errorf("repository error: %w", err)
 			}
 			if resolvedSpot == nil {
-				newSpot, err := entities.NewSpot(0, input.SpotName, input.Latitude, input.Longitude, user.ID.Value())
+				newSpot, err := newSpotFromInput(input, user)
 				if err != nil {
-					return nil, fmt.Errorf("entity creation error: %w", err)
+					return nil, err
 				}
 				resolvedSpot, err = i.spotRepo.Create(newSpot)
 				if err != nil {
 					return nil, fmt.Errorf("spot storage error: %w", err)
 				}
 			}
-
-			existingPosts, err := i.postRepo.FindBySpotID(resolvedSpot.ID)
-			if err != nil {
-				return nil, fmt.Errorf("post lookup error: %w", err)
-			}
-			for _, p := range existingPosts {
-				if p.UserID.Value() != user.ID.Value() {
-					continue
-				}
-				if err := i.postRepo.Delete(p.ID); err != nil {
-					return nil, fmt.Errorf("post replacement error: %w", err)
-				}
+			if resolvedSpot.IsClosed() {
+				return nil, fmt.Errorf("%w: spot %d is closed", ErrConflict, resolvedSpot.ID.Value())
 			}
 
 			targetSpot = resolvedSpot
@@ -170,14 +265,23 @@ func (i *registerSpotPostInteractor) Execute(ctx context.Context, input Register
 			if err != nil {
 				return nil, fmt.Errorf("post creation error: %w", err)
 			}
+			post.Rating = rating
+			post.Visibility = visibility
+			if len(postImages) > 0 {
+				if err := post.SetImages(postImages); err != nil {
+					return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+				}
+			}
 
-			createdPost, err := i.postRepo.Create(post)
+			// 自分の既存の投稿は削除せず上書き済みとして残し、激戦区度の履歴に含める。
+			createdPost, err := i.postRepo.Supersede(post)
 			if err != nil {
 				return nil, fmt.Errorf("post storage error: %w", err)
 			}
 
 			output := i.presenter.Output(targetSpot, createdPost)
 			output.HasExistingInfo = hasExistingInfo
+			output.LocationCheck = locationCheck
 			return output, nil
 		}
 	}
@@ -189,14 +293,18 @@ func (i *registerSpotPostInteractor) Execute(ctx context.Context, input Register
 	}
 
 	var targetSpot *entities.Spot
+	if existingSpot != nil && existingSpot.IsClosed() {
+		// 閉店済みの Spot には投稿できない（再開していれば運営者が復元する）。
+		return nil, fmt.Errorf("%w: spot %d is closed", ErrConflict, existingSpot.ID.Value())
+	}
 	if existingSpot != nil {
 		// 他ユーザーの登録済み Spot があれば同一エンティティに合流する。
 		targetSpot = existingSpot
 	} else {
 		// 同一座標の Spot がない場合のみ新規作成する。
-		newSpot, err := entities.NewSpot(0, input.SpotName, input.Latitude, input.Longitude, user.ID.Value())
+		newSpot, err := newSpotFromInput(input, user)
 		if err != nil {
-			return nil, fmt.Errorf("entity creation error: %w", err)
+			return nil, err
 		}
 		targetSpot, err = i.spotRepo.Create(newSpot)
 		if err != nil {
@@ -217,6 +325,14 @@ func (i *registerSpotPostInteractor) Execute(ctx context.Context, input Register
 	if err != nil {
 		return nil, fmt.Errorf("post creation error: %w", err)
 	}
+	post.Rating = rating
+	post.Visibility = visibility
+	// 添付画像の指定がなければ image_url がそのままカバー画像になる
+	if len(postImages) > 0 {
+		if err := post.SetImages(postImages); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+	}
 
 	// 6. Post の永続化
 	createdPost, err := i.postRepo.Create(post)
@@ -227,5 +343,96 @@ func (i *registerSpotPostInteractor) Execute(ctx context.Context, input Register
 	// 7. 出力整形
 	output := i.presenter.Output(targetSpot, createdPost)
 	output.HasExistingInfo = hasExistingInfo
+	output.LocationCheck = locationCheck
 	return output, nil
 }
+
+// applyImage は投稿者本人がアップロードした画像を引き当て、image_url を補完したうえで、
+// 撮影地点と送信された座標を照合して投稿先の座標を決めます。
+// 座標が送られていない（0,0）場合は撮影地点を、撮影地点から離れすぎている場合も撮影地点を採用します。
+func (i *registerSpotPostInteractor) applyImage(ctx context.Context, input RegisterSpotPostInput, user *entities.User) (RegisterSpotPostInput, *RegisterSpotPostLocationCheck, error) {
+	imageID, err := value_objects.NewID(input.ImageID)
+	if err != nil {
+		return input, nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	image, err := i.imageRepo.FindByID(ctx, imageID)
+	if err != nil {
+		return input, nil, fmt.Errorf("image lookup error: %w", err)
+	}
+	// 他人の画像は存在しないものとして扱う（撮影地点を推測させない）。
+	if image == nil || image.OwnerID != user.ID {
+		return input, nil, fmt.Errorf("%w: image %d", ErrNotFound, input.ImageID)
+	}
+
+	switch {
+	case len(input.Images) > 0:
+		if !containsImageURL(input.Images, image.URL.String()) {
+			return input, nil, fmt.Errorf("%w: image_id is not among images", ErrInvalidInput)
+		}
+	case input.ImageURL == "":
+		input.ImageURL = image.URL.String()
+	case input.ImageURL != image.URL.String():
+		return input, nil, fmt.Errorf("%w: image_url does not match image_id", ErrInvalidInput)
+	}
+
+	submitted := input.Latitude != 0 || input.Longitude != 0
+	check := &RegisterSpotPostLocationCheck{Source: LocationSourceSubmitted}
+	if image.GeoTag == nil {
+		if !submitted {
+			return input, nil, fmt.Errorf("%w: latitude and longitude are required when the image has no location", ErrInvalidInput)
+		}
+		return input, check, nil
+	}
+
+	check.ImageLocation = &RegisterSpotPostLocationPayload{
+		Latitude:  image.GeoTag.Latitude.Value(),
+		Longitude: image.GeoTag.Longitude.Value(),
+	}
+	if submitted {
+		distance := image.GeoTag.DistanceMeters(input.Latitude, input.Longitude)
+		check.DistanceMeters = &distance
+		if distance <= ImageLocationToleranceMeters {
+			return input, check, nil
+		}
+	}
+	check.Source = LocationSourceImage
+	input.Latitude, input.Longitude = image.GeoTag.Latitude.Value(), image.GeoTag.Longitude.Value()
+	return input, check, nil
+}
+
+// newPostImagesFromInput は添付画像の入力を検証して投稿の添付画像に変換します。
+func newPostImagesFromInput(images []RegisterSpotPostImageInput) ([]entities.PostImage, error) {
+	if len(images) > entities.MaxPostImages {
+		return nil, fmt.Errorf("%w: a post can have at most %d images", ErrInvalidInput, entities.MaxPostImages)
+	}
+	out := make([]entities.PostImage, 0, len(images))
+	for n, img := range images {
+		postImage, err := entities.NewPostImage(n, img.ImageURL, img.AltText)
+		if err != nil {
+			return nil, fmt.Errorf("%w: images[%d]: %v", ErrInvalidInput, n, err)
+		}
+		out = append(out, postImage)
+	}
+	return out, nil
+}
+
+func containsImageURL(images []RegisterSpotPostImageInput, url string) bool {
+	for _, img := range images {
+		if img.ImageURL == url {
+			return true
+		}
+	}
+	return false
+}
+
+// newSpotFromInput は入力から新規作成する Spot を組み立て、付帯情報を検証して設定します。
+func newSpotFromInput(input RegisterSpotPostInput, user *entities.User) (*entities.Spot, error) {
+	spot, err := entities.NewSpot(0, input.SpotName, input.Latitude, input.Longitude, user.ID.Value())
+	if err != nil {
+		return nil, fmt.Errorf("entity creation error: %w", err)
+	}
+	if err := spot.SetAttributes(input.Category, input.Address, input.PriceRange, input.OpeningHours); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	return spot, nil
+}
@@ -48,21 +48,44 @@ func (m *MockSpotRepository) Create(s *entities.Spot) (*entities.Spot, error) {
 	return args.Get(0).(*entities.Spot), args.Error(1)
 }
 func (m *MockSpotRepository) FindByID(ctx context.Context, id value_objects.ID) (*entities.Spot, error) {
-	return nil, nil
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Spot), args.Error(1)
+}
+func (m *MockSpotRepository) FindByIDs(ctx context.Context, ids []value_objects.ID) (map[int]*entities.Spot, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[int]*entities.Spot), args.Error(1)
 }
 func (m *MockSpotRepository) FindByMeshID(mID value_objects.MeshID) ([]*entities.Spot, error) {
 	return nil, nil
 }
 func (m *MockSpotRepository) FindByRegisteredUser(ctx context.Context, userID value_objects.ID) ([]*entities.Spot, error) {
-	return nil, nil
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Spot), args.Error(1)
 }
 func (m *MockSpotRepository) Update(s *entities.Spot) error {
 	args := m.Called(s)
 	return args.Error(0)
 }
 func (m *MockSpotRepository) Delete(id value_objects.ID) error { return nil }
+func (m *MockSpotRepository) Merge(ctx context.Context, sourceID, targetID, mergedBy value_objects.ID) (int, error) {
+	args := m.Called(ctx, sourceID, targetID, mergedBy)
+	return args.Int(0), args.Error(1)
+}
 func (m *MockSpotRepository) FindResonantUsersWithMatchCount(ctx context.Context, uID value_objects.ID) ([]entities.ResonantUser, error) {
-	return nil, nil
+	args := m.Called(ctx, uID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.ResonantUser), args.Error(1)
 }
 func (m *MockSpotRepository) FindSpotByMeshAndUser(ctx context.Context, mID value_objects.MeshID, uID value_objects.ID) (*entities.Spot, error) {
 	args := m.Called(ctx, mID, uID)
@@ -71,19 +94,51 @@ func (m *MockSpotRepository) FindSpotByMeshAndUser(ctx context.Context, mID valu
 	}
 	return args.Get(0).(*entities.Spot), args.Error(1)
 }
-func (m *MockSpotRepository) FindSpotsByMeshAndUsers(ctx context.Context, mIDs []value_objects.MeshID, uIDs []value_objects.ID) ([]*entities.Spot, error) {
-	args := m.Called(ctx, mIDs, uIDs)
+func (m *MockSpotRepository) FindSpotsByMeshAndUsers(ctx context.Context, mIDs []value_objects.MeshID, uIDs []value_objects.ID, viewerID value_objects.ID) ([]*entities.Spot, error) {
+	args := m.Called(ctx, mIDs, uIDs, viewerID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*entities.Spot), args.Error(1)
 }
-func (m *MockSpotRepository) GetDensityScoreByMesh(ctx context.Context, mID value_objects.MeshID) (value_objects.DensityScore, error) {
+func (m *MockSpotRepository) GetDensityScoreByMesh(ctx context.Context, mID value_objects.MeshID, viewerID value_objects.ID) (value_objects.DensityScore, error) {
 	return value_objects.NewDensityScore(0)
 }
-func (m *MockSpotRepository) FindPostsBySpot(ctx context.Context, sID value_objects.ID) ([]*entities.Post, error) {
+func (m *MockSpotRepository) FindPostsBySpot(ctx context.Context, sID, viewerID value_objects.ID) ([]*entities.Post, error) {
 	return nil, nil
 }
+func (m *MockSpotRepository) SearchByName(ctx context.Context, criteria entities.SpotSearchCriteria) ([]entities.SpotSearchHit, error) {
+	args := m.Called(ctx, criteria)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.SpotSearchHit), args.Error(1)
+}
+func (m *MockSpotRepository) ReportClosure(ctx context.Context, sID, uID value_objects.ID, threshold int) (int, entities.SpotStatus, error) {
+	args := m.Called(ctx, sID, uID, threshold)
+	return args.Int(0), args.Get(1).(entities.SpotStatus), args.Error(2)
+}
+func (m *MockSpotRepository) UpdateStatus(ctx context.Context, sID value_objects.ID, status entities.SpotStatus) error {
+	return m.Called(ctx, sID, status).Error(0)
+}
+func (m *MockSpotRepository) FindPostsBySpotPage(ctx context.Context, sID, viewerID value_objects.ID, before *entities.PostCursor, limit int) ([]*entities.Post, error) {
+	args := m.Called(ctx, sID, viewerID, before, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Post), args.Error(1)
+}
+func (m *MockSpotRepository) FindRatingsBySpots(ctx context.Context, sIDs []value_objects.ID, viewerID value_objects.ID) (map[int]entities.SpotRating, error) {
+	args := m.Called(ctx, sIDs, viewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[int]entities.SpotRating), args.Error(1)
+}
+func (m *MockSpotRepository) IsThroneVisible(ctx context.Context, sID, viewerID value_objects.ID) (bool, error) {
+	args := m.Called(ctx, sID, viewerID)
+	return args.Bool(0), args.Error(1)
+}
 
 type MockPostRepository struct{ mock.Mock }
 
@@ -94,22 +149,49 @@ func (m *MockPostRepository) Create(p *entities.Post) (*entities.Post, error) {
 	}
 	return args.Get(0).(*entities.Post), args.Error(1)
 }
-func (m *MockPostRepository) FindBySpotID(sID value_objects.ID) ([]*entities.Post, error) {
-	args := m.Called(sID)
+func (m *MockPostRepository) FindBySpotID(sID, viewerID value_objects.ID) ([]*entities.Post, error) {
+	args := m.Called(sID, viewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Post), args.Error(1)
+}
+func (m *MockPostRepository) FindByUserID(uID, viewerID value_objects.ID) ([]*entities.Post, error) {
+	args := m.Called(uID, viewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Post), args.Error(1)
+}
+func (m *MockPostRepository) Supersede(p *entities.Post) (*entities.Post, error) {
+	args := m.Called(p)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Post), args.Error(1)
+}
+func (m *MockPostRepository) FindHistoryBySpotID(sID, viewerID value_objects.ID) ([]*entities.Post, error) {
+	args := m.Called(sID, viewerID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*entities.Post), args.Error(1)
 }
-func (m *MockPostRepository) FindByUserID(uID value_objects.ID) ([]*entities.Post, error) {
-	args := m.Called(uID)
+func (m *MockPostRepository) FindHistoryByUserID(uID, viewerID value_objects.ID) ([]*entities.Post, error) {
+	args := m.Called(uID, viewerID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*entities.Post), args.Error(1)
 }
-func (m *MockPostRepository) FindByID(id value_objects.ID) (*entities.Post, error) { return nil, nil }
-func (m *MockPostRepository) Update(p *entities.Post) error                        { return nil }
+func (m *MockPostRepository) FindByID(id, viewerID value_objects.ID) (*entities.Post, error) {
+	args := m.Called(id, viewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Post), args.Error(1)
+}
+func (m *MockPostRepository) Update(p *entities.Post) error { return nil }
 func (m *MockPostRepository) Delete(id value_objects.ID) error {
 	args := m.Called(id)
 	return args.Error(0)
@@ -204,7 +286,7 @@ func TestRegisterSpotPost_Execute(t *testing.T) {
 			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
 				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
 				sm.On("FindSpotByMeshAndUser", mock.Anything, mock.Anything, mock.Anything).Return(ownSpot, nil)
-				pm.On("FindBySpotID", ownSpot.ID).Return([]*entities.Post{ownSpotOldPost, otherUserPostOnOwnSpot, ownSpotLatestPost}, nil)
+				pm.On("FindBySpotID", ownSpot.ID, malloy.ID).Return([]*entities.Post{ownSpotOldPost, otherUserPostOnOwnSpot, ownSpotLatestPost}, nil)
 			},
 			wantErr: false,
 			check: func(t *testing.T, out *usecase.RegisterSpotPostOutput) {
@@ -228,7 +310,7 @@ func TestRegisterSpotPost_Execute(t *testing.T) {
 			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
 				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
 				sm.On("FindSpotByMeshAndUser", mock.Anything, mock.Anything, mock.Anything).Return(ownSpot, nil)
-				pm.On("FindBySpotID", ownSpot.ID).Return([]*entities.Post{}, nil)
+				pm.On("FindBySpotID", ownSpot.ID, malloy.ID).Return([]*entities.Post{}, nil)
 				sm.On("FindByLocation", mock.Anything, 35.6467, 139.7101).Return(existingSpot, nil)
 				pm.On("Create", mock.MatchedBy(func(p *entities.Post) bool {
 					return p.SpotID.Value() == 1 && p.UserID.Value() == 2
@@ -245,7 +327,7 @@ func TestRegisterSpotPost_Execute(t *testing.T) {
 			},
 		},
 		{
-			name: "【正常系】自分の過去登録スポットが同メッシュにあり overwrite=true の場合、Spotを再解決して自分のPostを入れ替える",
+			name: "【正常系】自分の過去登録スポットが同メッシュにあり overwrite=true の場合、Spotを再解決して自分のPostを上書きする（過去の投稿は削除しない）",
 			input: usecase.RegisterSpotPostInput{
 				Token: "valid_token", SpotName: "ステーキ屋さん", Latitude: 35.6467, Longitude: 139.7101, ImageURL: "http://example.com/merge.jpg", Caption: "上書き投稿", Overwrite: true,
 			},
@@ -253,10 +335,8 @@ func TestRegisterSpotPost_Execute(t *testing.T) {
 				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
 				sm.On("FindSpotByMeshAndUser", mock.Anything, mock.Anything, mock.Anything).Return(ownSpot, nil)
 				sm.On("FindByLocation", mock.Anything, 35.6467, 139.7101).Return(ownSpot, nil)
-				pm.On("FindBySpotID", ownSpot.ID).Return([]*entities.Post{ownSpotOldPost, otherUserPostOnOwnSpot, ownSpotLatestPost}, nil)
-				pm.On("Delete", ownSpotOldPost.ID).Return(nil)
-				pm.On("Delete", ownSpotLatestPost.ID).Return(nil)
-				pm.On("Create", mock.MatchedBy(func(p *entities.Post) bool {
+				// Delete は呼ばれず、Supersede で過去の投稿を上書き済みにする
+				pm.On("Supersede", mock.MatchedBy(func(p *entities.Post) bool {
 					return p.SpotID.Value() == 77 && p.UserID.Value() == 2
 				})).Return(overwriteCreatedPost, nil)
 			},
@@ -284,6 +364,45 @@ func TestRegisterSpotPost_Execute(t *testing.T) {
 				assert.Equal(t, 99, out.Spot.ID)
 			},
 		},
+		{
+			name: "【正常系】新規作成するスポットにカテゴリ・住所・価格帯・営業時間を設定する",
+			input: usecase.RegisterSpotPostInput{
+				Token: "valid_token", SpotName: "新規店", Latitude: 35.0, Longitude: 135.0,
+				Category: "ramen", Address: "東京都渋谷区恵比寿1-1-1", PriceRange: 1,
+				OpeningHours: map[string][]string{"fri": {"18:00-02:00"}},
+			},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindSpotByMeshAndUser", mock.Anything, mock.Anything, mock.Anything).Return((*entities.Spot)(nil), nil)
+				sm.On("FindByLocation", mock.Anything, 35.0, 135.0).Return((*entities.Spot)(nil), nil)
+				sm.On("Create", mock.MatchedBy(func(s *entities.Spot) bool {
+					// 金曜 18:00-02:00 は土曜 1:00（JST）にも営業中と判定される
+					saturdayNight := time.Date(2026, 3, 7, 1, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+					return s.Category == value_objects.CategoryRamen &&
+						s.Address.String() == "東京都渋谷区恵比寿1-1-1" &&
+						s.PriceRange.Int() == 1 &&
+						s.OpeningHours.IsOpenAt(saturdayNight)
+				})).Return(newlyCreatedSpot, nil)
+				pm.On("Create", mock.Anything).Return(dummyPost, nil)
+			},
+			wantErr: false,
+			check: func(t *testing.T, out *usecase.RegisterSpotPostOutput) {
+				assert.Equal(t, 99, out.Spot.ID)
+			},
+		},
+		{
+			name: "【異常系】不正な営業時間が指定された場合、スポットを作成せずエラーを返す",
+			input: usecase.RegisterSpotPostInput{
+				Token: "valid_token", SpotName: "新規店", Latitude: 35.0, Longitude: 135.0,
+				OpeningHours: map[string][]string{"holiday": {"11:00-15:00"}},
+			},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindSpotByMeshAndUser", mock.Anything, mock.Anything, mock.Anything).Return((*entities.Spot)(nil), nil)
+				sm.On("FindByLocation", mock.Anything, 35.0, 135.0).Return((*entities.Spot)(nil), nil)
+			},
+			wantErr: true,
+		},
 		// --- ここから追加した複雑なテストケース ---
 		{
 			name: "【正常系】第3のユーザー（ハッカー）が極端な座標（境界値）で新規地点を登録する",
@@ -335,6 +454,21 @@ func TestRegisterSpotPost_Execute(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "【異常系】同一座標のスポットが閉店済みの場合、投稿を作らずにエラーを返す",
+			input: usecase.RegisterSpotPostInput{
+				Token: "valid_token", Latitude: 35.6467, Longitude: 139.7101, Caption: "まだやってる？",
+			},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
+				closedSpot, _ := entities.NewSpot(1, "恵比寿うどん", 35.6467, 139.7101, 1)
+				closedSpot.Status = entities.SpotClosed
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindSpotByMeshAndUser", mock.Anything, mock.Anything, mock.Anything).Return((*entities.Spot)(nil), nil)
+				sm.On("FindByLocation", mock.Anything, 35.6467, 139.7101).Return(closedSpot, nil)
+				// 注意: PostのCreateは呼ばれないのでMock定義をしない
+			},
+			wantErr: true,
+		},
 		// --- ここまで ---
 		{
 			name:  "【異常系】トークンが不正な場合、エラーを返す",
@@ -380,7 +514,7 @@ func TestRegisterSpotPost_Execute(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			am, sm, pm := new(MockAuthService), new(MockSpotRepository), new(MockPostRepository)
 			tt.setupMock(am, sm, pm)
-			interactor := usecase.NewRegisterSpotPostInteractor(&MockPresenter{}, sm, pm, am)
+			interactor := usecase.NewRegisterSpotPostInteractor(&MockPresenter{}, sm, pm, new(MockImageRepository), am)
 
 			out, err := interactor.Execute(context.Background(), tt.input)
 
@@ -398,3 +532,346 @@ func TestRegisterSpotPost_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestRegisterSpotPost_ExecuteWithImage(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	imageID, _ := value_objects.NewID(50)
+	const imageURL = "https://cdn.example.com/images/2026/10/abc.jpg"
+
+	// 恵比寿で撮影された画像
+	geotagged, _ := entities.NewImage(50, 2, "images/2026/10/abc.jpg", imageURL, "image/jpeg", 100)
+	geotagged.GeoTag, _ = entities.NewImageGeoTag(35.6467, 139.7101)
+	noGeotag, _ := entities.NewImage(50, 2, "images/2026/10/abc.jpg", imageURL, "image/jpeg", 100)
+	othersImage, _ := entities.NewImage(50, 9, "images/2026/10/abc.jpg", imageURL, "image/jpeg", 100)
+
+	ebisuSpot, _ := entities.NewSpot(1, "恵比寿うどん", 35.6467, 139.7101, 1)
+	createdPost, _ := entities.NewPost(100, 2, 1, "local_malloy", imageURL, "caption", time.Now())
+
+	expectPostAtEbisu := func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository, lat, lng float64) {
+		sm.On("FindSpotByMeshAndUser", mock.Anything, mock.Anything, mock.Anything).Return((*entities.Spot)(nil), nil)
+		sm.On("FindByLocation", mock.Anything, lat, lng).Return(ebisuSpot, nil)
+		pm.On("Create", mock.MatchedBy(func(p *entities.Post) bool {
+			return p.ImageURL.String() == imageURL
+		})).Return(createdPost, nil)
+	}
+
+	tests := []struct {
+		name      string
+		input     usecase.RegisterSpotPostInput
+		setupMock func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository, im *MockImageRepository)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.RegisterSpotPostOutput)
+	}{
+		{
+			name:  "【正常系】座標を送らない場合は撮影地点に投稿し、image_url も補完される",
+			input: usecase.RegisterSpotPostInput{Token: "valid_token", ImageID: 50, Caption: "caption"},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository, im *MockImageRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				im.On("FindByID", mock.Anything, imageID).Return(geotagged, nil)
+				expectPostAtEbisu(am, sm, pm, 35.6467, 139.7101)
+			},
+			check: func(t *testing.T, out *usecase.RegisterSpotPostOutput) {
+				assert.Equal(t, usecase.LocationSourceImage, out.LocationCheck.Source)
+				assert.Nil(t, out.LocationCheck.DistanceMeters)
+				assert.Equal(t, 35.6467, out.LocationCheck.ImageLocation.Latitude)
+			},
+		},
+		{
+			name:  "【正常系】撮影地点の近くの座標が送られた場合は送信された座標を使う",
+			input: usecase.RegisterSpotPostInput{Token: "valid_token", ImageID: 50, Latitude: 35.6470, Longitude: 139.7105},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository, im *MockImageRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				im.On("FindByID", mock.Anything, imageID).Return(geotagged, nil)
+				expectPostAtEbisu(am, sm, pm, 35.6470, 139.7105)
+			},
+			check: func(t *testing.T, out *usecase.RegisterSpotPostOutput) {
+				assert.Equal(t, usecase.LocationSourceSubmitted, out.LocationCheck.Source)
+				assert.InDelta(t, 49, *out.LocationCheck.DistanceMeters, 5)
+			},
+		},
+		{
+			name:  "【正常系】撮影地点から離れた座標（帰宅後の投稿）は撮影地点に置き換える",
+			input: usecase.RegisterSpotPostInput{Token: "valid_token", ImageID: 50, Latitude: 35.7000, Longitude: 139.8000},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository, im *MockImageRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				im.On("FindByID", mock.Anything, imageID).Return(geotagged, nil)
+				expectPostAtEbisu(am, sm, pm, 35.6467, 139.7101)
+			},
+			check: func(t *testing.T, out *usecase.RegisterSpotPostOutput) {
+				assert.Equal(t, usecase.LocationSourceImage, out.LocationCheck.Source)
+				assert.Greater(t, *out.LocationCheck.DistanceMeters, usecase.ImageLocationToleranceMeters)
+			},
+		},
+		{
+			name:  "【異常系】他人の画像は存在しないものとして扱う",
+			input: usecase.RegisterSpotPostInput{Token: "valid_token", ImageID: 50},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository, im *MockImageRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				im.On("FindByID", mock.Anything, imageID).Return(othersImage, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrNotFound,
+		},
+		{
+			name:  "【異常系】撮影地点のない画像で座標も送られない場合は入力エラー",
+			input: usecase.RegisterSpotPostInput{Token: "valid_token", ImageID: 50},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository, im *MockImageRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				im.On("FindByID", mock.Anything, imageID).Return(noGeotag, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】image_url と image_id が食い違う場合は入力エラー",
+			input: usecase.RegisterSpotPostInput{Token: "valid_token", ImageID: 50, ImageURL: "http://example.com/other.jpg"},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository, im *MockImageRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				im.On("FindByID", mock.Anything, imageID).Return(geotagged, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, sm, pm, im := new(MockAuthService), new(MockSpotRepository), new(MockPostRepository), new(MockImageRepository)
+			tt.setupMock(am, sm, pm, im)
+			interactor := usecase.NewRegisterSpotPostInteractor(&MockPresenter{}, sm, pm, im, am)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			am.AssertExpectations(t)
+			sm.AssertExpectations(t)
+			pm.AssertExpectations(t)
+			im.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRegisterSpotPost_ExecuteWithPhotos(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	existingSpot, _ := entities.NewSpot(1, "恵比寿うどん", 35.6467, 139.7101, 1)
+	createdPost, _ := entities.NewPost(100, 2, 1, "local_malloy", "http://example.com/exterior.jpg", "caption", time.Now())
+	photos := []usecase.RegisterSpotPostImageInput{
+		{ImageURL: "http://example.com/exterior.jpg", AltText: "外観"},
+		{ImageURL: "http://example.com/dish.jpg", AltText: "看板メニューのうどん"},
+	}
+
+	tests := []struct {
+		name      string
+		input     usecase.RegisterSpotPostInput
+		setupMock func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository)
+		errIs     error
+	}{
+		{
+			name:  "【正常系】複数画像を表示順に添付し、先頭をカバー画像にする",
+			input: usecase.RegisterSpotPostInput{Token: "valid_token", Latitude: 35.6467, Longitude: 139.7101, Images: photos},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindSpotByMeshAndUser", mock.Anything, mock.Anything, mock.Anything).Return((*entities.Spot)(nil), nil)
+				sm.On("FindByLocation", mock.Anything, 35.6467, 139.7101).Return(existingSpot, nil)
+				pm.On("Create", mock.MatchedBy(func(p *entities.Post) bool {
+					return p.ImageURL.String() == "http://example.com/exterior.jpg" && len(p.Images) == 2 &&
+						p.Images[1].Position == 1 && p.Images[1].AltText.String() == "看板メニューのうどん"
+				})).Return(createdPost, nil)
+			},
+		},
+		{
+			name: "【異常系】上限を超える枚数は Spot を作る前に入力エラー",
+			input: usecase.RegisterSpotPostInput{Token: "valid_token", Latitude: 35.6467, Longitude: 139.7101,
+				Images: append(append(append([]usecase.RegisterSpotPostImageInput{}, photos...), photos...), photos...)},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			errIs: usecase.ErrInvalidInput,
+		},
+		{
+			name: "【異常系】image_url が先頭の画像と食い違う場合は入力エラー",
+			input: usecase.RegisterSpotPostInput{Token: "valid_token", Latitude: 35.6467, Longitude: 139.7101,
+				ImageURL: "http://example.com/dish.jpg", Images: photos},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			errIs: usecase.ErrInvalidInput,
+		},
+		{
+			name: "【異常系】URLのない画像は入力エラー",
+			input: usecase.RegisterSpotPostInput{Token: "valid_token", Latitude: 35.6467, Longitude: 139.7101,
+				Images: []usecase.RegisterSpotPostImageInput{{AltText: "説明だけ"}}},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			errIs: usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, sm, pm := new(MockAuthService), new(MockSpotRepository), new(MockPostRepository)
+			tt.setupMock(am, sm, pm)
+			interactor := usecase.NewRegisterSpotPostInteractor(&MockPresenter{}, sm, pm, new(MockImageRepository), am)
+
+			_, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.errIs != nil {
+				assert.ErrorIs(t, err, tt.errIs)
+			} else {
+				assert.NoError(t, err)
+			}
+			am.AssertExpectations(t)
+			sm.AssertExpectations(t)
+			pm.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRegisterSpotPost_ExecuteWithRating(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	existingSpot, _ := entities.NewSpot(1, "恵比寿うどん", 35.6467, 139.7101, 1)
+	createdPost, _ := entities.NewPost(100, 2, 1, "local_malloy", "", "caption", time.Now())
+
+	tests := []struct {
+		name      string
+		rating    usecase.RegisterSpotPostRatingInput
+		setupMock func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository)
+		errIs     error
+	}{
+		{
+			name:   "【正常系】総合評価と内訳を投稿に保存する（付けなかった内訳は未評価のまま）",
+			rating: usecase.RegisterSpotPostRatingInput{Overall: 5, Taste: 5, Value: 4},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindSpotByMeshAndUser", mock.Anything, mock.Anything, mock.Anything).Return((*entities.Spot)(nil), nil)
+				sm.On("FindByLocation", mock.Anything, 35.6467, 139.7101).Return(existingSpot, nil)
+				pm.On("Create", mock.MatchedBy(func(p *entities.Post) bool {
+					return p.Rating.Overall.Int() == 5 && p.Rating.Taste.Int() == 5 && p.Rating.Value.Int() == 4 &&
+						!p.Rating.Atmosphere.IsRated()
+				})).Return(createdPost, nil)
+			},
+		},
+		{
+			name: "【正常系】評価なしでも投稿できる",
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindSpotByMeshAndUser", mock.Anything, mock.Anything, mock.Anything).Return((*entities.Spot)(nil), nil)
+				sm.On("FindByLocation", mock.Anything, 35.6467, 139.7101).Return(existingSpot, nil)
+				pm.On("Create", mock.MatchedBy(func(p *entities.Post) bool { return !p.Rating.IsRated() })).Return(createdPost, nil)
+			},
+		},
+		{
+			name:   "【異常系】総合評価なしで内訳だけを付けることはできない",
+			rating: usecase.RegisterSpotPostRatingInput{Taste: 4},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			errIs: usecase.ErrInvalidInput,
+		},
+		{
+			name:   "【異常系】1〜5の範囲外の評価は Spot を作る前に入力エラー",
+			rating: usecase.RegisterSpotPostRatingInput{Overall: 6},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			errIs: usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, sm, pm := new(MockAuthService), new(MockSpotRepository), new(MockPostRepository)
+			tt.setupMock(am, sm, pm)
+			interactor := usecase.NewRegisterSpotPostInteractor(&MockPresenter{}, sm, pm, new(MockImageRepository), am)
+
+			input := usecase.RegisterSpotPostInput{Token: "valid_token", Latitude: 35.6467, Longitude: 139.7101, Rating: tt.rating}
+			_, err := interactor.Execute(context.Background(), input)
+
+			if tt.errIs != nil {
+				assert.ErrorIs(t, err, tt.errIs)
+			} else {
+				assert.NoError(t, err)
+			}
+			am.AssertExpectations(t)
+			sm.AssertExpectations(t)
+			pm.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRegisterSpotPost_ExecuteWithVisibility(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	existingSpot, _ := entities.NewSpot(1, "恵比寿うどん", 35.6467, 139.7101, 1)
+	createdPost, _ := entities.NewPost(100, 2, 1, "local_malloy", "", "caption", time.Now())
+
+	tests := []struct {
+		name       string
+		visibility string
+		setupMock  func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository)
+		errIs      error
+	}{
+		{
+			name:       "【正常系】公開範囲を指定した投稿はその範囲で保存する",
+			visibility: "private",
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindSpotByMeshAndUser", mock.Anything, mock.Anything, mock.Anything).Return((*entities.Spot)(nil), nil)
+				sm.On("FindByLocation", mock.Anything, 35.6467, 139.7101).Return(existingSpot, nil)
+				pm.On("Create", mock.MatchedBy(func(p *entities.Post) bool {
+					return p.Visibility == value_objects.PostVisibilityPrivate
+				})).Return(createdPost, nil)
+			},
+		},
+		{
+			name: "【正常系】公開範囲を省略した投稿は public になる",
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindSpotByMeshAndUser", mock.Anything, mock.Anything, mock.Anything).Return((*entities.Spot)(nil), nil)
+				sm.On("FindByLocation", mock.Anything, 35.6467, 139.7101).Return(existingSpot, nil)
+				pm.On("Create", mock.MatchedBy(func(p *entities.Post) bool {
+					return p.Visibility == value_objects.PostVisibilityPublic
+				})).Return(createdPost, nil)
+			},
+		},
+		{
+			name:       "【異常系】未知の公開範囲は Spot を作る前に入力エラー",
+			visibility: "friends",
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, pm *MockPostRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			errIs: usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, sm, pm := new(MockAuthService), new(MockSpotRepository), new(MockPostRepository)
+			tt.setupMock(am, sm, pm)
+			interactor := usecase.NewRegisterSpotPostInteractor(&MockPresenter{}, sm, pm, new(MockImageRepository), am)
+
+			input := usecase.RegisterSpotPostInput{Token: "valid_token", Latitude: 35.6467, Longitude: 139.7101, Visibility: tt.visibility}
+			_, err := interactor.Execute(context.Background(), input)
+
+			if tt.errIs != nil {
+				assert.ErrorIs(t, err, tt.errIs)
+			} else {
+				assert.NoError(t, err)
+			}
+			am.AssertExpectations(t)
+			sm.AssertExpectations(t)
+			pm.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+// DefaultSpotClosureReportThreshold は、スポットを自動で閉店扱いにする閉店報告者数の既定値です。
+const DefaultSpotClosureReportThreshold = 3
+
+type ReportSpotClosureInput struct {
+	Token  string
+	SpotID int
+}
+
+type ReportSpotClosureResponse struct {
+	SpotID    int    `json:"spot_id"`
+	Status    string `json:"status"`
+	Reports   int    `json:"reports"`
+	Threshold int    `json:"threshold"`
+}
+
+type ReportSpotClosurePresenter interface {
+	Output(spot *entities.Spot, reports, threshold int) *ReportSpotClosureResponse
+}
+
+type ReportSpotClosureUseCase interface {
+	Execute(ctx context.Context, input ReportSpotClosureInput) (*ReportSpotClosureResponse, error)
+}
+
+type reportSpotClosureInteractor struct {
+	presenter   ReportSpotClosurePresenter
+	spotRepo    entities.SpotRepository
+	authService services.AuthDomainService
+	threshold   int
+}
+
+func NewReportSpotClosureInteractor(
+	p ReportSpotClosurePresenter,
+	s entities.SpotRepository,
+	a services.AuthDomainService,
+	threshold int,
+) ReportSpotClosureUseCase {
+	return &reportSpotClosureInteractor{
+		presenter:   p,
+		spotRepo:    s,
+		authService: a,
+		threshold:   threshold,
+	}
+}
+
+func (i *reportSpotClosureInteractor) Execute(ctx context.Context, input ReportSpotClosureInput) (*ReportSpotClosureResponse, error) {
+	user, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	spotID, err := value_objects.NewID(input.SpotID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	// 1. 対象スポットの取得（閉店済みのスポットには報告できない）
+	spot, err := i.spotRepo.FindByID(ctx, spotID)
+	if err != nil {
+		return nil, fmt.Errorf("repository error: %w", err)
+	}
+	if spot == nil {
+		return nil, fmt.Errorf("%w: %d", ErrSpotNotFound, spotID.Value())
+	}
+	if spot.IsClosed() {
+		return nil, fmt.Errorf("%w: spot %d is already closed", ErrConflict, spot.ID.Value())
+	}
+
+	// 2. 報告の記録（同一ユーザーの再報告は数えない）。
+	// 報告者数が閾値に達したら閉店扱い（論理削除）にする判定も、管理者の復元と競合しないよう記録と同じトランザクションで行う。
+	reports, status, err := i.spotRepo.ReportClosure(ctx, spot.ID, user.ID, i.threshold)
+	if err != nil {
+		return nil, fmt.Errorf("report storage error: %w", err)
+	}
+	spot.Status = status
+
+	return i.presenter.Output(spot, reports, i.threshold), nil
+}
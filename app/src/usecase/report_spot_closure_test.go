@@ -0,0 +1,130 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"app/src/domain/entities"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type ReportSpotClosureMockPresenter struct{}
+
+func (p *ReportSpotClosureMockPresenter) Output(spot *entities.Spot, reports, threshold int) *usecase.ReportSpotClosureResponse {
+	return &usecase.ReportSpotClosureResponse{SpotID: spot.ID.Value(), Status: string(spot.Status), Reports: reports, Threshold: threshold}
+}
+
+func TestReportSpotClosure_Execute(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+
+	tests := []struct {
+		name      string
+		input     usecase.ReportSpotClosureInput
+		setupMock func(am *MockAuthService, sm *MockSpotRepository)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.ReportSpotClosureResponse)
+	}{
+		{
+			name:  "【正常系】報告者数が閾値未満の場合は reported になる",
+			input: usecase.ReportSpotClosureInput{Token: "valid_token", SpotID: 1},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository) {
+				spot, _ := entities.NewSpot(1, "恵比寿うどん", 35.6467, 139.7101, 1)
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindByID", mock.Anything, spot.ID).Return(spot, nil)
+				sm.On("ReportClosure", mock.Anything, spot.ID, malloy.ID, 3).Return(1, entities.SpotReported, nil)
+			},
+			check: func(t *testing.T, out *usecase.ReportSpotClosureResponse) {
+				assert.Equal(t, "reported", out.Status)
+				assert.Equal(t, 1, out.Reports)
+				assert.Equal(t, 3, out.Threshold)
+			},
+		},
+		{
+			name:  "【正常系】報告者数が閾値に達すると閉店扱い（closed）になる",
+			input: usecase.ReportSpotClosureInput{Token: "valid_token", SpotID: 1},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository) {
+				spot, _ := entities.NewSpot(1, "恵比寿うどん", 35.6467, 139.7101, 1)
+				spot.Status = entities.SpotReported
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindByID", mock.Anything, spot.ID).Return(spot, nil)
+				sm.On("ReportClosure", mock.Anything, spot.ID, malloy.ID, 3).Return(3, entities.SpotClosed, nil)
+			},
+			check: func(t *testing.T, out *usecase.ReportSpotClosureResponse) {
+				assert.Equal(t, "closed", out.Status)
+				assert.Equal(t, 3, out.Reports)
+			},
+		},
+		{
+			name:  "【異常系】閉店済みのスポットには報告できない",
+			input: usecase.ReportSpotClosureInput{Token: "valid_token", SpotID: 1},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository) {
+				spot, _ := entities.NewSpot(1, "恵比寿うどん", 35.6467, 139.7101, 1)
+				spot.Status = entities.SpotClosed
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindByID", mock.Anything, spot.ID).Return(spot, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrConflict,
+		},
+		{
+			name:  "【異常系】存在しないスポットへの報告",
+			input: usecase.ReportSpotClosureInput{Token: "valid_token", SpotID: 404},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindByID", mock.Anything, mock.Anything).Return((*entities.Spot)(nil), nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrSpotNotFound,
+		},
+		{
+			name:  "【異常系】報告の保存時にDBエラーが発生した場合",
+			input: usecase.ReportSpotClosureInput{Token: "valid_token", SpotID: 1},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository) {
+				spot, _ := entities.NewSpot(1, "恵比寿うどん", 35.6467, 139.7101, 1)
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("FindByID", mock.Anything, spot.ID).Return(spot, nil)
+				sm.On("ReportClosure", mock.Anything, spot.ID, malloy.ID, 3).Return(0, entities.SpotStatus(""), errors.New("db insert error"))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "【異常系】トークンが不正で認証に失敗する",
+			input: usecase.ReportSpotClosureInput{Token: "bad_token", SpotID: 1},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "bad_token").Return((*entities.User)(nil), errors.New("invalid token"))
+			},
+			wantErr: true,
+			errIs:   usecase.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, sm := new(MockAuthService), new(MockSpotRepository)
+			tt.setupMock(am, sm)
+			interactor := usecase.NewReportSpotClosureInteractor(&ReportSpotClosureMockPresenter{}, sm, am, 3)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			am.AssertExpectations(t)
+			sm.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+)
+
+// DefaultAccountDeletionGracePeriod は、退会の申請から完全に削除するまでの猶予期間の既定値です。
+const DefaultAccountDeletionGracePeriod = 30 * 24 * time.Hour
+
+// RequestAccountDeletionInput の Password は、本人の操作であることを確かめるために再入力させます。
+type RequestAccountDeletionInput struct {
+	Token    string
+	Password string
+}
+
+type RequestAccountDeletionResponse struct {
+	DeletionRequestedAt string `json:"deletion_requested_at"`
+	// PurgeAfter 以降のパージ処理で完全に削除されます。それまでに再ログインすると申請は取り消されます。
+	PurgeAfter string `json:"purge_after"`
+}
+
+type RequestAccountDeletionPresenter interface {
+	Output(requestedAt, purgeAfter time.Time) *RequestAccountDeletionResponse
+}
+
+type RequestAccountDeletionUseCase interface {
+	Execute(ctx context.Context, input RequestAccountDeletionInput) (*RequestAccountDeletionResponse, error)
+}
+
+type requestAccountDeletionInteractor struct {
+	presenter    RequestAccountDeletionPresenter
+	userRepo     entities.UserRepository
+	deletionRepo entities.AccountDeletionRepository
+	authService  services.AuthDomainService
+	gracePeriod  time.Duration
+}
+
+func NewRequestAccountDeletionInteractor(
+	p RequestAccountDeletionPresenter,
+	u entities.UserRepository,
+	d entities.AccountDeletionRepository,
+	a services.AuthDomainService,
+	gracePeriod time.Duration,
+) RequestAccountDeletionUseCase {
+	if gracePeriod <= 0 {
+		gracePeriod = DefaultAccountDeletionGracePeriod
+	}
+	return &requestAccountDeletionInteractor{
+		presenter:    p,
+		userRepo:     u,
+		deletionRepo: d,
+		authService:  a,
+		gracePeriod:  gracePeriod,
+	}
+}
+
+// Execute は退会を申請します。すぐには削除せず、猶予期間を過ぎた後のパージ処理で完全に削除します。
+// 申請済みの場合は最初の申請時刻のまま、削除予定を返します。
+func (i *requestAccountDeletionInteractor) Execute(ctx context.Context, input RequestAccountDeletionInput) (*RequestAccountDeletionResponse, error) {
+	tokenUser, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+	user, err := i.userRepo.FindByID(tokenUser.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	if input.Password == "" {
+		return nil, fmt.Errorf("%w: password is required", ErrInvalidInput)
+	}
+	if err := i.authService.VerifyPassword(user.HashedPassword, input.Password); err != nil {
+		return nil, fmt.Errorf("%w: password does not match", ErrForbidden)
+	}
+
+	requestedAt, err := i.deletionRepo.RequestDeletion(ctx, user.ID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("deletion request error: %w", err)
+	}
+
+	return i.presenter.Output(requestedAt, requestedAt.Add(i.gracePeriod)), nil
+}
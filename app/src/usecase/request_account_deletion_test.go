@@ -0,0 +1,168 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAccountDeletionRepository struct{ mock.Mock }
+
+func (m *MockAccountDeletionRepository) RequestDeletion(ctx context.Context, userID value_objects.ID, at time.Time) (time.Time, error) {
+	args := m.Called(ctx, userID, at)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (m *MockAccountDeletionRepository) CancelDeletion(ctx context.Context, userID value_objects.ID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockAccountDeletionRepository) FindDue(ctx context.Context, cutoff time.Time, limit int) ([]value_objects.ID, error) {
+	args := m.Called(ctx, cutoff, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]value_objects.ID), args.Error(1)
+}
+
+func (m *MockAccountDeletionRepository) Purge(ctx context.Context, userID value_objects.ID, cutoff time.Time) (*entities.AccountPurgeResult, error) {
+	args := m.Called(ctx, userID, cutoff)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.AccountPurgeResult), args.Error(1)
+}
+
+// AccountDeletionMockAuthService はトークンの検証とパスワードの照合を両方モックします。
+type AccountDeletionMockAuthService struct{ mock.Mock }
+
+func (m *AccountDeletionMockAuthService) VerifyToken(ctx context.Context, token string) (*entities.User, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.User), args.Error(1)
+}
+func (m *AccountDeletionMockAuthService) VerifyPassword(hashed value_objects.HashedPassword, rawPassword string) error {
+	args := m.Called(hashed, rawPassword)
+	return args.Error(0)
+}
+func (m *AccountDeletionMockAuthService) HashPassword(p string) (string, error) { return "", nil }
+func (m *AccountDeletionMockAuthService) IssueToken(ctx context.Context, u *entities.User) (string, error) {
+	return "", nil
+}
+
+type RequestAccountDeletionMockPresenter struct{}
+
+func (p *RequestAccountDeletionMockPresenter) Output(requestedAt, purgeAfter time.Time) *usecase.RequestAccountDeletionResponse {
+	return &usecase.RequestAccountDeletionResponse{
+		DeletionRequestedAt: requestedAt.Format(time.RFC3339),
+		PurgeAfter:          purgeAfter.Format(time.RFC3339),
+	}
+}
+
+func TestRequestAccountDeletion_Execute(t *testing.T) {
+	bob, _ := entities.NewUser(1, "local_bob", "bob@example.com", "hashed_password")
+	requestedAt := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	grace := 7 * 24 * time.Hour
+
+	tests := []struct {
+		name      string
+		input     usecase.RequestAccountDeletionInput
+		setupMock func(am *AccountDeletionMockAuthService, um *MockUserRepository, dm *MockAccountDeletionRepository)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.RequestAccountDeletionResponse)
+	}{
+		{
+			name:  "【正常系】パスワードが一致すれば申請し、猶予期間後の削除予定を返す",
+			input: usecase.RequestAccountDeletionInput{Token: "valid_token", Password: "correct_password"},
+			setupMock: func(am *AccountDeletionMockAuthService, um *MockUserRepository, dm *MockAccountDeletionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(bob, nil)
+				um.On("FindByID", bob.ID).Return(bob, nil)
+				am.On("VerifyPassword", bob.HashedPassword, "correct_password").Return(nil)
+				dm.On("RequestDeletion", mock.Anything, bob.ID, mock.Anything).Return(requestedAt, nil)
+			},
+			check: func(t *testing.T, out *usecase.RequestAccountDeletionResponse) {
+				assert.Equal(t, "2026-03-01T09:00:00Z", out.DeletionRequestedAt)
+				assert.Equal(t, "2026-03-08T09:00:00Z", out.PurgeAfter)
+			},
+		},
+		{
+			name:  "【異常系】パスワードが一致しない場合は Forbidden",
+			input: usecase.RequestAccountDeletionInput{Token: "valid_token", Password: "wrong_password"},
+			setupMock: func(am *AccountDeletionMockAuthService, um *MockUserRepository, dm *MockAccountDeletionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(bob, nil)
+				um.On("FindByID", bob.ID).Return(bob, nil)
+				am.On("VerifyPassword", bob.HashedPassword, "wrong_password").Return(errors.New("mismatch"))
+			},
+			wantErr: true,
+			errIs:   usecase.ErrForbidden,
+		},
+		{
+			name:  "【異常系】パスワードが空の場合は入力エラー",
+			input: usecase.RequestAccountDeletionInput{Token: "valid_token"},
+			setupMock: func(am *AccountDeletionMockAuthService, um *MockUserRepository, dm *MockAccountDeletionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(bob, nil)
+				um.On("FindByID", bob.ID).Return(bob, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】DBエラーが発生した場合",
+			input: usecase.RequestAccountDeletionInput{Token: "valid_token", Password: "correct_password"},
+			setupMock: func(am *AccountDeletionMockAuthService, um *MockUserRepository, dm *MockAccountDeletionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(bob, nil)
+				um.On("FindByID", bob.ID).Return(bob, nil)
+				am.On("VerifyPassword", bob.HashedPassword, "correct_password").Return(nil)
+				dm.On("RequestDeletion", mock.Anything, bob.ID, mock.Anything).Return(time.Time{}, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "【異常系】トークンが不正な場合は認証エラー",
+			input: usecase.RequestAccountDeletionInput{Token: "bad_token", Password: "correct_password"},
+			setupMock: func(am *AccountDeletionMockAuthService, um *MockUserRepository, dm *MockAccountDeletionRepository) {
+				am.On("VerifyToken", mock.Anything, "bad_token").Return(nil, errors.New("invalid token"))
+			},
+			wantErr: true,
+			errIs:   usecase.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, um, dm := new(AccountDeletionMockAuthService), new(MockUserRepository), new(MockAccountDeletionRepository)
+			tt.setupMock(am, um, dm)
+			interactor := usecase.NewRequestAccountDeletionInteractor(&RequestAccountDeletionMockPresenter{}, um, dm, am, grace)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			am.AssertExpectations(t)
+			um.AssertExpectations(t)
+			dm.AssertExpectations(t)
+		})
+	}
+}
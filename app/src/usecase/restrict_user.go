@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+)
+
+// 制限の種類
+const (
+	RestrictionBlock = "block"
+	RestrictionMute  = "mute"
+)
+
+type RestrictUserInput struct {
+	Token    string
+	Username string
+	// Kind は block（ブロック）または mute（ミュート）です。
+	Kind string
+	// Restrict が false のときは制限を解除する。
+	Restrict bool
+}
+
+type RestrictUserResponse struct {
+	User       PublicUserSummaryPayload `json:"user"`
+	Kind       string                   `json:"kind"`
+	Restricted bool                     `json:"restricted"`
+}
+
+type RestrictUserPresenter interface {
+	Output(user *entities.User, kind entities.UserRestrictionKind, restricted bool) *RestrictUserResponse
+}
+
+type RestrictUserUseCase interface {
+	Execute(ctx context.Context, input RestrictUserInput) (*RestrictUserResponse, error)
+}
+
+type restrictUserInteractor struct {
+	presenter       RestrictUserPresenter
+	userRepo        entities.UserRepository
+	restrictionRepo entities.UserRestrictionRepository
+	authService     services.AuthDomainService
+}
+
+func NewRestrictUserInteractor(
+	p RestrictUserPresenter,
+	u entities.UserRepository,
+	r entities.UserRestrictionRepository,
+	a services.AuthDomainService,
+) RestrictUserUseCase {
+	return &restrictUserInteractor{
+		presenter:       p,
+		userRepo:        u,
+		restrictionRepo: r,
+		authService:     a,
+	}
+}
+
+func (i *restrictUserInteractor) Execute(ctx context.Context, input RestrictUserInput) (*RestrictUserResponse, error) {
+	user, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	kind, err := parseUserRestrictionKind(input.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 制限する相手の確認（自分自身は制限できない）
+	target, err := i.userRepo.FindByUsername(ctx, input.Username)
+	if err != nil {
+		return nil, fmt.Errorf("user lookup error: %w", err)
+	}
+	if target == nil {
+		return nil, fmt.Errorf("%w: user %q", ErrNotFound, input.Username)
+	}
+	if target.ID == user.ID {
+		return nil, fmt.Errorf("%w: cannot %s yourself", ErrInvalidInput, kind)
+	}
+
+	// 2. 制限・解除（どちらも冪等。ブロックは互いのフォローも解除する）
+	if input.Restrict {
+		err = i.restrictionRepo.Restrict(ctx, kind, user.ID, target.ID)
+	} else {
+		err = i.restrictionRepo.Unrestrict(ctx, kind, user.ID, target.ID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("restriction storage error: %w", err)
+	}
+
+	return i.presenter.Output(target, kind, input.Restrict), nil
+}
+
+// parseUserRestrictionKind は block / mute 以外を入力エラーにします。
+func parseUserRestrictionKind(kind string) (entities.UserRestrictionKind, error) {
+	switch kind {
+	case RestrictionBlock:
+		return entities.UserRestrictionBlock, nil
+	case RestrictionMute:
+		return entities.UserRestrictionMute, nil
+	default:
+		return "", fmt.Errorf("%w: unknown restriction %q", ErrInvalidInput, kind)
+	}
+}
+
+// ensureNotBlocked は、閲覧者と相手のどちらかがブロックしている場合に相手が見つからないものとして ErrNotFound を返します。
+// 閲覧者が未認証（nil）または本人の場合は常に nil です。
+func ensureNotBlocked(ctx context.Context, repo entities.UserRestrictionRepository, viewer, user *entities.User) error {
+	if viewer == nil || viewer.ID == user.ID {
+		return nil
+	}
+	blocked, err := repo.IsBlockedBetween(ctx, viewer.ID, user.ID)
+	if err != nil {
+		return fmt.Errorf("restriction lookup error: %w", err)
+	}
+	if blocked {
+		return fmt.Errorf("%w: user %q", ErrNotFound, user.Username.String())
+	}
+	return nil
+}
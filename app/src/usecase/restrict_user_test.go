@@ -0,0 +1,164 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockUserRestrictionRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserRestrictionRepository) Restrict(ctx context.Context, kind entities.UserRestrictionKind, userID, targetID value_objects.ID) error {
+	return m.Called(ctx, kind, userID, targetID).Error(0)
+}
+
+func (m *MockUserRestrictionRepository) Unrestrict(ctx context.Context, kind entities.UserRestrictionKind, userID, targetID value_objects.ID) error {
+	return m.Called(ctx, kind, userID, targetID).Error(0)
+}
+
+func (m *MockUserRestrictionRepository) FindByUser(ctx context.Context, kind entities.UserRestrictionKind, userID value_objects.ID) ([]entities.RestrictedUser, error) {
+	args := m.Called(ctx, kind, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.RestrictedUser), args.Error(1)
+}
+
+func (m *MockUserRestrictionRepository) IsBlockedBetween(ctx context.Context, a, b value_objects.ID) (bool, error) {
+	args := m.Called(ctx, a, b)
+	return args.Bool(0), args.Error(1)
+}
+
+type RestrictUserMockPresenter struct{}
+
+func (p *RestrictUserMockPresenter) Output(user *entities.User, kind entities.UserRestrictionKind, restricted bool) *usecase.RestrictUserResponse {
+	return &usecase.RestrictUserResponse{
+		User:       usecase.PublicUserSummaryPayload{ID: user.ID.Value()},
+		Kind:       string(kind),
+		Restricted: restricted,
+	}
+}
+
+func TestRestrictUser_Execute(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	taro, _ := entities.NewUser(3, "taro", "taro@example.com", "hashed_password")
+
+	tests := []struct {
+		name      string
+		input     usecase.RestrictUserInput
+		setupMock func(am *MockAuthService, um *MockUserRepository, bm *MockUserRestrictionRepository)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.RestrictUserResponse)
+	}{
+		{
+			name:  "【正常系】ユーザーをブロックできる",
+			input: usecase.RestrictUserInput{Token: "valid_token", Username: "taro", Kind: usecase.RestrictionBlock, Restrict: true},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, bm *MockUserRestrictionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				um.On("FindByUsername", mock.Anything, "taro").Return(taro, nil)
+				bm.On("Restrict", mock.Anything, entities.UserRestrictionBlock, malloy.ID, taro.ID).Return(nil)
+			},
+			check: func(t *testing.T, out *usecase.RestrictUserResponse) {
+				assert.Equal(t, "block", out.Kind)
+				assert.True(t, out.Restricted)
+			},
+		},
+		{
+			name:  "【正常系】ミュートを解除できる",
+			input: usecase.RestrictUserInput{Token: "valid_token", Username: "taro", Kind: usecase.RestrictionMute},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, bm *MockUserRestrictionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				um.On("FindByUsername", mock.Anything, "taro").Return(taro, nil)
+				bm.On("Unrestrict", mock.Anything, entities.UserRestrictionMute, malloy.ID, taro.ID).Return(nil)
+			},
+			check: func(t *testing.T, out *usecase.RestrictUserResponse) {
+				assert.Equal(t, "mute", out.Kind)
+				assert.False(t, out.Restricted)
+			},
+		},
+		{
+			name:  "【異常系】自分自身はブロックできない",
+			input: usecase.RestrictUserInput{Token: "valid_token", Username: "local_malloy", Kind: usecase.RestrictionBlock, Restrict: true},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, bm *MockUserRestrictionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				um.On("FindByUsername", mock.Anything, "local_malloy").Return(malloy, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】未知の制限の種類は入力エラー",
+			input: usecase.RestrictUserInput{Token: "valid_token", Username: "taro", Kind: "hide", Restrict: true},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, bm *MockUserRestrictionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】存在しないユーザーは NotFound",
+			input: usecase.RestrictUserInput{Token: "valid_token", Username: "ghost", Kind: usecase.RestrictionMute, Restrict: true},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, bm *MockUserRestrictionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				um.On("FindByUsername", mock.Anything, "ghost").Return((*entities.User)(nil), nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrNotFound,
+		},
+		{
+			name:  "【異常系】DBエラーが発生した場合",
+			input: usecase.RestrictUserInput{Token: "valid_token", Username: "taro", Kind: usecase.RestrictionBlock, Restrict: true},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, bm *MockUserRestrictionRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				um.On("FindByUsername", mock.Anything, "taro").Return(taro, nil)
+				bm.On("Restrict", mock.Anything, entities.UserRestrictionBlock, malloy.ID, taro.ID).Return(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "【異常系】トークンが不正な場合は認証エラー",
+			input: usecase.RestrictUserInput{Token: "bad_token", Username: "taro", Kind: usecase.RestrictionBlock, Restrict: true},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, bm *MockUserRestrictionRepository) {
+				am.On("VerifyToken", mock.Anything, "bad_token").Return((*entities.User)(nil), errors.New("invalid token"))
+			},
+			wantErr: true,
+			errIs:   usecase.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, um, bm := new(MockAuthService), new(MockUserRepository), new(MockUserRestrictionRepository)
+			tt.setupMock(am, um, bm)
+			interactor := usecase.NewRestrictUserInteractor(&RestrictUserMockPresenter{}, um, bm, am)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			am.AssertExpectations(t)
+			um.AssertExpectations(t)
+			bm.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+type SearchSpotsInput struct {
+	Token     string
+	Query     string
+	Latitude  *float64
+	Longitude *float64
+	Category  string
+	OpenNow   bool
+	Limit     int
+}
+
+type SearchSpotsResponse struct {
+	Query   string              `json:"query"`
+	Results []SpotSearchPayload `json:"results"`
+}
+
+type SpotSearchPayload struct {
+	Spot            SpotSearchSpotPayload `json:"spot"`
+	// HighlightedName は一致箇所を <mark> で囲んだ HTML です（店名自体はエスケープ済み）。
+	HighlightedName string                `json:"highlighted_name"`
+	Score           float64               `json:"score"`
+	DistanceKm      *float64              `json:"distance_km"`
+}
+
+type SpotSearchSpotPayload struct {
+	ID         int                   `json:"id"`
+	Name       string                `json:"name"`
+	MeshID     string                `json:"mesh_id"`
+	Location   SpotSearchLocation    `json:"location"`
+	Attributes SpotAttributesPayload `json:"attributes"`
+}
+
+type SpotSearchLocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+type SearchSpotsPresenter interface {
+	Output(query value_objects.SpotSearchQuery, hits []entities.SpotSearchHit) *SearchSpotsResponse
+}
+
+type SearchSpotsUseCase interface {
+	Execute(ctx context.Context, input SearchSpotsInput) (*SearchSpotsResponse, error)
+}
+
+type searchSpotsInteractor struct {
+	presenter   SearchSpotsPresenter
+	spotRepo    entities.SpotRepository
+	authService services.AuthDomainService
+}
+
+func NewSearchSpotsInteractor(
+	p SearchSpotsPresenter,
+	s entities.SpotRepository,
+	a services.AuthDomainService,
+) SearchSpotsUseCase {
+	return &searchSpotsInteractor{
+		presenter:   p,
+		spotRepo:    s,
+		authService: a,
+	}
+}
+
+func (i *searchSpotsInteractor) Execute(ctx context.Context, input SearchSpotsInput) (*SearchSpotsResponse, error) {
+	if _, err := i.authService.VerifyToken(ctx, input.Token); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	// 1. キーワードの正規化（ひらがな/カタカナ・全角/半角・大文字/小文字の揺れを吸収）
+	query, err := value_objects.NewSpotSearchQuery(input.Query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	filter, err := newSpotFilter(input.Category, input.OpenNow, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	criteria := entities.SpotSearchCriteria{
+		Query:  query,
+		Filter: filter,
+		Limit:  normalizePageLimit(input.Limit),
+	}
+
+	// 2. 現在地が指定されていれば、近い店舗を優先するための検索地点として渡す
+	if input.Latitude != nil && input.Longitude != nil {
+		lat, err := value_objects.NewLatitude(*input.Latitude)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+		lng, err := value_objects.NewLongitude(*input.Longitude)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+		criteria.Latitude = &lat
+		criteria.Longitude = &lng
+	}
+
+	hits, err := i.spotRepo.SearchByName(ctx, criteria)
+	if err != nil {
+		return nil, fmt.Errorf("spot search error: %w", err)
+	}
+
+	return i.presenter.Output(query, hits), nil
+}
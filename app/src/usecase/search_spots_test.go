@@ -0,0 +1,158 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type SearchSpotsMockPresenter struct{}
+
+func (p *SearchSpotsMockPresenter) Output(query value_objects.SpotSearchQuery, hits []entities.SpotSearchHit) *usecase.SearchSpotsResponse {
+	out := &usecase.SearchSpotsResponse{Query: query.String()}
+	for _, h := range hits {
+		out.Results = append(out.Results, usecase.SpotSearchPayload{
+			Spot:       usecase.SpotSearchSpotPayload{ID: h.Spot.ID.Value(), Name: h.Spot.Name.String()},
+			Score:      h.Score,
+			DistanceKm: h.DistanceKm,
+		})
+	}
+	return out
+}
+
+func TestSearchSpots_Execute(t *testing.T) {
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	ramen, _ := entities.NewSpot(1, "ラーメン二郎 三田本店", 35.6467, 139.7101, 1)
+	lat, lng := 35.65, 139.71
+	badLat := 120.0
+
+	tests := []struct {
+		name      string
+		input     usecase.SearchSpotsInput
+		setupMock func(am *MockAuthService, sm *MockSpotRepository)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.SearchSpotsResponse)
+	}{
+		{
+			name:  "【正常系】全角カタカナ・半角カナの揺れを吸収したキーワードでリポジトリを検索する",
+			input: usecase.SearchSpotsInput{Token: "valid_token", Query: "  ﾗｰﾒﾝ  "},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("SearchByName", mock.Anything, mock.MatchedBy(func(c entities.SpotSearchCriteria) bool {
+					return c.Query.String() == "らーめん" && c.Latitude == nil && c.Longitude == nil && c.Limit == 20
+				})).Return([]entities.SpotSearchHit{{Spot: ramen, Score: 1.4}}, nil)
+			},
+			check: func(t *testing.T, out *usecase.SearchSpotsResponse) {
+				assert.Equal(t, "らーめん", out.Query)
+				if assert.Len(t, out.Results, 1) {
+					assert.Equal(t, 1, out.Results[0].Spot.ID)
+				}
+			},
+		},
+		{
+			name:  "【正常系】現在地が指定された場合は検索地点として渡す",
+			input: usecase.SearchSpotsInput{Token: "valid_token", Query: "ＲＡＭＥＮ", Latitude: &lat, Longitude: &lng, Limit: 5},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("SearchByName", mock.Anything, mock.MatchedBy(func(c entities.SpotSearchCriteria) bool {
+					return c.Query.String() == "ramen" && c.Latitude != nil && c.Latitude.Value() == lat &&
+						c.Longitude != nil && c.Longitude.Value() == lng && c.Limit == 5
+				})).Return([]entities.SpotSearchHit{}, nil)
+			},
+			check: func(t *testing.T, out *usecase.SearchSpotsResponse) {
+				assert.Empty(t, out.Results)
+			},
+		},
+		{
+			name:  "【正常系】カテゴリと営業中の絞り込み条件を検索条件として渡す",
+			input: usecase.SearchSpotsInput{Token: "valid_token", Query: "らーめん", Category: "ramen", OpenNow: true},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("SearchByName", mock.Anything, mock.MatchedBy(func(c entities.SpotSearchCriteria) bool {
+					return c.Filter.Category == value_objects.CategoryRamen && c.Filter.OpenAt != nil
+				})).Return([]entities.SpotSearchHit{}, nil)
+			},
+			check: func(t *testing.T, out *usecase.SearchSpotsResponse) {
+				assert.Empty(t, out.Results)
+			},
+		},
+		{
+			name:  "【異常系】未知のカテゴリは入力エラー",
+			input: usecase.SearchSpotsInput{Token: "valid_token", Query: "らーめん", Category: "fastfood"},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】空白のみのキーワードは入力エラー",
+			input: usecase.SearchSpotsInput{Token: "valid_token", Query: "　 "},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】範囲外の緯度は入力エラー",
+			input: usecase.SearchSpotsInput{Token: "valid_token", Query: "うどん", Latitude: &badLat, Longitude: &lng},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】検索クエリでDBエラーが発生した場合",
+			input: usecase.SearchSpotsInput{Token: "valid_token", Query: "うどん"},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(malloy, nil)
+				sm.On("SearchByName", mock.Anything, mock.Anything).Return(nil, errors.New("db search error"))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "【異常系】トークンが不正な場合は認証エラー",
+			input: usecase.SearchSpotsInput{Token: "bad_token", Query: "うどん"},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "bad_token").Return((*entities.User)(nil), errors.New("invalid token"))
+			},
+			wantErr: true,
+			errIs:   usecase.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, sm := new(MockAuthService), new(MockSpotRepository)
+			tt.setupMock(am, sm)
+			interactor := usecase.NewSearchSpotsInteractor(&SearchSpotsMockPresenter{}, sm, am)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			am.AssertExpectations(t)
+			sm.AssertExpectations(t)
+		})
+	}
+}
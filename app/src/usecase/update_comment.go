@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+type UpdateCommentInput struct {
+	Token     string
+	CommentID int
+	Body      string
+}
+
+type UpdateCommentUseCase interface {
+	Execute(ctx context.Context, input UpdateCommentInput) (*CommentPayload, error)
+}
+
+type updateCommentInteractor struct {
+	presenter   CommentPresenter
+	commentRepo entities.CommentRepository
+	moderator   services.CommentModerator
+	authService services.AuthDomainService
+}
+
+func NewUpdateCommentInteractor(
+	p CommentPresenter,
+	c entities.CommentRepository,
+	m services.CommentModerator,
+	a services.AuthDomainService,
+) UpdateCommentUseCase {
+	return &updateCommentInteractor{
+		presenter:   p,
+		commentRepo: c,
+		moderator:   m,
+		authService: a,
+	}
+}
+
+func (i *updateCommentInteractor) Execute(ctx context.Context, input UpdateCommentInput) (*CommentPayload, error) {
+	user, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	commentID, err := value_objects.NewID(input.CommentID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	body, err := value_objects.NewCommentBody(input.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	// 1. 編集できるのは削除していない自分のコメントだけ
+	comment, err := i.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		return nil, fmt.Errorf("comment lookup error: %w", err)
+	}
+	if comment == nil || comment.IsDeleted() || !canViewComment(comment, user.ID) {
+		return nil, fmt.Errorf("%w: comment %d", ErrNotFound, commentID.Value())
+	}
+	if comment.UserID != user.ID {
+		return nil, fmt.Errorf("%w: only the author can edit the comment", ErrForbidden)
+	}
+
+	// 2. 新しい本文を審査して保存する
+	now := time.Now()
+	comment.Body = body
+	comment.UpdatedAt = &now
+	if err := moderateComment(ctx, i.moderator, comment); err != nil {
+		return nil, err
+	}
+	if err := i.commentRepo.Update(ctx, comment); err != nil {
+		return nil, fmt.Errorf("comment save error: %w", err)
+	}
+	return i.presenter.Output(comment), nil
+}
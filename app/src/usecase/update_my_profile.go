@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+// UpdateMyProfileInput は変更する項目のみを指定します（nil の項目は変更しない）。
+type UpdateMyProfileInput struct {
+	Token       string
+	Username    *string
+	DisplayName *string
+	Bio         *string
+	HomeArea    *string
+	// AvatarImageID は POST /v1/images でアップロードした自分の画像です。0 を指定するとアイコンを外します。
+	AvatarImageID *int
+}
+
+type UpdateMyProfileUseCase interface {
+	Execute(ctx context.Context, input UpdateMyProfileInput) (*UserProfileResponse, error)
+}
+
+type updateMyProfileInteractor struct {
+	presenter   UserProfilePresenter
+	userRepo    entities.UserRepository
+	imageRepo   entities.ImageRepository
+	authService services.AuthDomainService
+}
+
+func NewUpdateMyProfileInteractor(
+	p UserProfilePresenter,
+	u entities.UserRepository,
+	ir entities.ImageRepository,
+	a services.AuthDomainService,
+) UpdateMyProfileUseCase {
+	return &updateMyProfileInteractor{
+		presenter:   p,
+		userRepo:    u,
+		imageRepo:   ir,
+		authService: a,
+	}
+}
+
+func (i *updateMyProfileInteractor) Execute(ctx context.Context, input UpdateMyProfileInput) (*UserProfileResponse, error) {
+	tokenUser, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+	user, err := i.userRepo.FindByID(tokenUser.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	// 1. 各項目の検証（1つでも不正なら何も保存しない）
+	if input.DisplayName != nil {
+		if user.DisplayName, err = value_objects.NewDisplayName(*input.DisplayName); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+	}
+	if input.Bio != nil {
+		if user.Bio, err = value_objects.NewBio(*input.Bio); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+	}
+	if input.HomeArea != nil {
+		if user.HomeArea, err = value_objects.NewHomeArea(*input.HomeArea); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+	}
+	if input.AvatarImageID != nil {
+		if user.Avatar, err = i.resolveAvatar(ctx, *input.AvatarImageID, user); err != nil {
+			return nil, err
+		}
+	}
+
+	// 2. ユーザー名の変更は他のユーザーと重複しないこと
+	renamed := false
+	if input.Username != nil && *input.Username != user.Username.String() {
+		username, err := value_objects.NewUsername(*input.Username)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+		other, err := i.userRepo.FindByUsername(ctx, username.String())
+		if err != nil {
+			return nil, fmt.Errorf("user lookup error: %w", err)
+		}
+		if other != nil && other.ID != user.ID {
+			return nil, fmt.Errorf("%w: username %q is already taken", ErrConflict, username.String())
+		}
+		user.Username = username
+		renamed = true
+	}
+
+	// 3. 保存（ユーザー名を変えた場合は過去の投稿の表示名もリポジトリ側で揃える）
+	if err := i.userRepo.Update(user); err != nil {
+		if errors.Is(err, entities.ErrUsernameTaken) {
+			return nil, fmt.Errorf("%w: username %q is already taken", ErrConflict, user.Username.String())
+		}
+		return nil, fmt.Errorf("user storage error: %w", err)
+	}
+
+	output := i.presenter.Output(user)
+	// トークンにはユーザー名が埋め込まれているため、変更後のユーザー名で発行し直す
+	if renamed {
+		token, err := i.authService.IssueToken(ctx, user)
+		if err != nil {
+			return nil, fmt.Errorf("token issue error: %w", err)
+		}
+		output.Token = &token
+	}
+	return output, nil
+}
+
+// resolveAvatar は本人がアップロードした画像をアイコンとして引き当てます。0 はアイコンを外す指定です。
+func (i *updateMyProfileInteractor) resolveAvatar(ctx context.Context, id int, user *entities.User) (entities.UserAvatar, error) {
+	if id == 0 {
+		return entities.UserAvatar{}, nil
+	}
+	imageID, err := value_objects.NewID(id)
+	if err != nil {
+		return entities.UserAvatar{}, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	image, err := i.imageRepo.FindByID(ctx, imageID)
+	if err != nil {
+		return entities.UserAvatar{}, fmt.Errorf("image lookup error: %w", err)
+	}
+	// 他人の画像は存在しないものとして扱う
+	if image == nil || image.OwnerID != user.ID {
+		return entities.UserAvatar{}, fmt.Errorf("%w: image %d", ErrNotFound, id)
+	}
+	return entities.UserAvatar{ImageID: image.ID, URL: image.URL, Thumb: image.Thumb.URL}, nil
+}
@@ -0,0 +1,193 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"app/src/domain/entities"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type UserProfileMockPresenter struct{}
+
+func (p *UserProfileMockPresenter) Output(user *entities.User) *usecase.UserProfileResponse {
+	out := &usecase.UserProfileResponse{
+		ID:          user.ID.Value(),
+		Username:    user.Username.String(),
+		DisplayName: user.DisplayName.String(),
+		Bio:         user.Bio.String(),
+		HomeArea:    user.HomeArea.String(),
+	}
+	if user.Avatar.IsSet() {
+		out.Avatar = &usecase.UserAvatarPayload{ImageID: user.Avatar.ImageID.Value(), URL: user.Avatar.URL.String()}
+	}
+	return out
+}
+
+func strPtr(s string) *string { return &s }
+func intPtr(i int) *int       { return &i }
+
+func TestUpdateMyProfile_Execute(t *testing.T) {
+	tokenUser, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+	stored := func() *entities.User {
+		u, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+		u.DisplayName = "マロイ"
+		return u
+	}
+	other, _ := entities.NewUser(3, "taken_name", "taro@example.com", "hashed_password")
+	avatar, _ := entities.NewImage(7, 2, "images/7.jpg", "https://cdn.example.com/7.jpg", "image/jpeg", 1024)
+	othersImage, _ := entities.NewImage(8, 3, "images/8.jpg", "https://cdn.example.com/8.jpg", "image/jpeg", 1024)
+
+	tests := []struct {
+		name      string
+		input     usecase.UpdateMyProfileInput
+		setupMock func(am *MockAuthService, um *MockUserRepository, im *MockImageRepository)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.UserProfileResponse)
+	}{
+		{
+			name: "【正常系】指定した項目だけを更新し、前後の空白を除く",
+			input: usecase.UpdateMyProfileInput{
+				Token: "valid_token", Bio: strPtr("  二郎とカレーが好き  "), HomeArea: strPtr("三田・田町"), AvatarImageID: intPtr(7),
+			},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, im *MockImageRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(tokenUser, nil)
+				um.On("FindByID", tokenUser.ID).Return(stored(), nil)
+				im.On("FindByID", mock.Anything, avatar.ID).Return(avatar, nil)
+				um.On("Update", mock.MatchedBy(func(u *entities.User) bool {
+					return u.Bio == "二郎とカレーが好き" && u.DisplayName == "マロイ" && u.Avatar.ImageID == avatar.ID
+				})).Return(nil)
+			},
+			check: func(t *testing.T, out *usecase.UserProfileResponse) {
+				assert.Equal(t, "三田・田町", out.HomeArea)
+				assert.Equal(t, "マロイ", out.DisplayName)
+				if assert.NotNil(t, out.Avatar) {
+					assert.Equal(t, "https://cdn.example.com/7.jpg", out.Avatar.URL)
+				}
+				assert.Nil(t, out.Token)
+			},
+		},
+		{
+			name:  "【正常系】ユーザー名を変更するとトークンを発行し直す",
+			input: usecase.UpdateMyProfileInput{Token: "valid_token", Username: strPtr("new_malloy")},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, im *MockImageRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(tokenUser, nil)
+				um.On("FindByID", tokenUser.ID).Return(stored(), nil)
+				um.On("FindByUsername", mock.Anything, "new_malloy").Return(nil, nil)
+				um.On("Update", mock.MatchedBy(func(u *entities.User) bool { return u.Username == "new_malloy" })).Return(nil)
+			},
+			check: func(t *testing.T, out *usecase.UserProfileResponse) {
+				assert.Equal(t, "new_malloy", out.Username)
+				assert.NotNil(t, out.Token)
+			},
+		},
+		{
+			name:  "【正常系】avatar_image_id に 0 を指定するとアイコンを外す",
+			input: usecase.UpdateMyProfileInput{Token: "valid_token", AvatarImageID: intPtr(0)},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, im *MockImageRepository) {
+				withAvatar := stored()
+				withAvatar.Avatar = entities.UserAvatar{ImageID: avatar.ID, URL: avatar.URL}
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(tokenUser, nil)
+				um.On("FindByID", tokenUser.ID).Return(withAvatar, nil)
+				um.On("Update", mock.MatchedBy(func(u *entities.User) bool { return !u.Avatar.IsSet() })).Return(nil)
+			},
+			check: func(t *testing.T, out *usecase.UserProfileResponse) {
+				assert.Nil(t, out.Avatar)
+			},
+		},
+		{
+			name:  "【異常系】他のユーザーが使っているユーザー名には変更できない",
+			input: usecase.UpdateMyProfileInput{Token: "valid_token", Username: strPtr("taken_name")},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, im *MockImageRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(tokenUser, nil)
+				um.On("FindByID", tokenUser.ID).Return(stored(), nil)
+				um.On("FindByUsername", mock.Anything, "taken_name").Return(other, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrConflict,
+		},
+		{
+			name:  "【異常系】重複チェックの後に同じユーザー名を取られた場合も Conflict",
+			input: usecase.UpdateMyProfileInput{Token: "valid_token", Username: strPtr("new_malloy")},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, im *MockImageRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(tokenUser, nil)
+				um.On("FindByID", tokenUser.ID).Return(stored(), nil)
+				um.On("FindByUsername", mock.Anything, "new_malloy").Return(nil, nil)
+				um.On("Update", mock.Anything).Return(entities.ErrUsernameTaken)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrConflict,
+		},
+		{
+			name:  "【異常系】他人の画像はアイコンにできない",
+			input: usecase.UpdateMyProfileInput{Token: "valid_token", AvatarImageID: intPtr(8)},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, im *MockImageRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(tokenUser, nil)
+				um.On("FindByID", tokenUser.ID).Return(stored(), nil)
+				im.On("FindByID", mock.Anything, othersImage.ID).Return(othersImage, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrNotFound,
+		},
+		{
+			name:  "【異常系】表示名が長すぎる場合は何も保存せず入力エラー",
+			input: usecase.UpdateMyProfileInput{Token: "valid_token", DisplayName: strPtr(string(make([]rune, 51)))},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, im *MockImageRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(tokenUser, nil)
+				um.On("FindByID", tokenUser.ID).Return(stored(), nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】DBエラーが発生した場合",
+			input: usecase.UpdateMyProfileInput{Token: "valid_token", Bio: strPtr("")},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, im *MockImageRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(tokenUser, nil)
+				um.On("FindByID", tokenUser.ID).Return(stored(), nil)
+				um.On("Update", mock.Anything).Return(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "【異常系】トークンが不正な場合は認証エラー",
+			input: usecase.UpdateMyProfileInput{Token: "bad_token"},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, im *MockImageRepository) {
+				am.On("VerifyToken", mock.Anything, "bad_token").Return((*entities.User)(nil), errors.New("invalid token"))
+			},
+			wantErr: true,
+			errIs:   usecase.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, um, im := new(MockAuthService), new(MockUserRepository), new(MockImageRepository)
+			tt.setupMock(am, um, im)
+			interactor := usecase.NewUpdateMyProfileInteractor(&UserProfileMockPresenter{}, um, im, am)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			am.AssertExpectations(t)
+			um.AssertExpectations(t)
+			im.AssertExpectations(t)
+		})
+	}
+}
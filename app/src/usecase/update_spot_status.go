@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+type UpdateSpotStatusInput struct {
+	Token  string
+	SpotID int
+	// Status は "closed"（閉店の確定）または "active"（復元・報告の却下）です。
+	Status string
+}
+
+type UpdateSpotStatusResponse struct {
+	SpotID         int    `json:"spot_id"`
+	PreviousStatus string `json:"previous_status"`
+	Status         string `json:"status"`
+}
+
+type UpdateSpotStatusPresenter interface {
+	Output(spot *entities.Spot, previous entities.SpotStatus) *UpdateSpotStatusResponse
+}
+
+type UpdateSpotStatusUseCase interface {
+	Execute(ctx context.Context, input UpdateSpotStatusInput) (*UpdateSpotStatusResponse, error)
+}
+
+type updateSpotStatusInteractor struct {
+	presenter   UpdateSpotStatusPresenter
+	spotRepo    entities.SpotRepository
+	userRepo    entities.UserRepository
+	authService services.AuthDomainService
+}
+
+func NewUpdateSpotStatusInteractor(
+	p UpdateSpotStatusPresenter,
+	s entities.SpotRepository,
+	u entities.UserRepository,
+	a services.AuthDomainService,
+) UpdateSpotStatusUseCase {
+	return &updateSpotStatusInteractor{
+		presenter:   p,
+		spotRepo:    s,
+		userRepo:    u,
+		authService: a,
+	}
+}
+
+func (i *updateSpotStatusInteractor) Execute(ctx context.Context, input UpdateSpotStatusInput) (*UpdateSpotStatusResponse, error) {
+	// 1. 操作者の特定と管理者権限の確認
+	tokenUser, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+	operator, err := i.userRepo.FindByID(tokenUser.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+	if !operator.IsAdmin {
+		return nil, ErrAdminRequired
+	}
+
+	// reported は閉店報告によってのみ遷移する状態のため、運営者が直接設定することはできない。
+	status := entities.SpotStatus(input.Status)
+	if status != entities.SpotClosed && status != entities.SpotActive {
+		return nil, fmt.Errorf("%w: status must be \"closed\" or \"active\"", ErrInvalidInput)
+	}
+	spotID, err := value_objects.NewID(input.SpotID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	// 2. 対象スポットの取得と状態の変更（閉店済みのスポットも投稿・履歴ごと残っているため復元できる）
+	spot, err := i.spotRepo.FindByID(ctx, spotID)
+	if err != nil {
+		return nil, fmt.Errorf("repository error: %w", err)
+	}
+	if spot == nil {
+		return nil, fmt.Errorf("%w: %d", ErrSpotNotFound, spotID.Value())
+	}
+
+	previous := spot.Status
+	if err := i.spotRepo.UpdateStatus(ctx, spot.ID, status); err != nil {
+		return nil, fmt.Errorf("status update error: %w", err)
+	}
+	spot.Status = status
+
+	return i.presenter.Output(spot, previous), nil
+}
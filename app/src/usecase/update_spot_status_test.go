@@ -0,0 +1,133 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"app/src/domain/entities"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type UpdateSpotStatusMockPresenter struct{}
+
+func (p *UpdateSpotStatusMockPresenter) Output(spot *entities.Spot, previous entities.SpotStatus) *usecase.UpdateSpotStatusResponse {
+	return &usecase.UpdateSpotStatusResponse{SpotID: spot.ID.Value(), PreviousStatus: string(previous), Status: string(spot.Status)}
+}
+
+func TestUpdateSpotStatus_Execute(t *testing.T) {
+	admin, _ := entities.NewUser(1, "trapizzino_admin", "admin@example.com", "hashed_password")
+	admin.IsAdmin = true
+	malloy, _ := entities.NewUser(2, "local_malloy", "malloy@example.com", "hashed_password")
+
+	tests := []struct {
+		name      string
+		input     usecase.UpdateSpotStatusInput
+		setupMock func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.UpdateSpotStatusResponse)
+	}{
+		{
+			name:  "【正常系】管理者が報告済みのスポットの閉店を確定する",
+			input: usecase.UpdateSpotStatusInput{Token: "admin_token", SpotID: 1, Status: "closed"},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository) {
+				spot, _ := entities.NewSpot(1, "恵比寿うどん", 35.6467, 139.7101, 1)
+				spot.Status = entities.SpotReported
+				am.On("VerifyToken", mock.Anything, "admin_token").Return(admin, nil)
+				um.On("FindByID", admin.ID).Return(admin, nil)
+				sm.On("FindByID", mock.Anything, spot.ID).Return(spot, nil)
+				sm.On("UpdateStatus", mock.Anything, spot.ID, entities.SpotClosed).Return(nil)
+			},
+			check: func(t *testing.T, out *usecase.UpdateSpotStatusResponse) {
+				assert.Equal(t, "reported", out.PreviousStatus)
+				assert.Equal(t, "closed", out.Status)
+			},
+		},
+		{
+			name:  "【正常系】管理者が閉店済みのスポットを復元する",
+			input: usecase.UpdateSpotStatusInput{Token: "admin_token", SpotID: 1, Status: "active"},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository) {
+				spot, _ := entities.NewSpot(1, "恵比寿うどん", 35.6467, 139.7101, 1)
+				spot.Status = entities.SpotClosed
+				am.On("VerifyToken", mock.Anything, "admin_token").Return(admin, nil)
+				um.On("FindByID", admin.ID).Return(admin, nil)
+				sm.On("FindByID", mock.Anything, spot.ID).Return(spot, nil)
+				sm.On("UpdateStatus", mock.Anything, spot.ID, entities.SpotActive).Return(nil)
+			},
+			check: func(t *testing.T, out *usecase.UpdateSpotStatusResponse) {
+				assert.Equal(t, "closed", out.PreviousStatus)
+				assert.Equal(t, "active", out.Status)
+			},
+		},
+		{
+			name:  "【異常系】reported を直接設定することはできない",
+			input: usecase.UpdateSpotStatusInput{Token: "admin_token", SpotID: 1, Status: "reported"},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "admin_token").Return(admin, nil)
+				um.On("FindByID", admin.ID).Return(admin, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name:  "【異常系】一般ユーザーは状態を変更できない",
+			input: usecase.UpdateSpotStatusInput{Token: "user_token", SpotID: 1, Status: "closed"},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "user_token").Return(malloy, nil)
+				um.On("FindByID", malloy.ID).Return(malloy, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrAdminRequired,
+		},
+		{
+			name:  "【異常系】存在しないスポット",
+			input: usecase.UpdateSpotStatusInput{Token: "admin_token", SpotID: 404, Status: "closed"},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "admin_token").Return(admin, nil)
+				um.On("FindByID", admin.ID).Return(admin, nil)
+				sm.On("FindByID", mock.Anything, mock.Anything).Return((*entities.Spot)(nil), nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrSpotNotFound,
+		},
+		{
+			name:  "【異常系】トークンが不正で認証に失敗する",
+			input: usecase.UpdateSpotStatusInput{Token: "bad_token", SpotID: 1, Status: "closed"},
+			setupMock: func(am *MockAuthService, um *MockUserRepository, sm *MockSpotRepository) {
+				am.On("VerifyToken", mock.Anything, "bad_token").Return((*entities.User)(nil), errors.New("invalid token"))
+			},
+			wantErr: true,
+			errIs:   usecase.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, um, sm := new(MockAuthService), new(MockUserRepository), new(MockSpotRepository)
+			tt.setupMock(am, um, sm)
+			interactor := usecase.NewUpdateSpotStatusInteractor(&UpdateSpotStatusMockPresenter{}, sm, um, am)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			am.AssertExpectations(t)
+			um.AssertExpectations(t)
+			sm.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"image"
+	_ "image/jpeg" // DecodeConfig で JPEG を判別するため
+	_ "image/png"  // DecodeConfig で PNG を判別するため
+	"io"
+	"net/http"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+)
+
+// MaxImageUploadBytes はアップロードできる画像1枚あたりの最大サイズです。
+const MaxImageUploadBytes = 10 << 20
+
+// maxImagePixels は展開後の画素数の上限です（小さなファイルで巨大な画像を送る攻撃への対策）。
+const maxImagePixels = 40_000_000
+
+// allowedImageTypes は受け付ける画像形式と、保存時の拡張子です。
+var allowedImageTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+type UploadImageInput struct {
+	Token string
+	Body  io.Reader
+}
+
+type UploadImageResponse struct {
+	ID          int                  `json:"id"`
+	URL         string               `json:"url"`
+	ContentType string               `json:"content_type"`
+	Size        int64                `json:"size"`
+	Width       int                  `json:"width"`
+	Height      int                  `json:"height"`
+	Images      ImageVariantsPayload `json:"images"`
+	// Location / CapturedAt は EXIF から読み取った撮影地点・撮影日時です（なければ null）。
+	// 投稿時に image_id を渡すと、サーバー側でもこの座標を使って投稿先を確認します。
+	Location   *ImageLocationPayload `json:"location"`
+	CapturedAt *string               `json:"captured_at"`
+}
+
+type ImageLocationPayload struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// ImageVariantsPayload は画像のサイズ別URLです。派生サイズを持たない画像では元画像のURLで代替します。
+// original はアップロード時の形式のままですが、medium / thumb は元画像の形式によらず常に JPEG です。
+// WebP の画像は派生サイズを生成しないため、3つとも元画像（WebP）のURLになります。
+type ImageVariantsPayload struct {
+	Original string `json:"original"`
+	Medium   string `json:"medium"`
+	Thumb    string `json:"thumb"`
+}
+
+// PostPhotoPayload は投稿の添付画像1枚分です。position 0 がカバー画像（image_url）です。
+type PostPhotoPayload struct {
+	Position int                  `json:"position"`
+	URL      string               `json:"url"`
+	AltText  string               `json:"alt_text"`
+	Images   ImageVariantsPayload `json:"images"`
+}
+
+type UploadImagePresenter interface {
+	Output(image *entities.Image) *UploadImageResponse
+}
+
+type UploadImageUseCase interface {
+	Execute(ctx context.Context, input UploadImageInput) (*UploadImageResponse, error)
+}
+
+type uploadImageInteractor struct {
+	presenter   UploadImagePresenter
+	imageRepo   entities.ImageRepository
+	blobStore   services.BlobStore
+	processor   services.ImageProcessor
+	authService services.AuthDomainService
+}
+
+func NewUploadImageInteractor(
+	p UploadImagePresenter,
+	r entities.ImageRepository,
+	b services.BlobStore,
+	ip services.ImageProcessor,
+	a services.AuthDomainService,
+) UploadImageUseCase {
+	return &uploadImageInteractor{
+		presenter:   p,
+		imageRepo:   r,
+		blobStore:   b,
+		processor:   ip,
+		authService: a,
+	}
+}
+
+func (i *uploadImageInteractor) Execute(ctx context.Context, input UploadImageInput) (*UploadImageResponse, error) {
+	user, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	// 1. サイズの検証（申告値は信用せず、上限+1バイトまで読んで判定する）
+	data, err := io.ReadAll(io.LimitReader(input.Body, MaxImageUploadBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w: empty file", ErrInvalidInput)
+	}
+	if len(data) > MaxImageUploadBytes {
+		return nil, fmt.Errorf("%w: image must be at most %d bytes", ErrTooLarge, MaxImageUploadBytes)
+	}
+
+	// 2. MIMEタイプの検証（拡張子やヘッダーではなく中身から判定する）
+	contentType := http.DetectContentType(data)
+	ext, ok := allowedImageTypes[contentType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupported, contentType)
+	}
+	if contentType != "image/webp" {
+		cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("%w: broken image: %v", ErrInvalidInput, err)
+		}
+		if cfg.Width*cfg.Height > maxImagePixels {
+			return nil, fmt.Errorf("%w: image dimensions %dx%d are too large", ErrTooLarge, cfg.Width, cfg.Height)
+		}
+	}
+
+	// 3. メタデータ（EXIF 等）の除去と派生サイズの生成
+	processed, err := i.processor.Process(data, contentType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: broken image: %v", ErrInvalidInput, err)
+	}
+	if processed.Original.Width*processed.Original.Height > maxImagePixels {
+		return nil, fmt.Errorf("%w: image dimensions %dx%d are too large", ErrTooLarge, processed.Original.Width, processed.Original.Height)
+	}
+
+	// 4. 保存（キーは推測されないランダム値）とメタデータの記録
+	base, err := newImageKeyBase(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	var stored []string
+	rollback := func() {
+		// メタデータのない実体は孤立画像の回収対象にならないため、ここで消しておく。
+		for _, key := range stored {
+			i.blobStore.Delete(ctx, key)
+		}
+	}
+	put := func(key string, r services.ImageRendition) error {
+		if err := i.blobStore.Put(ctx, key, r.ContentType, bytes.NewReader(r.Data), int64(len(r.Data))); err != nil {
+			return fmt.Errorf("blob storage error: %w", err)
+		}
+		stored = append(stored, key)
+		return nil
+	}
+
+	key := base + ext
+	if err := put(key, processed.Original); err != nil {
+		rollback()
+		return nil, err
+	}
+	img, err := entities.NewImage(0, user.ID.Value(), key, i.blobStore.URL(key), contentType, int64(len(processed.Original.Data)))
+	if err != nil {
+		rollback()
+		return nil, fmt.Errorf("image url error: %w", err)
+	}
+	img.Width, img.Height = processed.Original.Width, processed.Original.Height
+	if meta := processed.Metadata; meta.Latitude != nil && meta.Longitude != nil {
+		// 範囲外の座標は壊れた EXIF とみなし、位置情報なしとして扱う。
+		img.GeoTag, _ = entities.NewImageGeoTag(*meta.Latitude, *meta.Longitude)
+	}
+	img.CapturedAt = processed.Metadata.CapturedAt
+
+	variants := []struct {
+		suffix    string
+		rendition *services.ImageRendition
+		target    *entities.ImageVariant
+	}{
+		{"_medium.jpg", processed.Medium, &img.Medium},
+		{"_thumb.jpg", processed.Thumb, &img.Thumb},
+	}
+	for _, v := range variants {
+		if v.rendition == nil {
+			continue
+		}
+		variantKey := base + v.suffix
+		if err := put(variantKey, *v.rendition); err != nil {
+			rollback()
+			return nil, err
+		}
+		if *v.target, err = entities.NewImageVariant(variantKey, i.blobStore.URL(variantKey)); err != nil {
+			rollback()
+			return nil, fmt.Errorf("image url error: %w", err)
+		}
+	}
+
+	img, err = i.imageRepo.Create(ctx, img)
+	if err != nil {
+		rollback()
+		return nil, fmt.Errorf("image storage error: %w", err)
+	}
+
+	return i.presenter.Output(img), nil
+}
+
+// newImageKeyBase は images/<年>/<月>/<ランダム32桁> 形式の保存キーの基部を生成します。
+// 元画像は拡張子を、派生サイズは _medium.jpg / _thumb.jpg を付けて保存します。
+func newImageKeyBase(now time.Time) (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("images/%s/%s", now.UTC().Format("2006/01"), hex.EncodeToString(buf)), nil
+}
@@ -0,0 +1,313 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- MOCK 定義 ---
+
+type MockImageRepository struct{ mock.Mock }
+
+func (m *MockImageRepository) Create(ctx context.Context, img *entities.Image) (*entities.Image, error) {
+	args := m.Called(ctx, img)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	// 採番はDBが行うため、テスト側で保存結果を組み立てられるようにする
+	if fn, ok := args.Get(0).(func(*entities.Image) *entities.Image); ok {
+		return fn(img), args.Error(1)
+	}
+	return args.Get(0).(*entities.Image), args.Error(1)
+}
+func (m *MockImageRepository) FindByID(ctx context.Context, id value_objects.ID) (*entities.Image, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Image), args.Error(1)
+}
+func (m *MockImageRepository) FindOrphans(ctx context.Context, createdBefore time.Time, limit int) ([]*entities.Image, error) {
+	args := m.Called(ctx, createdBefore, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Image), args.Error(1)
+}
+func (m *MockImageRepository) Delete(ctx context.Context, id value_objects.ID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+type MockBlobStore struct{ mock.Mock }
+
+func (m *MockBlobStore) Put(ctx context.Context, key, contentType string, body io.Reader, size int64) error {
+	return m.Called(ctx, key, contentType, size).Error(0)
+}
+func (m *MockBlobStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+func (m *MockBlobStore) Delete(ctx context.Context, key string) error {
+	return m.Called(ctx, key).Error(0)
+}
+func (m *MockBlobStore) URL(key string) string {
+	return "https://cdn.example.com/" + key
+}
+
+type MockImageProcessor struct{ mock.Mock }
+
+func (m *MockImageProcessor) Process(data []byte, contentType string) (*services.ProcessedImage, error) {
+	args := m.Called(data, contentType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*services.ProcessedImage), args.Error(1)
+}
+
+type UploadImageMockPresenter struct{}
+
+func (p *UploadImageMockPresenter) Output(img *entities.Image) *usecase.UploadImageResponse {
+	return &usecase.UploadImageResponse{
+		ID: img.ID.Value(), URL: img.URL.String(), ContentType: img.ContentType, Size: img.Size,
+		Width: img.Width, Height: img.Height,
+		Images: usecase.ImageVariantsPayload{Original: img.URL.String(), Medium: img.Medium.URL.String(), Thumb: img.Thumb.URL.String()},
+	}
+}
+
+func testPNG(t *testing.T) []byte {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 4, 4))); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestUploadImage_Execute(t *testing.T) {
+	alice, _ := entities.NewUser(3, "alice", "alice@example.com", "hashed_password")
+	pngData := testPNG(t)
+	webpData := []byte("RIFF\x1a\x00\x00\x00WEBPVP8L\x0d\x00\x00\x00\x2f\x00\x00\x00\x10\x07\x10\x11\x11\x88\x88\xfe\x07\x00")
+	keyWithSuffix := func(suffix string) interface{} {
+		return mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "images/") && strings.HasSuffix(key, suffix)
+		})
+	}
+	processed := &services.ProcessedImage{
+		Original: services.ImageRendition{Data: []byte("stripped-png"), ContentType: "image/png", Width: 4, Height: 4},
+		Medium:   &services.ImageRendition{Data: []byte("medium"), ContentType: "image/jpeg", Width: 4, Height: 4},
+		Thumb:    &services.ImageRendition{Data: []byte("thumb"), ContentType: "image/jpeg", Width: 4, Height: 4},
+	}
+
+	tests := []struct {
+		name      string
+		body      []byte
+		setupMock func(am *MockAuthService, im *MockImageRepository, bm *MockBlobStore, pm *MockImageProcessor)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.UploadImageResponse)
+	}{
+		{
+			name: "【正常系】メタデータを除いた元画像と派生サイズを保存し、投稿に使えるURLを返す",
+			body: pngData,
+			setupMock: func(am *MockAuthService, im *MockImageRepository, bm *MockBlobStore, pm *MockImageProcessor) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(alice, nil)
+				pm.On("Process", pngData, "image/png").Return(processed, nil)
+				bm.On("Put", mock.Anything, keyWithSuffix(".png"), "image/png", int64(len("stripped-png"))).Return(nil)
+				bm.On("Put", mock.Anything, keyWithSuffix("_medium.jpg"), "image/jpeg", int64(len("medium"))).Return(nil)
+				bm.On("Put", mock.Anything, keyWithSuffix("_thumb.jpg"), "image/jpeg", int64(len("thumb"))).Return(nil)
+				im.On("Create", mock.Anything, mock.MatchedBy(func(img *entities.Image) bool {
+					return img.OwnerID == alice.ID && img.ContentType == "image/png" && img.Width == 4 &&
+						strings.HasSuffix(img.Thumb.StorageKey, "_thumb.jpg") && strings.HasSuffix(img.Medium.StorageKey, "_medium.jpg")
+				})).Return(func(img *entities.Image) *entities.Image {
+					img.ID = 42
+					return img
+				}, nil)
+			},
+			check: func(t *testing.T, out *usecase.UploadImageResponse) {
+				assert.Equal(t, 42, out.ID)
+				assert.Equal(t, "image/png", out.ContentType)
+				assert.Equal(t, int64(len("stripped-png")), out.Size)
+				_, err := value_objects.NewImageURL(out.URL)
+				assert.NoError(t, err)
+				assert.True(t, strings.HasPrefix(out.URL, "https://cdn.example.com/images/"))
+				assert.True(t, strings.HasSuffix(out.Images.Thumb, "_thumb.jpg"))
+				assert.True(t, strings.HasSuffix(out.Images.Medium, "_medium.jpg"))
+			},
+		},
+		{
+			name: "【正常系】派生サイズを生成できない形式は元画像のみ保存する",
+			body: webpData,
+			setupMock: func(am *MockAuthService, im *MockImageRepository, bm *MockBlobStore, pm *MockImageProcessor) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(alice, nil)
+				pm.On("Process", webpData, "image/webp").Return(&services.ProcessedImage{
+					Original: services.ImageRendition{Data: webpData, ContentType: "image/webp", Width: 1, Height: 1},
+				}, nil)
+				bm.On("Put", mock.Anything, keyWithSuffix(".webp"), "image/webp", int64(len(webpData))).Return(nil)
+				im.On("Create", mock.Anything, mock.MatchedBy(func(img *entities.Image) bool {
+					return img.Thumb.StorageKey == "" && img.Medium.StorageKey == ""
+				})).Return(func(img *entities.Image) *entities.Image {
+					img.ID = 43
+					return img
+				}, nil)
+			},
+			check: func(t *testing.T, out *usecase.UploadImageResponse) {
+				assert.Equal(t, "image/webp", out.ContentType)
+			},
+		},
+		{
+			name: "【異常系】画像以外のファイルは受け付けない",
+			body: []byte("%PDF-1.4 not an image"),
+			setupMock: func(am *MockAuthService, im *MockImageRepository, bm *MockBlobStore, pm *MockImageProcessor) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(alice, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrUnsupported,
+		},
+		{
+			name: "【異常系】上限サイズを超える画像は受け付けない",
+			body: append(append([]byte{}, pngData...), make([]byte, usecase.MaxImageUploadBytes)...),
+			setupMock: func(am *MockAuthService, im *MockImageRepository, bm *MockBlobStore, pm *MockImageProcessor) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(alice, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrTooLarge,
+		},
+		{
+			name: "【異常系】形式を偽装した壊れた画像は入力エラー",
+			body: pngData[:20],
+			setupMock: func(am *MockAuthService, im *MockImageRepository, bm *MockBlobStore, pm *MockImageProcessor) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(alice, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+		{
+			name: "【異常系】派生サイズの保存に失敗した場合は保存済みの実体を削除する",
+			body: pngData,
+			setupMock: func(am *MockAuthService, im *MockImageRepository, bm *MockBlobStore, pm *MockImageProcessor) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(alice, nil)
+				pm.On("Process", pngData, "image/png").Return(processed, nil)
+				bm.On("Put", mock.Anything, keyWithSuffix(".png"), "image/png", mock.Anything).Return(nil)
+				bm.On("Put", mock.Anything, keyWithSuffix("_medium.jpg"), "image/jpeg", mock.Anything).Return(errors.New("disk full"))
+				bm.On("Delete", mock.Anything, keyWithSuffix(".png")).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "【異常系】メタデータの保存に失敗した場合は保存済みの実体をすべて削除する",
+			body: pngData,
+			setupMock: func(am *MockAuthService, im *MockImageRepository, bm *MockBlobStore, pm *MockImageProcessor) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(alice, nil)
+				pm.On("Process", pngData, "image/png").Return(processed, nil)
+				bm.On("Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(3)
+				im.On("Create", mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+				bm.On("Delete", mock.Anything, mock.Anything).Return(nil).Times(3)
+			},
+			wantErr: true,
+		},
+		{
+			name: "【異常系】認証エラー",
+			body: pngData,
+			setupMock: func(am *MockAuthService, im *MockImageRepository, bm *MockBlobStore, pm *MockImageProcessor) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(nil, errors.New("invalid"))
+			},
+			wantErr: true,
+			errIs:   usecase.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, im, bm, pm := new(MockAuthService), new(MockImageRepository), new(MockBlobStore), new(MockImageProcessor)
+			tt.setupMock(am, im, bm, pm)
+			interactor := usecase.NewUploadImageInteractor(&UploadImageMockPresenter{}, im, bm, pm, am)
+
+			out, err := interactor.Execute(context.Background(), usecase.UploadImageInput{Token: "valid_token", Body: bytes.NewReader(tt.body)})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			am.AssertExpectations(t)
+			im.AssertExpectations(t)
+			bm.AssertExpectations(t)
+			pm.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCollectOrphanImages_Execute(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	cutoff := now.Add(-usecase.DefaultOrphanImageGracePeriod)
+	orphanA, _ := entities.NewImage(1, 3, "images/2026/10/a.jpg", "https://cdn.example.com/images/2026/10/a.jpg", "image/jpeg", 10)
+	orphanA.Thumb, _ = entities.NewImageVariant("images/2026/10/a_thumb.jpg", "https://cdn.example.com/images/2026/10/a_thumb.jpg")
+	orphanB, _ := entities.NewImage(2, 3, "images/2026/10/b.png", "https://cdn.example.com/images/2026/10/b.png", "image/png", 10)
+
+	t.Run("【正常系】孤立画像を実体（派生サイズを含む）・メタデータの順に削除する", func(t *testing.T) {
+		im, bm := new(MockImageRepository), new(MockBlobStore)
+		im.On("FindOrphans", mock.Anything, cutoff, 100).Return([]*entities.Image{orphanA, orphanB}, nil)
+		bm.On("Delete", mock.Anything, orphanA.StorageKey).Return(nil)
+		bm.On("Delete", mock.Anything, orphanA.Thumb.StorageKey).Return(nil)
+		bm.On("Delete", mock.Anything, orphanB.StorageKey).Return(nil)
+		im.On("Delete", mock.Anything, orphanA.ID).Return(nil)
+		im.On("Delete", mock.Anything, orphanB.ID).Return(nil)
+
+		out, err := usecase.NewCollectOrphanImagesInteractor(im, bm).Execute(context.Background(), usecase.CollectOrphanImagesInput{Now: now})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, out.Deleted)
+		assert.Equal(t, []string{orphanA.StorageKey, orphanA.Thumb.StorageKey, orphanB.StorageKey}, out.Keys)
+		im.AssertExpectations(t)
+		bm.AssertExpectations(t)
+	})
+
+	t.Run("【正常系】dry-run では何も削除しない", func(t *testing.T) {
+		im, bm := new(MockImageRepository), new(MockBlobStore)
+		im.On("FindOrphans", mock.Anything, now.Add(-time.Hour), 100).Return([]*entities.Image{orphanA}, nil)
+
+		out, err := usecase.NewCollectOrphanImagesInteractor(im, bm).Execute(context.Background(), usecase.CollectOrphanImagesInput{Now: now, GracePeriod: time.Hour, DryRun: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, out.Deleted)
+		assert.Equal(t, []string{orphanA.StorageKey, orphanA.Thumb.StorageKey}, out.Keys)
+		im.AssertExpectations(t)
+		bm.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("【異常系】実体の削除に失敗した画像はメタデータを残す", func(t *testing.T) {
+		im, bm := new(MockImageRepository), new(MockBlobStore)
+		im.On("FindOrphans", mock.Anything, cutoff, 100).Return([]*entities.Image{orphanA}, nil)
+		bm.On("Delete", mock.Anything, orphanA.StorageKey).Return(errors.New("s3 down"))
+
+		out, err := usecase.NewCollectOrphanImagesInteractor(im, bm).Execute(context.Background(), usecase.CollectOrphanImagesInput{Now: now})
+
+		assert.Error(t, err)
+		assert.Equal(t, 0, out.Deleted)
+		im.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"app/src/domain/entities"
+	"app/src/domain/services"
+	"app/src/domain/value_objects"
+)
+
+type VoteSpotEditInput struct {
+	Token      string
+	ProposalID int
+	// Vote は "up"（賛成）または "down"（反対）です。
+	Vote string
+}
+
+type VoteSpotEditUseCase interface {
+	Execute(ctx context.Context, input VoteSpotEditInput) (*SpotEditProposalResponse, error)
+}
+
+type voteSpotEditInteractor struct {
+	presenter   ProposeSpotEditPresenter
+	spotRepo    entities.SpotRepository
+	editRepo    entities.SpotEditRepository
+	authService services.AuthDomainService
+	threshold   int
+}
+
+func NewVoteSpotEditInteractor(
+	p ProposeSpotEditPresenter,
+	s entities.SpotRepository,
+	e entities.SpotEditRepository,
+	a services.AuthDomainService,
+	threshold int,
+) VoteSpotEditUseCase {
+	return &voteSpotEditInteractor{
+		presenter:   p,
+		spotRepo:    s,
+		editRepo:    e,
+		authService: a,
+		threshold:   threshold,
+	}
+}
+
+func (i *voteSpotEditInteractor) Execute(ctx context.Context, input VoteSpotEditInput) (*SpotEditProposalResponse, error) {
+	user, err := i.authService.VerifyToken(ctx, input.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	var value int
+	switch input.Vote {
+	case "up":
+		value = 1
+	case "down":
+		value = -1
+	default:
+		return nil, fmt.Errorf("%w: vote must be \"up\" or \"down\"", ErrInvalidInput)
+	}
+	proposalID, err := value_objects.NewID(input.ProposalID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	// 1. 投票できるのは未解決の提案のみ
+	proposal, err := i.editRepo.FindProposalByID(ctx, proposalID)
+	if err != nil {
+		return nil, fmt.Errorf("repository error: %w", err)
+	}
+	if proposal == nil {
+		return nil, fmt.Errorf("%w: spot edit proposal %d", ErrNotFound, proposalID.Value())
+	}
+	if proposal.Status != entities.SpotEditOpen {
+		return nil, fmt.Errorf("%w: proposal is already %s", ErrConflict, proposal.Status)
+	}
+
+	// 2. 票の記録（再投票は上書き）と閾値判定
+	proposal, err = i.editRepo.Vote(ctx, proposal.ID, user.ID, value)
+	if errors.Is(err, entities.ErrSpotEditResolved) {
+		return nil, fmt.Errorf("%w: %v", ErrConflict, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("vote storage error: %w", err)
+	}
+	if err := resolveSpotEdit(ctx, i.spotRepo, i.editRepo, proposal, i.threshold); err != nil {
+		return nil, err
+	}
+
+	return i.presenter.Output(proposal), nil
+}
@@ -0,0 +1,158 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"app/src/domain/entities"
+	"app/src/domain/value_objects"
+	"app/src/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestVoteSpotEdit_Execute(t *testing.T) {
+	alice, _ := entities.NewUser(3, "alice", "alice@example.com", "hashed_password")
+	proposalID, _ := value_objects.NewID(10)
+
+	tests := []struct {
+		name      string
+		input     usecase.VoteSpotEditInput
+		setupMock func(am *MockAuthService, sm *MockSpotRepository, em *MockSpotEditRepository)
+		wantErr   bool
+		errIs     error
+		check     func(t *testing.T, out *usecase.SpotEditProposalResponse)
+	}{
+		{
+			name:  "【正常系】賛成票で閾値に届かない場合は未解決のまま集計だけ更新される",
+			input: usecase.VoteSpotEditInput{Token: "valid_token", ProposalID: 10, Vote: "up"},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, em *MockSpotEditRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(alice, nil)
+				em.On("FindProposalByID", mock.Anything, proposalID).Return(storedProposal(10, 1, "rename", "新店名", 0, 0, "", 1, 0), nil)
+				em.On("Vote", mock.Anything, proposalID, alice.ID, 1).Return(storedProposal(10, 1, "rename", "新店名", 0, 0, "", 2, 0), nil)
+			},
+			check: func(t *testing.T, out *usecase.SpotEditProposalResponse) {
+				assert.Equal(t, "open", out.Proposal.Status)
+				assert.Equal(t, 2, out.Proposal.Score)
+			},
+		},
+		{
+			name:  "【正常系】賛成票で閾値に達すると座標が修正され、メッシュIDも再計算される",
+			input: usecase.VoteSpotEditInput{Token: "valid_token", ProposalID: 10, Vote: "up"},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, em *MockSpotEditRepository) {
+				spot, _ := entities.NewSpot(1, "ボブの隠れ家", 35.6467, 139.7101, 1)
+				wantMesh, _ := value_objects.NewMeshID(35.7000, 139.8000)
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(alice, nil)
+				em.On("FindProposalByID", mock.Anything, proposalID).Return(storedProposal(10, 1, "relocate", "", 35.7000, 139.8000, "", 2, 0), nil)
+				em.On("Vote", mock.Anything, proposalID, alice.ID, 1).Return(storedProposal(10, 1, "relocate", "", 35.7000, 139.8000, "", 3, 0), nil)
+				sm.On("FindByID", mock.Anything, spot.ID).Return(spot, nil)
+				em.On("Apply", mock.Anything, mock.Anything, mock.MatchedBy(func(s *entities.Spot) bool {
+					return s.Latitude.Value() == 35.7000 && s.Longitude.Value() == 139.8000 && s.MeshID == wantMesh
+				}), mock.MatchedBy(func(h *entities.SpotEditHistory) bool {
+					return h.OldValue == "35.6467,139.7101" && h.NewValue == "35.7,139.8"
+				})).Return(nil)
+			},
+			check: func(t *testing.T, out *usecase.SpotEditProposalResponse) {
+				assert.Equal(t, "applied", out.Proposal.Status)
+			},
+		},
+		{
+			name:  "【正常系】閾値に達しても移転先に別のスポットがある場合は、開いたままにせず却下する",
+			input: usecase.VoteSpotEditInput{Token: "valid_token", ProposalID: 10, Vote: "up"},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, em *MockSpotEditRepository) {
+				spot, _ := entities.NewSpot(1, "ボブの隠れ家", 35.6467, 139.7101, 1)
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(alice, nil)
+				em.On("FindProposalByID", mock.Anything, proposalID).Return(storedProposal(10, 1, "relocate", "", 35.7000, 139.8000, "", 2, 0), nil)
+				em.On("Vote", mock.Anything, proposalID, alice.ID, 1).Return(storedProposal(10, 1, "relocate", "", 35.7000, 139.8000, "", 3, 0), nil)
+				sm.On("FindByID", mock.Anything, spot.ID).Return(spot, nil)
+				em.On("Apply", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(entities.ErrSpotLocationTaken)
+				em.On("Reject", mock.Anything, proposalID).Return(nil)
+			},
+			check: func(t *testing.T, out *usecase.SpotEditProposalResponse) {
+				assert.Equal(t, "rejected", out.Proposal.Status)
+			},
+		},
+		{
+			name:  "【正常系】反対票で -閾値 に達すると提案は却下される",
+			input: usecase.VoteSpotEditInput{Token: "valid_token", ProposalID: 10, Vote: "down"},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, em *MockSpotEditRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(alice, nil)
+				em.On("FindProposalByID", mock.Anything, proposalID).Return(storedProposal(10, 1, "rename", "新店名", 0, 0, "", 1, 3), nil)
+				em.On("Vote", mock.Anything, proposalID, alice.ID, -1).Return(storedProposal(10, 1, "rename", "新店名", 0, 0, "", 1, 4), nil)
+				em.On("Reject", mock.Anything, proposalID).Return(nil)
+			},
+			check: func(t *testing.T, out *usecase.SpotEditProposalResponse) {
+				assert.Equal(t, "rejected", out.Proposal.Status)
+			},
+		},
+		{
+			name:  "【異常系】解決済みの提案には投票できない",
+			input: usecase.VoteSpotEditInput{Token: "valid_token", ProposalID: 10, Vote: "up"},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, em *MockSpotEditRepository) {
+				applied := storedProposal(10, 1, "rename", "新店名", 0, 0, "", 3, 0)
+				applied.Status = entities.SpotEditApplied
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(alice, nil)
+				em.On("FindProposalByID", mock.Anything, proposalID).Return(applied, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrConflict,
+		},
+		{
+			name:  "【異常系】投票の記録までに他の票で解決済みになった場合は Conflict",
+			input: usecase.VoteSpotEditInput{Token: "valid_token", ProposalID: 10, Vote: "up"},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, em *MockSpotEditRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(alice, nil)
+				em.On("FindProposalByID", mock.Anything, proposalID).Return(storedProposal(10, 1, "rename", "新店名", 0, 0, "", 2, 0), nil)
+				em.On("Vote", mock.Anything, proposalID, alice.ID, 1).Return((*entities.SpotEditProposal)(nil), entities.ErrSpotEditResolved)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrConflict,
+		},
+		{
+			name:  "【異常系】存在しない提案への投票",
+			input: usecase.VoteSpotEditInput{Token: "valid_token", ProposalID: 10, Vote: "up"},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, em *MockSpotEditRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(alice, nil)
+				em.On("FindProposalByID", mock.Anything, proposalID).Return((*entities.SpotEditProposal)(nil), nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrNotFound,
+		},
+		{
+			name:  "【異常系】up / down 以外の票は入力エラー",
+			input: usecase.VoteSpotEditInput{Token: "valid_token", ProposalID: 10, Vote: "maybe"},
+			setupMock: func(am *MockAuthService, sm *MockSpotRepository, em *MockSpotEditRepository) {
+				am.On("VerifyToken", mock.Anything, "valid_token").Return(alice, nil)
+			},
+			wantErr: true,
+			errIs:   usecase.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am, sm, em := new(MockAuthService), new(MockSpotRepository), new(MockSpotEditRepository)
+			tt.setupMock(am, sm, em)
+			interactor := usecase.NewVoteSpotEditInteractor(&SpotEditMockPresenter{}, sm, em, am, 3)
+
+			out, err := interactor.Execute(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				if tt.check != nil {
+					tt.check(t, out)
+				}
+			}
+			am.AssertExpectations(t)
+			sm.AssertExpectations(t)
+			em.AssertExpectations(t)
+		})
+	}
+}